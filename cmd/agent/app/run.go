@@ -39,6 +39,7 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/status/health"
 	"github.com/DataDog/datadog-agent/pkg/telemetry"
 	"github.com/DataDog/datadog-agent/pkg/util"
+	"github.com/DataDog/datadog-agent/pkg/util/ec2"
 	"github.com/DataDog/datadog-agent/pkg/util/log"
 	"github.com/DataDog/datadog-agent/pkg/version"
 	"github.com/spf13/cobra"
@@ -219,6 +220,10 @@ func StartAgent() error {
 		log.Infof("pid '%d' written to pid file '%s'", os.Getpid(), pidfilePath)
 	}
 
+	// renew the IMDSv2 token in the background when ec2_imdsv2_background_token_refresh is set, before
+	// the hostname resolution queries the EC2 metadata API
+	ec2.StartTokenRefresher(common.MainCtx)
+
 	hostname, err := util.GetHostname()
 	if err != nil {
 		return log.Errorf("Error while getting hostname, exiting: %v", err)
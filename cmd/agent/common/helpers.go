@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/DataDog/datadog-agent/pkg/config"
+	"github.com/DataDog/datadog-agent/pkg/util/ec2"
 )
 
 // SetupConfig fires up the configuration system
@@ -56,5 +57,7 @@ func setupConfig(confFilePath string, configName string, withoutSecrets bool) (*
 	if err != nil {
 		return warnings, fmt.Errorf("unable to load Datadog config file: %s", err)
 	}
+	// the EC2 metadata API may be reachable with the new configuration
+	ec2.ResetNotOnEC2()
 	return warnings, nil
 }
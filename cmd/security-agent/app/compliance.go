@@ -101,12 +101,10 @@ func newComplianceReporter(stopper restart.Stopper, sourceName, sourceType strin
 	// setup the auditor
 	auditor := auditor.New(coreconfig.Datadog.GetString("compliance_config.run_path"), "compliance-registry.json", health)
 	auditor.Start()
-	stopper.Add(auditor)
 
 	// setup the pipeline provider that provides pairs of processor and sender
 	pipelineProvider := pipeline.NewProvider(config.NumberOfPipelines, auditor, nil, endpoints, context)
 	pipelineProvider.Start()
-	stopper.Add(pipelineProvider)
 
 	logSource := config.NewLogSource(
 		sourceName,
@@ -116,7 +114,19 @@ func newComplianceReporter(stopper restart.Stopper, sourceName, sourceType strin
 			Source:  sourceName,
 		},
 	)
-	return event.NewReporter(logSource, pipelineProvider.NextPipelineChan()), nil
+	reporter := event.NewReporter(logSource, pipelineProvider.NextPipelineChan())
+
+	// the batch reporter is stopped before the pipeline so that its remaining events are flushed through it
+	if flushInterval := coreconfig.Datadog.GetDuration("compliance_config.report_flush_interval"); flushInterval > 0 {
+		batchReporter := event.NewBatchReporter(reporter, flushInterval, coreconfig.Datadog.GetInt("compliance_config.report_buffer_size"))
+		stopper.Add(batchReporter)
+		reporter = batchReporter
+	}
+
+	stopper.Add(auditor)
+	stopper.Add(pipelineProvider)
+
+	return reporter, nil
 }
 
 func startCompliance(hostname string, endpoints *config.Endpoints, context *client.DestinationsContext, stopper restart.Stopper) error {
@@ -125,13 +135,17 @@ func startCompliance(hostname string, endpoints *config.Endpoints, context *clie
 		return nil
 	}
 
+	// the checks are stopped before the reporter so that the findings of the running checks are sent
+	checksStopper := restart.NewSerialStopper()
+	stopper.Add(checksStopper)
+
 	reporter, err := newComplianceReporter(stopper, "compliance-agent", "compliance", endpoints, context)
 	if err != nil {
 		return err
 	}
 
 	runner := runner.NewRunner()
-	stopper.Add(runner)
+	checksStopper.Add(runner)
 
 	scheduler := scheduler.NewScheduler(runner.GetChan())
 	runner.SetScheduler(scheduler)
@@ -141,12 +155,18 @@ func startCompliance(hostname string, endpoints *config.Endpoints, context *clie
 
 	options := []checks.BuilderOption{
 		checks.WithInterval(checkInterval),
+		checks.WithCheckTimeout(coreconfig.Datadog.GetDuration("compliance_config.check_timeout")),
 		checks.WithHostname(hostname),
 		checks.WithHostRootMount(os.Getenv("HOST_ROOT")),
+		checks.WithCommandAllowlist(coreconfig.Datadog.GetStringSlice("compliance_config.command_allowlist")),
 		checks.MayFail(checks.WithDocker()),
 		checks.MayFail(checks.WithAudit()),
 	}
 
+	if coreconfig.Datadog.IsSet("compliance_config.allowed_resource_kinds") {
+		options = append(options, checks.WithAllowedResourceKinds(coreconfig.Datadog.GetStringSlice("compliance_config.allowed_resource_kinds")))
+	}
+
 	if coreconfig.IsKubernetes() {
 		nodeLabels, err := agent.WaitGetNodeLabels()
 		if err != nil {
@@ -171,7 +191,7 @@ func startCompliance(hostname string, endpoints *config.Endpoints, context *clie
 		log.Errorf("Error starting compliance agent, exiting: %v", err)
 		return err
 	}
-	stopper.Add(agent)
+	checksStopper.Add(agent)
 
 	log.Infof("Running compliance checks every %s", checkInterval.String())
 	return nil
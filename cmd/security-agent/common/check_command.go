@@ -34,6 +34,7 @@ var (
 		framework string
 		file      string
 		verbose   bool
+		workers   int
 	}{}
 )
 
@@ -41,6 +42,7 @@ func setupCheckCmd(cmd *cobra.Command) {
 	cmd.Flags().StringVarP(&checkArgs.framework, "framework", "", "", "Framework to run the checks from")
 	cmd.Flags().StringVarP(&checkArgs.file, "file", "f", "", "Compliance suite file to read rules from")
 	cmd.Flags().BoolVarP(&checkArgs.verbose, "verbose", "v", false, "Include verbose details")
+	cmd.Flags().IntVarP(&checkArgs.workers, "workers", "w", 0, "Number of checks to run concurrently across suites (0 runs checks sequentially)")
 }
 
 // CheckCmd returns a cobra command to run security agent checks
@@ -113,7 +115,14 @@ func runCheck(cmd *cobra.Command, confPath *string, args []string) error {
 		return err
 	}
 
-	options = append(options, checks.WithHostname(hostname))
+	options = append(options,
+		checks.WithHostname(hostname),
+		checks.WithCommandAllowlist(config.Datadog.GetStringSlice("compliance_config.command_allowlist")),
+	)
+
+	if config.Datadog.IsSet("compliance_config.allowed_resource_kinds") {
+		options = append(options, checks.WithAllowedResourceKinds(config.Datadog.GetStringSlice("compliance_config.allowed_resource_kinds")))
+	}
 
 	reporter := &runCheckReporter{}
 
@@ -129,6 +138,9 @@ func runCheck(cmd *cobra.Command, confPath *string, args []string) error {
 
 	if checkArgs.file != "" {
 		err = agent.RunChecksFromFile(reporter, checkArgs.file, options...)
+	} else if checkArgs.workers > 0 {
+		configDir := config.Datadog.GetString("compliance_config.dir")
+		_, err = agent.RunChecksInParallel(reporter, configDir, checkArgs.workers, options...)
 	} else {
 		configDir := config.Datadog.GetString("compliance_config.dir")
 		err = agent.RunChecks(reporter, configDir, options...)
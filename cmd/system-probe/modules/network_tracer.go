@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -62,12 +63,29 @@ func (nt *networkTracer) Register(httpMux *http.ServeMux) error {
 	httpMux.HandleFunc("/connections", func(w http.ResponseWriter, req *http.Request) {
 		start := time.Now()
 		id := getClientID(req)
-		cs, err := nt.tracer.GetActiveConnections(id)
+		since, err := getSince(req)
+		if err != nil {
+			log.Errorf("invalid since parameter: %s", err)
+			w.WriteHeader(400)
+			return
+		}
+
+		states, err := getStates(req)
+		if err != nil {
+			log.Errorf("invalid state parameter: %s", err)
+			w.WriteHeader(400)
+			return
+		}
+
+		cs, err := nt.tracer.GetActiveConnections(id, states...)
 		if err != nil {
 			log.Errorf("unable to retrieve connections: %s", err)
 			w.WriteHeader(500)
 			return
 		}
+		if !since.IsZero() {
+			cs.Conns = network.FilterConnectionsSince(cs.Conns, since)
+		}
 		contentType := req.Header.Get("Accept")
 		marshaler := encoding.GetMarshaler(contentType)
 		writeConnections(w, marshaler, cs)
@@ -100,6 +118,10 @@ func (nt *networkTracer) Register(httpMux *http.ServeMux) error {
 		utils.WriteAsJSON(w, stats)
 	})
 
+	httpMux.HandleFunc("/debug/net_info", func(w http.ResponseWriter, req *http.Request) {
+		utils.WriteAsJSON(w, nt.tracer.Info())
+	})
+
 	// Convenience logging if nothing has made any requests to the system-probe in some time, let's log something.
 	// This should be helpful for customers + support to debug the underlying issue.
 	time.AfterFunc(inactivityLogDuration, func() {
@@ -135,6 +157,34 @@ func getClientID(req *http.Request) string {
 	return clientID
 }
 
+// getSince returns the time set by the since parameter, as an RFC 3339 timestamp, after which the connections
+// must have been first seen or last active to be returned. It is zero when all the connections are requested.
+func getSince(req *http.Request) (time.Time, error) {
+	rawSince := req.URL.Query().Get("since")
+	if rawSince == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339Nano, rawSince)
+}
+
+// getStates returns the connection states of the state parameter, a comma separated list
+func getStates(req *http.Request) ([]network.ConnectionState, error) {
+	rawStates := req.URL.Query().Get("state")
+	if rawStates == "" {
+		return nil, nil
+	}
+
+	var states []network.ConnectionState
+	for _, name := range strings.Split(rawStates, ",") {
+		state, err := network.ParseConnectionState(strings.TrimSpace(name))
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, state)
+	}
+	return states, nil
+}
+
 func writeConnections(w http.ResponseWriter, marshaler encoding.Marshaler, cs *network.Connections) {
 	buf, err := marshaler.Marshal(cs)
 	if err != nil {
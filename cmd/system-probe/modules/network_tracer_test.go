@@ -58,3 +58,16 @@ func TestDecode(t *testing.T) {
 	assert.Equal(t, expected, out)
 
 }
+
+func TestGetStates(t *testing.T) {
+	states, err := getStates(httptest.NewRequest("GET", "/connections", nil))
+	require.NoError(t, err)
+	assert.Empty(t, states)
+
+	states, err = getStates(httptest.NewRequest("GET", "/connections?state=established,%20closed", nil))
+	require.NoError(t, err)
+	assert.Equal(t, []network.ConnectionState{network.ESTABLISHED, network.CLOSED}, states)
+
+	_, err = getStates(httptest.NewRequest("GET", "/connections?state=listen", nil))
+	assert.Error(t, err)
+}
@@ -156,11 +156,14 @@ func (a *Agent) Stop() {
 	}
 }
 
-func (a *Agent) buildChecks(onCheck compliance.CheckVisitor) error {
+func (a *Agent) suiteFiles() ([]string, error) {
 	log.Infof("Loading compliance rules from %s", a.configDir)
 	pattern := path.Join(a.configDir, "*.yaml")
-	files, err := filepath.Glob(pattern)
+	return filepath.Glob(pattern)
+}
 
+func (a *Agent) buildChecks(onCheck compliance.CheckVisitor) error {
+	files, err := a.suiteFiles()
 	if err != nil {
 		return err
 	}
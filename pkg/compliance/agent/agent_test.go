@@ -260,3 +260,76 @@ func TestRunChecksFromFile(t *testing.T) {
 	)
 	assert.NoError(err)
 }
+
+func TestRunChecksInParallel(t *testing.T) {
+	assert := assert.New(t)
+
+	e := enterTempEnv(t)
+	defer e.leave()
+
+	reporter := &mocks.Reporter{}
+
+	reporter.On(
+		"Report",
+		mock.MatchedBy(
+			eventMatcher(
+				eventMatch{
+					ruleID:       "cis-docker-1",
+					resourceID:   "the-host",
+					resourceType: "docker",
+					result:       "passed",
+					path:         "/files/daemon.json",
+					permissions:  0644,
+				},
+			),
+		),
+	).Once()
+
+	reporter.On(
+		"Report",
+		mock.MatchedBy(
+			eventMatcher(
+				eventMatch{
+					ruleID:       "cis-kubernetes-1",
+					resourceID:   "the-host",
+					resourceType: "kubernetesNode",
+					result:       "failed",
+					path:         "/files/kube-apiserver.yaml",
+					permissions:  0644,
+				},
+			),
+		),
+	).Once()
+
+	defer reporter.AssertExpectations(t)
+
+	dockerClient := &mocks.DockerClient{}
+	dockerClient.On("Close").Return(nil).Once()
+	defer dockerClient.AssertExpectations(t)
+
+	nodeLabels := map[string]string{
+		"node-role.kubernetes.io/worker": "",
+	}
+
+	results, err := RunChecksInParallel(
+		reporter,
+		e.dir,
+		2,
+		checks.WithHostname("the-host"),
+		checks.WithHostRootMount(e.dir),
+		checks.WithDockerClient(dockerClient),
+		checks.WithNodeLabels(nodeLabels),
+	)
+	assert.NoError(err)
+
+	assert.Len(results, 2)
+	assert.Equal(filepath.Join(e.dir, "cis-docker.yaml"), results[0].File)
+	assert.Equal(1, results[0].Checks)
+	assert.Equal(0, results[0].Errors)
+	assert.NoError(results[0].Err)
+
+	assert.Equal(filepath.Join(e.dir, "cis-kubernetes.yaml"), results[1].File)
+	assert.Equal(1, results[1].Checks)
+	assert.Equal(0, results[1].Errors)
+	assert.NoError(results[1].Err)
+}
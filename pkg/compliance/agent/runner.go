@@ -0,0 +1,134 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package agent
+
+import (
+	"sync"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/checks"
+	"github.com/DataDog/datadog-agent/pkg/compliance/event"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+// SuiteResult summarizes the run of the checks of a compliance suite
+type SuiteResult struct {
+	// File is the path of the suite
+	File string
+	// Checks is the number of checks run for the suite
+	Checks int
+	// Errors is the number of checks which failed to run
+	Errors int
+	// Duration is the cumulated run time of the checks of the suite
+	Duration time.Duration
+	// Err is set when the suite failed to load
+	Err error
+}
+
+// RunChecksInParallel runs checks from all suites right away without scheduling,
+// using a pool of workers shared by all the suites
+func RunChecksInParallel(reporter event.Reporter, configDir string, workers int, options ...checks.BuilderOption) ([]*SuiteResult, error) {
+	builder, err := checks.NewBuilder(
+		reporter,
+		options...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer builder.Close()
+
+	agent := &Agent{
+		builder:   builder,
+		configDir: configDir,
+	}
+
+	return agent.RunChecksInParallel(workers)
+}
+
+// RunChecksInParallel runs checks with no scheduling, evaluating up to workers checks concurrently.
+// Checks of all suites share the builder clients and caches.
+func (a *Agent) RunChecksInParallel(workers int) ([]*SuiteResult, error) {
+	files, err := a.suiteFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	if workers < 1 {
+		workers = 1
+	}
+
+	type checkJob struct {
+		check  compliance.Check
+		result *SuiteResult
+	}
+
+	var (
+		lock sync.Mutex
+		wg   sync.WaitGroup
+		jobs = make(chan checkJob)
+	)
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for job := range jobs {
+				start := time.Now()
+				err := job.check.Run()
+				elapsed := time.Since(start)
+
+				if err != nil {
+					log.Errorf("%s: Check failed: %v", job.check.ID(), err)
+				}
+
+				lock.Lock()
+				job.result.Checks++
+				job.result.Duration += elapsed
+				if err != nil {
+					job.result.Errors++
+				}
+				lock.Unlock()
+			}
+		}()
+	}
+
+	results := make([]*SuiteResult, 0, len(files))
+	for _, file := range files {
+		result := &SuiteResult{
+			File: file,
+		}
+		results = append(results, result)
+
+		result.Err = a.builder.ChecksFromFile(file, func(rule *compliance.Rule, check compliance.Check, err error) bool {
+			if err != nil {
+				log.Infof("%s: Not running check: %v", rule.ID, err)
+				return true
+			}
+
+			log.Infof("%s: Running check: %s [version=%s]", rule.ID, check.String(), check.Version())
+			jobs <- checkJob{
+				check:  check,
+				result: result,
+			}
+			return true
+		})
+		if result.Err != nil {
+			log.Errorf("Failed to load rules from %s: %v", file, result.Err)
+		}
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	for _, result := range results {
+		log.Infof("Ran %d checks from %s in %s (%d errors)", result.Checks, result.File, result.Duration, result.Errors)
+	}
+
+	return results, nil
+}
@@ -42,6 +42,20 @@ func (c *auditClient) Close() error {
 	return c.client.Close()
 }
 
+// GetStatus returns the status of the kernel audit subsystem
+func (c *auditClient) GetStatus() (*env.AuditStatus, error) {
+	status, err := c.client.GetStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	return &env.AuditStatus{
+		Enabled: status.Enabled,
+		Failure: status.Failure,
+		PID:     status.PID,
+	}, nil
+}
+
 // GetFileWatchRules returns audit rules for file watching
 func (c *auditClient) GetFileWatchRules() ([]*rule.FileWatchRule, error) {
 	data, err := c.client.GetRules()
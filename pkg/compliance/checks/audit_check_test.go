@@ -6,6 +6,7 @@
 package checks
 
 import (
+	"context"
 	"testing"
 
 	"github.com/DataDog/datadog-agent/pkg/compliance"
@@ -123,7 +124,7 @@ func TestAuditCheck(t *testing.T) {
 			auditCheck, err := newResourceCheck(env, "rule-id", test.resource)
 			assert.NoError(err)
 
-			result, err := auditCheck.check(env)
+			result, err := auditCheck.check(context.Background(), env)
 
 			assert.Equal(test.expectError, err)
 			assert.Equal(test.expectReport, result)
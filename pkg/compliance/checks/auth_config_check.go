@@ -0,0 +1,124 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package checks
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/checks/env"
+	"github.com/DataDog/datadog-agent/pkg/compliance/eval"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+var defaultAuthConfigPaths = []string{
+	"/etc/nsswitch.conf",
+	"/etc/pam.d/*",
+}
+
+var authConfigReportedFields = []string{
+	compliance.AuthConfigFieldFound,
+	compliance.AuthConfigFieldPath,
+	compliance.AuthConfigFieldLine,
+	compliance.AuthConfigFieldCaptures,
+}
+
+// resolveAuthConfig looks up a directive in the authentication configuration files, it is a file check
+// matching the lines of every file found by its glob patterns. The first matching line is reported.
+func resolveAuthConfig(_ context.Context, e env.Env, ruleID string, res compliance.Resource) (interface{}, error) {
+	if res.AuthConfig == nil {
+		return nil, fmt.Errorf("%s: expecting authConfig resource in authConfig check", ruleID)
+	}
+
+	authConfig := res.AuthConfig
+
+	if err := authConfig.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", ruleID, err)
+	}
+	re := regexp.MustCompile(authConfig.Directive)
+
+	patterns := authConfig.Paths
+	if len(patterns) == 0 {
+		patterns = defaultAuthConfigPaths
+	}
+
+	log.Debugf("%s: running authConfig check for %q in %v", ruleID, authConfig.Directive, patterns)
+
+	fs := e.FileSystem()
+
+	instance := &eval.Instance{
+		Vars: eval.VarMap{
+			compliance.AuthConfigFieldFound: false,
+		},
+	}
+
+	filesRead := 0
+	for _, pattern := range patterns {
+		path, err := resolvePath(e, pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		paths, err := fs.Glob(e.NormalizeToHostRoot(path))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, path := range paths {
+			data, err := readFile(fs, path)
+			if err != nil {
+				// This is not a failure unless we don't have any file to act on
+				log.Debugf("%s: authConfig check failed to read %s: %v", ruleID, path, err)
+				continue
+			}
+			filesRead++
+
+			line, captures, found := matchConfigLine(re, data)
+			if !found {
+				continue
+			}
+
+			instance.Vars[compliance.AuthConfigFieldFound] = true
+			instance.Vars[compliance.AuthConfigFieldPath] = e.RelativeToHostRoot(path)
+			instance.Vars[compliance.AuthConfigFieldLine] = line
+			if captures != nil {
+				instance.Vars[compliance.AuthConfigFieldCaptures] = captures
+			}
+			return instance, nil
+		}
+	}
+
+	if filesRead == 0 {
+		return nil, fmt.Errorf("no files found for authConfig check %v", patterns)
+	}
+
+	return instance, nil
+}
+
+// matchConfigLine returns the first line of a configuration file matching a regexp, blank
+// lines and comments are skipped
+func matchConfigLine(re *regexp.Regexp, data []byte) (string, map[string]string, bool) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if !re.MatchString(line) {
+			continue
+		}
+
+		_, captures := regexpFindCaptures(re, []byte(line))
+		return line, captures, true
+	}
+	return "", nil, false
+}
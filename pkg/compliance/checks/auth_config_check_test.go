@@ -0,0 +1,157 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build !windows
+
+package checks
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/event"
+	"github.com/DataDog/datadog-agent/pkg/compliance/mocks"
+
+	"github.com/stretchr/testify/mock"
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestAuthConfigCheck(t *testing.T) {
+	fs := memFileSystem{
+		"/etc":        {mode: os.ModeDir | 0755},
+		"/etc/pam.d":  {mode: os.ModeDir | 0755},
+		"/etc/ldap":   {mode: os.ModeDir | 0755},
+		"/etc/passwd": {content: "root:x:0:0:root:/root:/bin/bash\n", mode: 0644},
+		"/etc/nsswitch.conf": {content: `# /etc/nsswitch.conf
+passwd:         files ldap
+group:          files ldap
+shadow:         files
+`, mode: 0644},
+		"/etc/pam.d/common-auth": {content: `# here are the per-package modules (the "Primary" block)
+#auth	required	pam_faillock.so preauth
+auth	[success=2 default=ignore]	pam_unix.so nullok_secure
+auth	[success=1 default=ignore]	pam_ldap.so use_first_pass
+`, mode: 0644},
+		"/etc/pam.d/common-password": {content: `password	requisite	pam_pwquality.so retry=3 minlen=14
+`, mode: 0644},
+		"/etc/ldap/ldap.conf": {content: `BASE	dc=example,dc=com
+URI	ldaps://ldap.example.com
+TLS_REQCERT	demand
+`, mode: 0644},
+	}
+
+	tests := []struct {
+		name         string
+		resource     compliance.Resource
+		expectReport *compliance.Report
+		expectError  bool
+	}{
+		{
+			name: "module found in default paths",
+			resource: compliance.Resource{
+				AuthConfig: &compliance.AuthConfig{
+					Directive: `^password\s+\S+\s+pam_pwquality\.so.*minlen=(?P<minlen>\d+)`,
+				},
+				Condition: `authConfig.found`,
+			},
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					"authConfig.found":    true,
+					"authConfig.path":     "/etc/pam.d/common-password",
+					"authConfig.line":     "password\trequisite\tpam_pwquality.so retry=3 minlen=14",
+					"authConfig.captures": map[string]string{"minlen": "14"},
+				},
+			},
+		},
+		{
+			name: "commented out module",
+			resource: compliance.Resource{
+				AuthConfig: &compliance.AuthConfig{
+					Directive: `^auth\s+required\s+pam_faillock\.so`,
+				},
+				Condition: `authConfig.found`,
+			},
+			expectReport: &compliance.Report{
+				Passed: false,
+				Data: event.Data{
+					"authConfig.found": false,
+				},
+			},
+		},
+		{
+			name: "nsswitch source",
+			resource: compliance.Resource{
+				AuthConfig: &compliance.AuthConfig{
+					Paths:     []string{"/etc/nsswitch.conf"},
+					Directive: `^passwd:.*\bldap\b`,
+				},
+				Condition: `authConfig.found`,
+			},
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					"authConfig.found": true,
+					"authConfig.path":  "/etc/nsswitch.conf",
+					"authConfig.line":  "passwd:         files ldap",
+				},
+			},
+		},
+		{
+			name: "ldap client configuration",
+			resource: compliance.Resource{
+				AuthConfig: &compliance.AuthConfig{
+					Paths:     []string{"/etc/ldap/*.conf"},
+					Directive: `^TLS_REQCERT\s+(\w+)`,
+				},
+				Condition: `authConfig.found`,
+			},
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					"authConfig.found":    true,
+					"authConfig.path":     "/etc/ldap/ldap.conf",
+					"authConfig.line":     "TLS_REQCERT\tdemand",
+					"authConfig.captures": map[string]string{"1": "demand"},
+				},
+			},
+		},
+		{
+			name: "no files",
+			resource: compliance.Resource{
+				AuthConfig: &compliance.AuthConfig{
+					Paths:     []string{"/etc/sssd/*.conf"},
+					Directive: `^ldap_tls_reqcert`,
+				},
+				Condition: `authConfig.found`,
+			},
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			env := &mocks.Env{}
+			env.On("FileSystem").Return(fs)
+			env.On("NormalizeToHostRoot", mock.Anything).Return(func(path string) string { return path })
+			env.On("RelativeToHostRoot", mock.Anything).Return(func(path string) string { return path })
+
+			authConfigCheck, err := newResourceCheck(env, "rule-id", test.resource)
+			assert.NoError(err)
+
+			report, err := authConfigCheck.check(context.Background(), env)
+			if test.expectError {
+				assert.Error(err)
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(test.expectReport, report)
+		})
+	}
+}
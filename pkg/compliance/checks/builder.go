@@ -9,6 +9,8 @@ package checks
 import (
 	"errors"
 	"fmt"
+	"os/exec"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -20,6 +22,7 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/util/kubernetes/hostinfo"
 	"github.com/DataDog/datadog-agent/pkg/util/log"
 	cache "github.com/patrickmn/go-cache"
+	"golang.org/x/crypto/ssh"
 )
 
 // ErrResourceNotSupported is returned when resource type is not supported by Builder
@@ -31,6 +34,9 @@ var ErrRuleScopeNotSupported = errors.New("rule scope not supported")
 // ErrRuleDoesNotApply is returned when a rule cannot be applied to the current environment
 var ErrRuleDoesNotApply = errors.New("rule does not apply to this environment")
 
+// ErrResourceKindDisabled is returned when a rule uses a resource type which is not in the allowed resource kinds
+var ErrResourceKindDisabled = errors.New("resource type disabled by policy")
+
 const (
 	builderFuncExec        = "exec"
 	builderFuncShell       = "shell"
@@ -42,6 +48,7 @@ const (
 // Builder defines an interface to build checks from rules
 type Builder interface {
 	ChecksFromFile(file string, onCheck compliance.CheckVisitor) error
+	ChecksFromSuite(meta *compliance.SuiteMeta, rules []*compliance.Rule) ([]compliance.Check, []error)
 	GetCheckStatus() compliance.CheckStatusList
 	Close() error
 }
@@ -57,6 +64,15 @@ func WithInterval(interval time.Duration) BuilderOption {
 	}
 }
 
+// WithCheckTimeout configures the default timeout of a check run, rules can override it. No timeout is
+// enforced when zero.
+func WithCheckTimeout(timeout time.Duration) BuilderOption {
+	return func(b *builder) error {
+		b.checkTimeout = timeout
+		return nil
+	}
+}
+
 // WithHostname configures hostname used by checks
 func WithHostname(hostname string) BuilderOption {
 	return func(b *builder) error {
@@ -65,6 +81,23 @@ func WithHostname(hostname string) BuilderOption {
 	}
 }
 
+// WithScannedHost attributes the findings to a host other than the one running the checks, as when
+// scanning a remote host. When not empty, hostRootMount defines where the root filesystem of the
+// scanned host is mounted and is used to resolve the resources.
+func WithScannedHost(hostname string, hostRootMount string) BuilderOption {
+	return func(b *builder) error {
+		if hostname == "" {
+			return errors.New("scanned host requires a hostname")
+		}
+		b.hostname = hostname
+		b.scannedHost = hostname
+		if hostRootMount != "" {
+			return WithHostRootMount(hostRootMount)(b)
+		}
+		return nil
+	}
+}
+
 // WithHostRootMount defines host root filesystem mount location
 func WithHostRootMount(hostRootMount string) BuilderOption {
 	return func(b *builder) error {
@@ -122,6 +155,55 @@ func WithKubernetesClient(cli env.KubeClient) BuilderOption {
 	}
 }
 
+// WithSSHFileSystem configures checks to access the files of a remote host over SSH. A single
+// connection per host is shared by the checks, connection failures are reported as check errors.
+func WithSSHFileSystem(addr string, config *ssh.ClientConfig) BuilderOption {
+	return func(b *builder) error {
+		if config == nil {
+			return errors.New("ssh file system requires a client configuration")
+		}
+		b.fileSystem = newSSHFileSystem(addr, config)
+		return nil
+	}
+}
+
+// WithCommandAllowlist sets the absolute paths of the binaries that command checks are allowed to run.
+// Command checks are refused unless their binary, or their shell for shell commands, is in the allowlist.
+func WithCommandAllowlist(binaries []string) BuilderOption {
+	return func(b *builder) error {
+		allowlist := make([]string, 0, len(binaries))
+		for _, binary := range binaries {
+			if !filepath.IsAbs(binary) {
+				return fmt.Errorf("command allowlist entry %q is not an absolute path", binary)
+			}
+			allowlist = append(allowlist, filepath.Clean(binary))
+		}
+		b.commandAllowlist = allowlist
+		return nil
+	}
+}
+
+// WithAllowedResourceKinds sets the resource types that rules are allowed to use, rules using any other
+// resource type, fallbacks included, are refused. All resource types are allowed when the option is not set.
+func WithAllowedResourceKinds(kinds []string) BuilderOption {
+	return func(b *builder) error {
+		allowed := make(map[compliance.ResourceKind]struct{}, len(kinds))
+		for _, kind := range kinds {
+			allowed[compliance.ResourceKind(kind)] = struct{}{}
+		}
+		b.allowedResourceKinds = allowed
+		return nil
+	}
+}
+
+// WithDryRun configures builder to validate rules and build no-op checks which never evaluate resources
+func WithDryRun() BuilderOption {
+	return func(b *builder) error {
+		b.dryRun = true
+		return nil
+	}
+}
+
 // SuiteMatcher checks if a compliance suite is included
 type SuiteMatcher func(*compliance.SuiteMeta) bool
 
@@ -186,6 +268,7 @@ func NewBuilder(reporter event.Reporter, options ...BuilderOption) (Builder, err
 		reporter:      reporter,
 		checkInterval: 20 * time.Minute,
 		etcGroupPath:  "/etc/group",
+		fileSystem:    osFileSystem{},
 		status:        newStatus(),
 	}
 
@@ -205,21 +288,28 @@ func NewBuilder(reporter event.Reporter, options ...BuilderOption) (Builder, err
 
 type builder struct {
 	checkInterval time.Duration
+	checkTimeout  time.Duration
 
 	reporter   event.Reporter
 	valueCache *cache.Cache
 
 	hostname     string
+	scannedHost  string
 	pathMapper   *pathMapper
 	etcGroupPath string
 	nodeLabels   map[string]string
 
 	suiteMatcher SuiteMatcher
 	ruleMatcher  RuleMatcher
+	dryRun       bool
+
+	commandAllowlist     []string
+	allowedResourceKinds map[compliance.ResourceKind]struct{}
 
 	dockerClient env.DockerClient
 	auditClient  env.AuditClient
 	kubeClient   env.KubeClient
+	fileSystem   env.FileSystem
 
 	status *status
 }
@@ -258,13 +348,8 @@ func (b *builder) ChecksFromFile(file string, onCheck compliance.CheckVisitor) e
 
 	matchedCount := 0
 	for _, r := range suite.Rules {
-		if b.ruleMatcher != nil {
-			if b.ruleMatcher(&r) {
-				log.Infof("%s/%s: matched rule %s in %s", suite.Meta.Name, suite.Meta.Version, r.ID, file)
-			} else {
-				log.Tracef("%s/%s: skipped rule %s in %s", suite.Meta.Name, suite.Meta.Version, r.ID, file)
-				continue
-			}
+		if !b.matchRule(&suite.Meta, &r) {
+			continue
 		}
 		matchedCount++
 
@@ -273,27 +358,7 @@ func (b *builder) ChecksFromFile(file string, onCheck compliance.CheckVisitor) e
 			continue
 		}
 
-		log.Debugf("%s/%s: loading rule %s", suite.Meta.Name, suite.Meta.Version, r.ID)
-		check, err := b.checkFromRule(&suite.Meta, &r)
-
-		if err != nil {
-			if err != ErrRuleDoesNotApply {
-				log.Warnf("%s/%s: failed to load rule %s: %v", suite.Meta.Name, suite.Meta.Version, r.ID, err)
-			}
-			log.Infof("%s/%s: skipped rule %s - does not apply to this system", suite.Meta.Name, suite.Meta.Version, r.ID)
-		}
-
-		if b.status != nil {
-			b.status.addCheck(&compliance.CheckStatus{
-				RuleID:      r.ID,
-				Description: r.Description,
-				Name:        compliance.CheckName(r.ID, r.Description),
-				Framework:   suite.Meta.Framework,
-				Source:      suite.Meta.Source,
-				Version:     suite.Meta.Version,
-				InitError:   err,
-			})
-		}
+		check, err := b.buildRule(&suite.Meta, &r)
 		ok := onCheck(&r, check, err)
 		if !ok {
 			log.Infof("%s/%s: stopping rule enumeration", suite.Meta.Name, suite.Meta.Version)
@@ -308,6 +373,77 @@ func (b *builder) ChecksFromFile(file string, onCheck compliance.CheckVisitor) e
 	return nil
 }
 
+// ChecksFromSuite builds the checks of the rules of a suite matched by the builder. The rules which don't
+// apply to the environment are skipped, the other failures are returned alongside the checks built successfully.
+func (b *builder) ChecksFromSuite(meta *compliance.SuiteMeta, rules []*compliance.Rule) ([]compliance.Check, []error) {
+	var (
+		checks []compliance.Check
+		errs   []error
+	)
+
+	for _, r := range rules {
+		if !b.matchRule(meta, r) {
+			continue
+		}
+
+		if len(r.Resources) == 0 {
+			log.Infof("%s/%s: skipped rule %s - no configured resources", meta.Name, meta.Version, r.ID)
+			continue
+		}
+
+		check, err := b.buildRule(meta, r)
+		switch {
+		case err == nil:
+			checks = append(checks, check)
+		case errors.Is(err, ErrRuleDoesNotApply):
+		default:
+			errs = append(errs, fmt.Errorf("%s/%s: rule %s: %w", meta.Name, meta.Version, r.ID, err))
+		}
+	}
+
+	return checks, errs
+}
+
+// matchRule returns whether a rule is selected by the rule matcher of the builder, if any
+func (b *builder) matchRule(meta *compliance.SuiteMeta, r *compliance.Rule) bool {
+	if b.ruleMatcher == nil {
+		return true
+	}
+	if b.ruleMatcher(r) {
+		log.Infof("%s/%s: matched rule %s in %s", meta.Name, meta.Version, r.ID, meta.Source)
+		return true
+	}
+	log.Tracef("%s/%s: skipped rule %s in %s", meta.Name, meta.Version, r.ID, meta.Source)
+	return false
+}
+
+// buildRule builds the check of a rule and records its status
+func (b *builder) buildRule(meta *compliance.SuiteMeta, r *compliance.Rule) (compliance.Check, error) {
+	log.Debugf("%s/%s: loading rule %s", meta.Name, meta.Version, r.ID)
+	check, err := b.checkFromRule(meta, r)
+
+	if err != nil {
+		if err != ErrRuleDoesNotApply {
+			log.Warnf("%s/%s: failed to load rule %s: %v", meta.Name, meta.Version, r.ID, err)
+		}
+		log.Infof("%s/%s: skipped rule %s - does not apply to this system", meta.Name, meta.Version, r.ID)
+	}
+
+	if b.status != nil {
+		b.status.addCheck(&compliance.CheckStatus{
+			RuleID:      r.ID,
+			Description: r.Description,
+			Name:        compliance.CheckName(r.ID, r.Description),
+			Framework:   meta.Framework,
+			Source:      meta.Source,
+			Version:     meta.Version,
+			InitError:   err,
+		})
+	}
+
+	return check, err
+}
+
 func (b *builder) GetCheckStatus() compliance.CheckStatusList {
 	if b.status != nil {
 		return b.status.getChecksStatus()
@@ -331,9 +467,40 @@ func (b *builder) checkFromRule(meta *compliance.SuiteMeta, rule *compliance.Rul
 		return nil, ErrRuleDoesNotApply
 	}
 
+	if err := b.checkResourceKinds(rule.Resources); err != nil {
+		return nil, err
+	}
+
+	if b.dryRun {
+		if err := validateRule(rule); err != nil {
+			return nil, err
+		}
+		return b.newDryRunCheck(meta, ruleScope, rule), nil
+	}
+
 	return b.newCheck(meta, ruleScope, rule)
 }
 
+// checkResourceKinds returns an error when a resource, or its fallback, is of a type not allowed by the builder
+func (b *builder) checkResourceKinds(resources []compliance.Resource) error {
+	if b.allowedResourceKinds == nil {
+		return nil
+	}
+
+	for _, resource := range resources {
+		kind := resource.Kind()
+		if _, ok := b.allowedResourceKinds[kind]; !ok {
+			return fmt.Errorf("%w: %s", ErrResourceKindDisabled, kind)
+		}
+		if resource.Fallback != nil {
+			if err := b.checkResourceKinds([]compliance.Resource{resource.Fallback.Resource}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func getRuleScope(meta *compliance.SuiteMeta, rule *compliance.Rule) (compliance.RuleScope, error) {
 	switch {
 	case rule.Scope.Includes(compliance.DockerScope):
@@ -449,6 +616,11 @@ func (b *builder) newCheck(meta *compliance.SuiteMeta, ruleScope compliance.Rule
 		notify = b.status.updateCheck
 	}
 
+	timeout := b.checkTimeout
+	if rule.Timeout > 0 {
+		timeout = rule.Timeout
+	}
+
 	// We capture err as configuration error but do not prevent check creation
 	return &complianceCheck{
 		Env: b,
@@ -456,18 +628,37 @@ func (b *builder) newCheck(meta *compliance.SuiteMeta, ruleScope compliance.Rule
 		ruleID:      rule.ID,
 		description: rule.Description,
 		interval:    b.checkInterval,
+		timeout:     timeout,
 
 		suiteMeta: meta,
 
 		// For now we are using rule scope (e.g. docker, kubernetesNode) as resource type
 		resourceType: string(ruleScope),
 		resourceID:   b.hostname,
+		host:         b.scannedHost,
 		checkable:    checkable,
 
 		eventNotify: notify,
 	}, nil
 }
 
+func (b *builder) newDryRunCheck(meta *compliance.SuiteMeta, ruleScope compliance.RuleScope, rule *compliance.Rule) compliance.Check {
+	return &complianceCheck{
+		Env: b,
+
+		ruleID:      rule.ID,
+		description: rule.Description,
+		interval:    b.checkInterval,
+
+		suiteMeta: meta,
+
+		resourceType: string(ruleScope),
+		resourceID:   b.hostname,
+		host:         b.scannedHost,
+		dryRun:       true,
+	}
+}
+
 func (b *builder) Reporter() event.Reporter {
 	return b.reporter
 }
@@ -484,6 +675,10 @@ func (b *builder) KubeClient() env.KubeClient {
 	return b.kubeClient
 }
 
+func (b *builder) FileSystem() env.FileSystem {
+	return b.fileSystem
+}
+
 func (b *builder) Hostname() string {
 	return b.hostname
 }
@@ -492,6 +687,22 @@ func (b *builder) EtcGroupPath() string {
 	return b.etcGroupPath
 }
 
+// IsCommandAllowed returns whether a command check may run a binary, looked up in PATH if needed
+func (b *builder) IsCommandAllowed(name string) bool {
+	path, err := exec.LookPath(name)
+	if err != nil {
+		return false
+	}
+	path = filepath.Clean(path)
+
+	for _, allowed := range b.commandAllowlist {
+		if path == allowed {
+			return true
+		}
+	}
+	return false
+}
+
 func (b *builder) NormalizeToHostRoot(path string) string {
 	if b.pathMapper == nil {
 		return path
@@ -659,6 +870,6 @@ func (b *builder) evalValueFromFile(get getter) eval.Function {
 		if !ok {
 			return nil, fmt.Errorf(`expecting string value for query argument`)
 		}
-		return queryValueFromFile(path, query, get)
+		return queryValueFromFile(b.fileSystem, path, query, get)
 	}
 }
@@ -8,8 +8,11 @@ package checks
 import (
 	"context"
 	"errors"
+	"os"
 	"testing"
 
+	"github.com/DataDog/datadog-agent/pkg/collector/check"
+	"github.com/DataDog/datadog-agent/pkg/compliance"
 	"github.com/DataDog/datadog-agent/pkg/compliance/checks/env"
 	"github.com/DataDog/datadog-agent/pkg/compliance/eval"
 	"github.com/DataDog/datadog-agent/pkg/compliance/mocks"
@@ -220,3 +223,151 @@ func TestResolveValueFrom(t *testing.T) {
 		})
 	}
 }
+
+func TestWithScannedHost(t *testing.T) {
+	assert := assert.New(t)
+
+	reporter := &mocks.Reporter{}
+	b, err := NewBuilder(reporter, WithHostname("scanner"), WithScannedHost("scanned", "/mnt/scanned"))
+	assert.NoError(err)
+
+	env, ok := b.(env.Env)
+	assert.True(ok)
+	assert.Equal("scanned", env.Hostname())
+	assert.Equal("/mnt/scanned/etc/passwd", env.NormalizeToHostRoot("/etc/passwd"))
+
+	_, err = NewBuilder(reporter, WithScannedHost("", ""))
+	assert.Error(err)
+}
+
+func TestWithCommandAllowlist(t *testing.T) {
+	assert := assert.New(t)
+
+	executable, err := os.Executable()
+	assert.NoError(err)
+
+	reporter := &mocks.Reporter{}
+	b, err := NewBuilder(reporter)
+	assert.NoError(err)
+	assert.False(b.(env.Env).IsCommandAllowed(executable))
+
+	b, err = NewBuilder(reporter, WithCommandAllowlist([]string{executable}))
+	assert.NoError(err)
+	assert.True(b.(env.Env).IsCommandAllowed(executable))
+	assert.False(b.(env.Env).IsCommandAllowed(executable + "-missing"))
+
+	_, err = NewBuilder(reporter, WithCommandAllowlist([]string{"sh"}))
+	assert.Error(err)
+}
+
+func TestWithAllowedResourceKinds(t *testing.T) {
+	assert := assert.New(t)
+
+	dockerClient := &mocks.DockerClient{}
+	dockerClient.On("Close").Return(nil).Once()
+	defer dockerClient.AssertExpectations(t)
+
+	b, err := NewBuilder(nil, WithDryRun(), WithDockerClient(dockerClient), WithAllowedResourceKinds([]string{"file"}))
+	assert.NoError(err)
+	defer b.Close()
+
+	meta := &compliance.SuiteMeta{Name: "Allowed Kinds", Version: "1.0.0"}
+	fileResource := compliance.Resource{
+		File: &compliance.File{
+			Path: "/etc/docker/daemon.json",
+		},
+		Condition: "file.permissions == 0644",
+	}
+	commandResource := compliance.Resource{
+		Command: &compliance.Command{
+			BinaryCmd: &compliance.BinaryCmd{
+				Name: "/usr/bin/docker",
+				Args: []string{"version"},
+			},
+		},
+		Condition: `command.exitCode == 0`,
+	}
+
+	_, err = b.(*builder).checkFromRule(meta, &compliance.Rule{
+		ID:        "file",
+		Scope:     compliance.RuleScopeList{compliance.DockerScope},
+		Resources: []compliance.Resource{fileResource},
+	})
+	assert.NoError(err)
+
+	_, err = b.(*builder).checkFromRule(meta, &compliance.Rule{
+		ID:        "command",
+		Scope:     compliance.RuleScopeList{compliance.DockerScope},
+		Resources: []compliance.Resource{fileResource, commandResource},
+	})
+	assert.True(errors.Is(err, ErrResourceKindDisabled))
+	assert.EqualError(err, "resource type disabled by policy: command")
+
+	fileResource.Fallback = &compliance.Fallback{
+		Resource: commandResource,
+	}
+	_, err = b.(*builder).checkFromRule(meta, &compliance.Rule{
+		ID:        "command-fallback",
+		Scope:     compliance.RuleScopeList{compliance.DockerScope},
+		Resources: []compliance.Resource{fileResource},
+	})
+	assert.True(errors.Is(err, ErrResourceKindDisabled))
+}
+
+func TestValidateSuite(t *testing.T) {
+	assert := assert.New(t)
+
+	dockerClient := &mocks.DockerClient{}
+	dockerClient.On("Close").Return(nil).Once()
+	defer dockerClient.AssertExpectations(t)
+
+	validations, err := ValidateSuite("./testdata/suite/dry-run.yaml", WithDockerClient(dockerClient))
+	assert.NoError(err)
+	assert.Len(validations, 4)
+
+	assert.Equal("valid", validations[0].RuleID)
+	assert.NoError(validations[0].Err)
+	assert.False(validations[0].Inapplicable)
+
+	assert.Equal("bad-condition", validations[1].RuleID)
+	assert.Error(validations[1].Err)
+
+	assert.Equal("missing-kind", validations[2].RuleID)
+	assert.EqualError(validations[2].Err, "resource kind is missing or invalid")
+
+	assert.Equal("inapplicable", validations[3].RuleID)
+	assert.NoError(validations[3].Err)
+	assert.True(validations[3].Inapplicable)
+}
+
+func TestChecksFromSuite(t *testing.T) {
+	assert := assert.New(t)
+
+	dockerClient := &mocks.DockerClient{}
+	dockerClient.On("Close").Return(nil).Once()
+	defer dockerClient.AssertExpectations(t)
+
+	suite, err := compliance.ParseSuite("./testdata/suite/dry-run.yaml")
+	assert.NoError(err)
+
+	var rules []*compliance.Rule
+	for i := range suite.Rules {
+		rules = append(rules, &suite.Rules[i])
+	}
+
+	b, err := NewBuilder(nil, WithDryRun(), WithDockerClient(dockerClient))
+	assert.NoError(err)
+	defer b.Close()
+
+	checks, errs := b.ChecksFromSuite(&suite.Meta, rules)
+	assert.Len(checks, 1)
+	assert.Equal(check.ID("valid"), checks[0].ID())
+
+	// the inapplicable rule is skipped
+	assert.Len(errs, 2)
+	assert.Contains(errs[0].Error(), "rule bad-condition")
+	assert.EqualError(errs[1], "Dry Run/1.0.0: rule missing-kind: resource kind is missing or invalid")
+
+	statuses := b.GetCheckStatus()
+	assert.Len(statuses, 4)
+}
@@ -6,6 +6,9 @@
 package checks
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/DataDog/datadog-agent/pkg/autodiscovery/integration"
@@ -16,6 +19,9 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/util/log"
 )
 
+// ErrCheckTimeout is returned when a check doesn't complete within its timeout
+var ErrCheckTimeout = errors.New("check timed out")
+
 // eventNotify is a callback invoked when a compliance check reported an event
 type eventNotify func(ruleID string, event *event.Event)
 
@@ -26,14 +32,22 @@ type complianceCheck struct {
 	ruleID      string
 	description string
 	interval    time.Duration
+	// timeout bounds the duration of a run, no timeout is enforced when zero
+	timeout time.Duration
 
 	suiteMeta *compliance.SuiteMeta
 
 	resourceType string
 	resourceID   string
 
+	// host is set when the checks are evaluated for another host than the one running them
+	host string
+
 	checkable checkable
 
+	// dryRun checks are built from validated rules but never run
+	dryRun bool
+
 	eventNotify eventNotify
 }
 
@@ -77,7 +91,12 @@ func (c *complianceCheck) IsTelemetryEnabled() bool {
 }
 
 func (c *complianceCheck) Run() error {
-	report, err := c.checkable.check(c)
+	if c.dryRun {
+		log.Debugf("%s: dry run, not running check", c.ruleID)
+		return nil
+	}
+
+	report, err := c.runCheckable()
 	if err != nil {
 		log.Warnf("%s: check run failed: %v", c.ruleID, err)
 	}
@@ -87,6 +106,7 @@ func (c *complianceCheck) Run() error {
 		AgentRuleID:  c.ruleID,
 		ResourceID:   c.resourceID,
 		ResourceType: c.resourceType,
+		Host:         c.host,
 		Result:       result,
 		Data:         data,
 	}
@@ -101,6 +121,23 @@ func (c *complianceCheck) Run() error {
 	return err
 }
 
+// runCheckable evaluates the checkable of the check within its timeout. The timeout is enforced through the
+// context passed down to the resource resolvers, which abort their commands and requests once it expires.
+func (c *complianceCheck) runCheckable() (*compliance.Report, error) {
+	if c.timeout <= 0 {
+		return c.checkable.check(context.Background(), c)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	report, err := c.checkable.check(ctx, c)
+	if ctx.Err() == context.DeadlineExceeded {
+		return nil, fmt.Errorf("%w after %s", ErrCheckTimeout, c.timeout)
+	}
+	return report, err
+}
+
 func reportToEventData(report *compliance.Report, err error) (event.Data, string) {
 	var (
 		data   event.Data
@@ -119,6 +156,9 @@ func reportToEventData(report *compliance.Report, err error) (event.Data, string
 }
 
 func eventResult(passed bool, err error) string {
+	if errors.Is(err, ErrResourceNotApplicable) {
+		return event.NotApplicable
+	}
 	if err != nil {
 		return event.Error
 	}
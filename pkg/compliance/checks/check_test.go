@@ -6,12 +6,15 @@
 package checks
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/DataDog/datadog-agent/pkg/compliance"
 	"github.com/DataDog/datadog-agent/pkg/compliance/event"
 	"github.com/DataDog/datadog-agent/pkg/compliance/mocks"
+	"github.com/stretchr/testify/mock"
 	assert "github.com/stretchr/testify/require"
 )
 
@@ -26,6 +29,7 @@ func TestCheckRun(t *testing.T) {
 
 	tests := []struct {
 		name        string
+		host        string
 		configErr   error
 		checkReport *compliance.Report
 		checkErr    error
@@ -68,6 +72,20 @@ func TestCheckRun(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "scanned host",
+			host: "scanned-host",
+			checkReport: &compliance.Report{
+				Passed: true,
+			},
+			expectEvent: &event.Event{
+				AgentRuleID:  ruleID,
+				ResourceType: resourceType,
+				ResourceID:   resourceID,
+				Host:         "scanned-host",
+				Result:       "passed",
+			},
+		},
 		{
 			name:     "check error",
 			checkErr: errors.New("check error"),
@@ -82,6 +100,20 @@ func TestCheckRun(t *testing.T) {
 			},
 			expectErr: errors.New("check error"),
 		},
+		{
+			name:     "check not applicable",
+			checkErr: ErrResourceNotApplicable,
+			expectEvent: &event.Event{
+				AgentRuleID:  ruleID,
+				ResourceType: resourceType,
+				ResourceID:   resourceID,
+				Result:       "not_applicable",
+				Data: event.Data{
+					"error": "resource not applicable",
+				},
+			},
+			expectErr: ErrResourceNotApplicable,
+		},
 	}
 
 	for _, test := range tests {
@@ -101,13 +133,14 @@ func TestCheckRun(t *testing.T) {
 				ruleID:       ruleID,
 				resourceType: resourceType,
 				resourceID:   resourceID,
+				host:         test.host,
 				checkable:    checkable,
 			}
 
 			if test.configErr == nil {
 				env.On("Reporter").Return(reporter)
 				reporter.On("Report", test.expectEvent).Once()
-				checkable.On("check", check).Return(test.checkReport, test.checkErr)
+				checkable.On("check", mock.Anything, check).Return(test.checkReport, test.checkErr)
 			}
 
 			err := check.Run()
@@ -115,3 +148,43 @@ func TestCheckRun(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckRunTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	env := &mocks.Env{}
+	defer env.AssertExpectations(t)
+
+	reporter := &mocks.Reporter{}
+	defer reporter.AssertExpectations(t)
+
+	checkable := &mockCheckable{}
+
+	check := &complianceCheck{
+		Env: env,
+
+		ruleID:       "rule-id",
+		resourceType: "resource-type",
+		resourceID:   "resource-id",
+		timeout:      10 * time.Millisecond,
+		checkable:    checkable,
+	}
+
+	env.On("Reporter").Return(reporter)
+	reporter.On("Report", &event.Event{
+		AgentRuleID:  "rule-id",
+		ResourceType: "resource-type",
+		ResourceID:   "resource-id",
+		Result:       "error",
+		Data: event.Data{
+			"error": "check timed out after 10ms",
+		},
+	}).Once()
+	// the check is stuck until its context is cancelled
+	checkable.On("check", mock.Anything, check).Return(&compliance.Report{Passed: true}, nil).Run(func(args mock.Arguments) {
+		<-args.Get(0).(context.Context).Done()
+	})
+
+	err := check.Run()
+	assert.True(errors.Is(err, ErrCheckTimeout))
+}
@@ -6,13 +6,15 @@
 package checks
 
 import (
+	"context"
+
 	"github.com/DataDog/datadog-agent/pkg/compliance"
 	"github.com/DataDog/datadog-agent/pkg/compliance/checks/env"
 )
 
 // checkable abstracts a resource check
 type checkable interface {
-	check(env env.Env) (*compliance.Report, error)
+	check(ctx context.Context, env env.Env) (*compliance.Report, error)
 }
 
 // checkableList abstracts a list of resource checks
@@ -22,14 +24,14 @@ type checkableList []checkable
 // note that this implements AND for all checkables in a check:
 // failure or error from a single checkable fails the check, all checkables must
 // return Passed in order for the check to be successful.
-func (list checkableList) check(env env.Env) (*compliance.Report, error) {
+func (list checkableList) check(ctx context.Context, env env.Env) (*compliance.Report, error) {
 	var (
 		result *compliance.Report
 		err    error
 	)
 
 	for _, c := range list {
-		result, err = c.check(env)
+		result, err = c.check(ctx, env)
 		if err != nil || !result.Passed {
 			break
 		}
@@ -6,12 +6,14 @@
 package checks
 
 import (
+	"context"
 	"errors"
 	"testing"
 
 	"github.com/DataDog/datadog-agent/pkg/compliance"
 	"github.com/DataDog/datadog-agent/pkg/compliance/event"
 	"github.com/DataDog/datadog-agent/pkg/compliance/mocks"
+	"github.com/stretchr/testify/mock"
 	assert "github.com/stretchr/testify/require"
 )
 
@@ -139,11 +141,11 @@ func TestCheckableList(t *testing.T) {
 
 			for _, outcome := range test.list {
 				c := &mockCheckable{}
-				c.On("check", env).Return(outcome.report, outcome.err)
+				c.On("check", mock.Anything, env).Return(outcome.report, outcome.err)
 				list = append(list, c)
 			}
 
-			report, err := list.check(env)
+			report, err := list.check(context.Background(), env)
 			assert.Equal(test.expected.report, report)
 			assert.Equal(test.expected.err, err)
 		})
@@ -24,7 +24,7 @@ var commandReportedFields = []string{
 	compliance.CommandFieldExitCode,
 }
 
-func resolveCommand(ctx context.Context, _ env.Env, ruleID string, res compliance.Resource) (interface{}, error) {
+func resolveCommand(ctx context.Context, e env.Env, ruleID string, res compliance.Resource) (interface{}, error) {
 	if res.Command == nil {
 		return nil, fmt.Errorf("%s: expecting command resource in command check", ruleID)
 	}
@@ -45,6 +45,10 @@ func resolveCommand(ctx context.Context, _ env.Env, ruleID string, res complianc
 		execCommand = shellCmdToBinaryCmd(command.ShellCmd)
 	}
 
+	if !e.IsCommandAllowed(execCommand.Name) {
+		return nil, fmt.Errorf("%s: command '%s' is not in the command check allowlist", ruleID, execCommand.Name)
+	}
+
 	commandTimeout := defaultTimeout
 	if command.TimeoutSeconds != 0 {
 		commandTimeout = time.Duration(command.TimeoutSeconds) * time.Second
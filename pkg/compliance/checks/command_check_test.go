@@ -25,9 +25,10 @@ type commandFixture struct {
 
 	resource compliance.Resource
 
-	commandExitCode int
-	commandOutput   string
-	commandError    error
+	commandNotAllowed bool
+	commandExitCode   int
+	commandOutput     string
+	commandError      error
 
 	expectCommandName string
 	expectCommandArgs []string
@@ -53,10 +54,12 @@ func (f *commandFixture) run(t *testing.T) {
 	env := &mocks.Env{}
 	defer env.AssertExpectations(t)
 
+	env.On("IsCommandAllowed", f.expectCommandName).Return(!f.commandNotAllowed)
+
 	commandCheck, err := newResourceCheck(env, "rule-id", f.resource)
 	assert.NoError(err)
 
-	result, err := commandCheck.check(env)
+	result, err := commandCheck.check(context.Background(), env)
 	assert.Equal(f.expectReport, result)
 	assert.Equal(f.expectError, err)
 
@@ -152,6 +155,21 @@ func TestCommandCheck(t *testing.T) {
 			expectCommandArgs: []string{"--foo=bar", "--baz"},
 			expectError:       fmt.Errorf("command 'Binary command: myCommand, args: [--foo=bar --baz]' execution failed, error: some failure"),
 		},
+		{
+			name: "binary not allowed",
+			resource: compliance.Resource{
+				Command: &compliance.Command{
+					BinaryCmd: &compliance.BinaryCmd{
+						Name: "myCommand",
+						Args: []string{"--foo=bar", "--baz"},
+					},
+				},
+				Condition: `command.stdout == "output"`,
+			},
+			commandNotAllowed: true,
+			expectCommandName: "myCommand",
+			expectError:       errors.New("rule-id: command 'myCommand' is not in the command check allowlist"),
+		},
 		{
 			name: "non-zero return code",
 			resource: compliance.Resource{
@@ -0,0 +1,236 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package checks
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/checks/env"
+	"github.com/DataDog/datadog-agent/pkg/compliance/eval"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+const (
+	cronTypeCrontab   = "crontab"
+	cronTypeCronD     = "cron.d"
+	cronTypeCrontabs  = "crontabs"
+	cronTypeDirectory = "directory"
+	cronTypeCronAllow = "cron.allow"
+	cronTypeCronDeny  = "cron.deny"
+	cronTypeAtAllow   = "at.allow"
+	cronTypeAtDeny    = "at.deny"
+)
+
+// cronFile describes a type of cron configuration file and the paths where the distributions put it
+type cronFile struct {
+	fileType string
+	patterns []string
+	// dir tells whether the paths are directories
+	dir bool
+	// at tells whether the file belongs to at rather than cron
+	at bool
+	// reportMissing adds an instance for the file when it doesn't exist, as for the allow and deny
+	// files whose absence changes who is allowed to schedule jobs
+	reportMissing bool
+}
+
+var cronFiles = []cronFile{
+	{fileType: cronTypeCrontab, patterns: []string{"/etc/crontab"}},
+	{fileType: cronTypeCronD, patterns: []string{"/etc/cron.d/*"}},
+	// busybox crond, on Alpine for instance, only reads the crontabs of /etc/crontabs
+	{fileType: cronTypeCrontabs, patterns: []string{"/etc/crontabs/*"}},
+	{fileType: cronTypeDirectory, dir: true, patterns: []string{
+		"/etc/cron.d",
+		"/etc/cron.hourly",
+		"/etc/cron.daily",
+		"/etc/cron.weekly",
+		"/etc/cron.monthly",
+		"/etc/crontabs",
+	}},
+	{fileType: cronTypeCronAllow, patterns: []string{"/etc/cron.allow"}, reportMissing: true},
+	{fileType: cronTypeCronDeny, patterns: []string{"/etc/cron.deny"}, reportMissing: true},
+	{fileType: cronTypeAtAllow, patterns: []string{"/etc/at.allow"}, at: true, reportMissing: true},
+	{fileType: cronTypeAtDeny, patterns: []string{"/etc/at.deny"}, at: true, reportMissing: true},
+}
+
+// cronInstalledPaths are the paths of which at least one exists when a cron daemon is installed
+var cronInstalledPaths = []string{
+	"/etc/crontab",
+	"/etc/cron.d",
+	"/etc/crontabs",
+	"/var/spool/cron/crontabs",
+}
+
+// atInstalledPaths are the paths of which at least one exists when at is installed, its spool
+// directory is /var/spool/cron/atjobs on Debian and /var/spool/at on Red Hat
+var atInstalledPaths = []string{
+	"/etc/at.allow",
+	"/etc/at.deny",
+	"/var/spool/cron/atjobs",
+	"/var/spool/at",
+}
+
+var cronReportedFields = []string{
+	compliance.CronFieldPath,
+	compliance.CronFieldType,
+	compliance.CronFieldExists,
+	compliance.CronFieldPermissions,
+	compliance.CronFieldUser,
+	compliance.CronFieldGroup,
+	compliance.CronFieldMatched,
+	compliance.CronFieldLine,
+}
+
+// resolveCron returns an instance for every cron configuration file found on the host, the allow and deny
+// files of cron and at are always reported, even when missing. The resource is not applicable when cron
+// is not installed, the files of at are skipped when at is not installed.
+func resolveCron(_ context.Context, e env.Env, ruleID string, res compliance.Resource) (interface{}, error) {
+	if res.Cron == nil {
+		return nil, fmt.Errorf("%s: expecting cron resource in cron check", ruleID)
+	}
+
+	cron := res.Cron
+
+	if err := cron.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", ruleID, err)
+	}
+
+	var re *regexp.Regexp
+	if cron.Entry != "" {
+		re = regexp.MustCompile(cron.Entry)
+	}
+
+	log.Debugf("%s: running cron check for %q", ruleID, cron.Entry)
+
+	fs := e.FileSystem()
+
+	if !anyPathExists(e, fs, cronInstalledPaths) {
+		return nil, fmt.Errorf("%w: cron is not installed", ErrResourceNotApplicable)
+	}
+	atInstalled := anyPathExists(e, fs, atInstalledPaths)
+
+	var instances []*eval.Instance
+	for _, file := range cronFiles {
+		if file.at && !atInstalled {
+			continue
+		}
+
+		found := false
+		for _, pattern := range file.patterns {
+			paths, err := fs.Glob(e.NormalizeToHostRoot(pattern))
+			if err != nil {
+				return nil, err
+			}
+
+			for _, path := range paths {
+				if !file.dir && isIgnoredCronFile(filepath.Base(path)) {
+					continue
+				}
+
+				fi, err := fs.Stat(path)
+				if err != nil {
+					log.Debugf("%s: cron check failed to stat %s: %v", ruleID, path, err)
+					continue
+				}
+				if fi.IsDir() != file.dir {
+					continue
+				}
+
+				instance, err := newCronInstance(fs, file.fileType, path, e.RelativeToHostRoot(path), fi, re)
+				if err != nil {
+					return nil, fmt.Errorf("%s: cron check failed to read %s: %w", ruleID, path, err)
+				}
+				instances = append(instances, instance)
+				found = true
+			}
+		}
+
+		if !found && file.reportMissing {
+			instances = append(instances, &eval.Instance{
+				Vars: eval.VarMap{
+					compliance.CronFieldPath:        file.patterns[0],
+					compliance.CronFieldType:        file.fileType,
+					compliance.CronFieldExists:      false,
+					compliance.CronFieldPermissions: uint64(0),
+					compliance.CronFieldUser:        "",
+					compliance.CronFieldGroup:       "",
+					compliance.CronFieldMatched:     false,
+				},
+			})
+		}
+	}
+
+	return &instanceIterator{
+		instances: instances,
+	}, nil
+}
+
+// newCronInstance returns the instance of a cron configuration file, the first line matching
+// the entry regexp, if any, is reported
+func newCronInstance(fs env.FileSystem, fileType, path, relPath string, fi os.FileInfo, re *regexp.Regexp) (*eval.Instance, error) {
+	instance := &eval.Instance{
+		Vars: eval.VarMap{
+			compliance.CronFieldPath:        relPath,
+			compliance.CronFieldType:        fileType,
+			compliance.CronFieldExists:      true,
+			compliance.CronFieldPermissions: uint64(fi.Mode() & os.ModePerm),
+			compliance.CronFieldMatched:     false,
+		},
+	}
+
+	user, _ := getFileUser(fi)
+	instance.Vars[compliance.CronFieldUser] = user
+
+	group, _ := getFileGroup(fi)
+	instance.Vars[compliance.CronFieldGroup] = group
+
+	if re == nil || fi.IsDir() {
+		return instance, nil
+	}
+
+	data, err := readFile(fs, path)
+	if err != nil {
+		return nil, err
+	}
+
+	if line, _, found := matchConfigLine(re, data); found {
+		instance.Vars[compliance.CronFieldMatched] = true
+		instance.Vars[compliance.CronFieldLine] = line
+	}
+
+	return instance, nil
+}
+
+// isIgnoredCronFile returns whether cron skips a file of its directories. Debian's cron ignores the names
+// with a dot, cronie the hidden files, the backups and the leftovers of the package managers.
+func isIgnoredCronFile(name string) bool {
+	if strings.HasPrefix(name, ".") || strings.HasSuffix(name, "~") {
+		return true
+	}
+
+	for _, suffix := range []string{".rpmsave", ".rpmorig", ".rpmnew", ".dpkg-old", ".dpkg-dist", ".dpkg-new"} {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// anyPathExists returns whether any of the paths exists on the host
+func anyPathExists(e env.Env, fs env.FileSystem, paths []string) bool {
+	for _, path := range paths {
+		if _, err := fs.Stat(e.NormalizeToHostRoot(path)); err == nil {
+			return true
+		}
+	}
+	return false
+}
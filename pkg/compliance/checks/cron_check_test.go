@@ -0,0 +1,215 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build !windows
+
+package checks
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/event"
+	"github.com/DataDog/datadog-agent/pkg/compliance/mocks"
+
+	"github.com/stretchr/testify/mock"
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestCronCheck(t *testing.T) {
+	debianFs := memFileSystem{
+		"/etc": {mode: os.ModeDir | 0755},
+		"/etc/crontab": {content: `SHELL=/bin/sh
+# m h dom mon dow user	command
+17 *	* * *	root    cd / && run-parts --report /etc/cron.hourly
+`, mode: 0644, user: "root", group: "root"},
+		"/etc/cron.d":                   {mode: os.ModeDir | 0755, user: "root", group: "root"},
+		"/etc/cron.d/.placeholder":      {content: "# DO NOT EDIT OR REMOVE\n", mode: 0644, user: "root", group: "root"},
+		"/etc/cron.d/backup":            {content: "0 2 * * * root /usr/local/bin/backup.sh\n", mode: 0600, user: "root", group: "root"},
+		"/etc/cron.d/backup.dpkg-old":   {content: "0 2 * * * nobody /tmp/backup.sh\n", mode: 0666, user: "root", group: "root"},
+		"/etc/cron.hourly":              {mode: os.ModeDir | 0700, user: "root", group: "root"},
+		"/etc/cron.deny":                {mode: 0600, user: "root", group: "root"},
+		"/var":                          {mode: os.ModeDir | 0755},
+		"/var/spool":                    {mode: os.ModeDir | 0755},
+		"/var/spool/cron":               {mode: os.ModeDir | 0755},
+		"/var/spool/cron/crontabs":      {mode: os.ModeDir | 01730},
+		"/var/spool/cron/atjobs":        {mode: os.ModeDir | 01770},
+		"/var/spool/cron/crontabs/root": {content: "* * * * * /usr/bin/true\n", mode: 0600, user: "root", group: "crontab"},
+	}
+
+	alpineFs := memFileSystem{
+		"/etc":               {mode: os.ModeDir | 0755},
+		"/etc/crontabs":      {mode: os.ModeDir | 0755, user: "root", group: "root"},
+		"/etc/crontabs/root": {content: "*/15 * * * * run-parts /etc/periodic/15min\n", mode: 0600, user: "root", group: "root"},
+	}
+
+	tests := []struct {
+		name                string
+		fs                  memFileSystem
+		resource            compliance.Resource
+		expectReport        *compliance.Report
+		expectNotApplicable bool
+	}{
+		{
+			name: "crontab permissions",
+			fs:   debianFs,
+			resource: compliance.Resource{
+				Cron:      &compliance.Cron{},
+				Condition: `cron.type == "cron.allow" || cron.type == "cron.deny" || cron.type == "at.allow" || cron.type == "at.deny" || cron.permissions & 0077 == 0`,
+			},
+			expectReport: &compliance.Report{
+				Passed: false,
+				Data: event.Data{
+					"cron.path":        "/etc/crontab",
+					"cron.type":        "crontab",
+					"cron.exists":      true,
+					"cron.permissions": uint64(0644),
+					"cron.user":        "root",
+					"cron.group":       "root",
+					"cron.matched":     false,
+				},
+			},
+		},
+		{
+			name: "job entry",
+			fs:   debianFs,
+			resource: compliance.Resource{
+				Cron: &compliance.Cron{
+					Entry: `/(tmp|usr/local/bin)/`,
+				},
+				Condition: `!cron.matched`,
+			},
+			expectReport: &compliance.Report{
+				Passed: false,
+				Data: event.Data{
+					"cron.path":        "/etc/cron.d/backup",
+					"cron.type":        "cron.d",
+					"cron.exists":      true,
+					"cron.permissions": uint64(0600),
+					"cron.user":        "root",
+					"cron.group":       "root",
+					"cron.matched":     true,
+					"cron.line":        "0 2 * * * root /usr/local/bin/backup.sh",
+				},
+			},
+		},
+		{
+			name: "cron restricted to allowed users",
+			fs:   debianFs,
+			resource: compliance.Resource{
+				Cron:      &compliance.Cron{},
+				Condition: `cron.type != "cron.allow" || cron.exists`,
+			},
+			expectReport: &compliance.Report{
+				Passed: false,
+				Data: event.Data{
+					"cron.path":        "/etc/cron.allow",
+					"cron.type":        "cron.allow",
+					"cron.exists":      false,
+					"cron.permissions": uint64(0),
+					"cron.user":        "",
+					"cron.group":       "",
+					"cron.matched":     false,
+				},
+			},
+		},
+		{
+			name: "at installed",
+			fs:   debianFs,
+			resource: compliance.Resource{
+				Cron:      &compliance.Cron{},
+				Condition: `cron.type != "at.allow" || cron.exists`,
+			},
+			expectReport: &compliance.Report{
+				Passed: false,
+				Data: event.Data{
+					"cron.path":        "/etc/at.allow",
+					"cron.type":        "at.allow",
+					"cron.exists":      false,
+					"cron.permissions": uint64(0),
+					"cron.user":        "",
+					"cron.group":       "",
+					"cron.matched":     false,
+				},
+			},
+		},
+		{
+			name: "busybox crontabs",
+			fs:   alpineFs,
+			resource: compliance.Resource{
+				Cron:      &compliance.Cron{},
+				Condition: `cron.type == "cron.allow" || cron.type == "cron.deny" || cron.user == "root"`,
+			},
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					"cron.path":        "/etc/crontabs/root",
+					"cron.type":        "crontabs",
+					"cron.exists":      true,
+					"cron.permissions": uint64(0600),
+					"cron.user":        "root",
+					"cron.group":       "root",
+					"cron.matched":     false,
+				},
+			},
+		},
+		{
+			name: "at not installed",
+			fs:   alpineFs,
+			resource: compliance.Resource{
+				Cron:      &compliance.Cron{},
+				Condition: `cron.type != "at.allow" || cron.exists`,
+			},
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					"cron.path":        "/etc/crontabs/root",
+					"cron.type":        "crontabs",
+					"cron.exists":      true,
+					"cron.permissions": uint64(0600),
+					"cron.user":        "root",
+					"cron.group":       "root",
+					"cron.matched":     false,
+				},
+			},
+		},
+		{
+			name: "cron not installed",
+			fs: memFileSystem{
+				"/etc": {mode: os.ModeDir | 0755},
+			},
+			resource: compliance.Resource{
+				Cron:      &compliance.Cron{},
+				Condition: `cron.exists`,
+			},
+			expectNotApplicable: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			env := &mocks.Env{}
+			env.On("FileSystem").Return(test.fs)
+			env.On("NormalizeToHostRoot", mock.Anything).Return(func(path string) string { return path })
+			env.On("RelativeToHostRoot", mock.Anything).Return(func(path string) string { return path })
+
+			cronCheck, err := newResourceCheck(env, "rule-id", test.resource)
+			assert.NoError(err)
+
+			report, err := cronCheck.check(context.Background(), env)
+			if test.expectNotApplicable {
+				assert.True(errors.Is(err, ErrResourceNotApplicable))
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(test.expectReport, report)
+		})
+	}
+}
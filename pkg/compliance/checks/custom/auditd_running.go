@@ -0,0 +1,111 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package custom
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/checks/env"
+	"github.com/DataDog/datadog-agent/pkg/compliance/eval"
+	"github.com/DataDog/datadog-agent/pkg/compliance/event"
+)
+
+const (
+	auditdProcessName      = "auditd"
+	auditdCommPath         = "/proc/%d/comm"
+	auditFailureModeVar    = "failureMode"
+	auditFailurePrintk     = 1
+	auditReasonNotRunning  = "auditd is not running"
+	auditReasonDisabled    = "kernel auditing is disabled"
+	auditReasonFailureMode = "failure mode is %d, expected at least %d"
+)
+
+func init() {
+	registerCustomCheck("auditdRunning", auditdRunningCheck)
+}
+
+// auditdRunningCheck checks that the audit subsystem is active: auditd is registered with the kernel as
+// the audit daemon and still running, kernel auditing is enabled and its failure mode is at least the
+// one set by the failureMode variable, 1 (printk) by default. The reason of the first failed requirement
+// is reported in auditd.reason, the check passes when there is none if the rule has no condition.
+func auditdRunningCheck(e env.Env, ruleID string, vars map[string]string, expr *eval.IterableExpression) (*compliance.Report, error) {
+	client := e.AuditClient()
+	if client == nil {
+		return nil, fmt.Errorf("%w: audit client not configured", compliance.ErrResourceNotApplicable)
+	}
+
+	expectedFailureMode := uint32(auditFailurePrintk)
+	if v, ok := vars[auditFailureModeVar]; ok {
+		mode, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s variable %q for rule: %s - err: %v", auditFailureModeVar, v, ruleID, err)
+		}
+		expectedFailureMode = uint32(mode)
+	}
+
+	status, err := client.GetStatus()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get audit status - rule: %s - err: %v", ruleID, err)
+	}
+
+	running, err := isAuditdRunning(e, status.PID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to check auditd process - rule: %s - err: %v", ruleID, err)
+	}
+
+	var reason string
+	switch {
+	case !running:
+		reason = auditReasonNotRunning
+	case status.Enabled == 0:
+		reason = auditReasonDisabled
+	case status.Failure < expectedFailureMode:
+		reason = fmt.Sprintf(auditReasonFailureMode, status.Failure, expectedFailureMode)
+	}
+
+	instance := &eval.Instance{
+		Vars: eval.VarMap{
+			compliance.AuditdFieldRunning:     running,
+			compliance.AuditdFieldPID:         int(status.PID),
+			compliance.AuditdFieldEnabled:     status.Enabled != 0,
+			compliance.AuditdFieldLocked:      status.Enabled == 2,
+			compliance.AuditdFieldFailureMode: int(status.Failure),
+			compliance.AuditdFieldReason:      reason,
+		},
+	}
+
+	passed := reason == ""
+	if expr != nil {
+		if passed, err = expr.Evaluate(instance); err != nil {
+			return nil, err
+		}
+	}
+
+	return &compliance.Report{
+		Passed: passed,
+		Data: event.Data{
+			compliance.AuditdFieldRunning:     running,
+			compliance.AuditdFieldEnabled:     status.Enabled != 0,
+			compliance.AuditdFieldFailureMode: int(status.Failure),
+			compliance.AuditdFieldReason:      reason,
+		},
+	}, nil
+}
+
+// isAuditdRunning returns whether the process registered as the audit daemon is still an auditd process
+func isAuditdRunning(e env.Env, pid uint32) (bool, error) {
+	if pid == 0 {
+		return false, nil
+	}
+
+	comm, found, err := readKernelFile(e, fmt.Sprintf(auditdCommPath, pid))
+	if err != nil {
+		return false, err
+	}
+	return found && comm == auditdProcessName, nil
+}
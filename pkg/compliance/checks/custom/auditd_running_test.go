@@ -0,0 +1,165 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package custom
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/checks/env"
+	"github.com/DataDog/datadog-agent/pkg/compliance/event"
+	"github.com/DataDog/datadog-agent/pkg/compliance/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuditdRunning(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       *env.AuditStatus
+		vars         map[string]string
+		files        map[string]string
+		expectReport *compliance.Report
+		expectError  bool
+	}{
+		{
+			name:   "running",
+			status: &env.AuditStatus{Enabled: 1, Failure: 1, PID: 512},
+			files: map[string]string{
+				"/proc/512/comm": "auditd\n",
+			},
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					compliance.AuditdFieldRunning:     true,
+					compliance.AuditdFieldEnabled:     true,
+					compliance.AuditdFieldFailureMode: 1,
+					compliance.AuditdFieldReason:      "",
+				},
+			},
+		},
+		{
+			name:   "no audit daemon",
+			status: &env.AuditStatus{Enabled: 1, Failure: 1},
+			expectReport: &compliance.Report{
+				Passed: false,
+				Data: event.Data{
+					compliance.AuditdFieldRunning:     false,
+					compliance.AuditdFieldEnabled:     true,
+					compliance.AuditdFieldFailureMode: 1,
+					compliance.AuditdFieldReason:      "auditd is not running",
+				},
+			},
+		},
+		{
+			name:   "audit daemon gone",
+			status: &env.AuditStatus{Enabled: 1, Failure: 1, PID: 512},
+			files: map[string]string{
+				"/proc/512/comm": "bash\n",
+			},
+			expectReport: &compliance.Report{
+				Passed: false,
+				Data: event.Data{
+					compliance.AuditdFieldRunning:     false,
+					compliance.AuditdFieldEnabled:     true,
+					compliance.AuditdFieldFailureMode: 1,
+					compliance.AuditdFieldReason:      "auditd is not running",
+				},
+			},
+		},
+		{
+			name:   "auditing disabled",
+			status: &env.AuditStatus{Enabled: 0, Failure: 1, PID: 512},
+			files: map[string]string{
+				"/proc/512/comm": "auditd\n",
+			},
+			expectReport: &compliance.Report{
+				Passed: false,
+				Data: event.Data{
+					compliance.AuditdFieldRunning:     true,
+					compliance.AuditdFieldEnabled:     false,
+					compliance.AuditdFieldFailureMode: 1,
+					compliance.AuditdFieldReason:      "kernel auditing is disabled",
+				},
+			},
+		},
+		{
+			name:   "wrong failure mode",
+			status: &env.AuditStatus{Enabled: 2, Failure: 1, PID: 512},
+			vars:   map[string]string{"failureMode": "2"},
+			files: map[string]string{
+				"/proc/512/comm": "auditd\n",
+			},
+			expectReport: &compliance.Report{
+				Passed: false,
+				Data: event.Data{
+					compliance.AuditdFieldRunning:     true,
+					compliance.AuditdFieldEnabled:     true,
+					compliance.AuditdFieldFailureMode: 1,
+					compliance.AuditdFieldReason:      "failure mode is 1, expected at least 2",
+				},
+			},
+		},
+		{
+			name:        "invalid failure mode",
+			status:      &env.AuditStatus{Enabled: 1, Failure: 1, PID: 512},
+			vars:        map[string]string{"failureMode": "panic"},
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			fs := &mocks.FileSystem{}
+			fs.On("Open", mock.Anything).Return(
+				func(name string) io.ReadCloser {
+					if content, ok := test.files[name]; ok {
+						return ioutil.NopCloser(strings.NewReader(content))
+					}
+					return nil
+				},
+				func(name string) error {
+					if _, ok := test.files[name]; ok {
+						return nil
+					}
+					return &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+				},
+			)
+
+			client := &mocks.AuditClient{}
+			client.On("GetStatus").Return(test.status, nil)
+
+			env := &mocks.Env{}
+			env.On("AuditClient").Return(client)
+			env.On("FileSystem").Return(fs)
+			env.On("NormalizeToHostRoot", mock.Anything).Return(func(path string) string { return path })
+
+			report, err := auditdRunningCheck(env, "rule-id", test.vars, nil)
+			if test.expectError {
+				assert.Error(err)
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(test.expectReport, report)
+		})
+	}
+}
+
+func TestAuditdRunningNoClient(t *testing.T) {
+	env := &mocks.Env{}
+	env.On("AuditClient").Return(nil)
+
+	_, err := auditdRunningCheck(env, "rule-id", nil, nil)
+	assert.True(t, errors.Is(err, compliance.ErrResourceNotApplicable))
+}
@@ -0,0 +1,157 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package custom
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/checks/env"
+	"github.com/DataDog/datadog-agent/pkg/compliance/eval"
+	"github.com/DataDog/datadog-agent/pkg/compliance/event"
+	"github.com/DataDog/datadog-agent/pkg/util/jsonquery"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+	"github.com/Masterminds/sprig"
+	dockerclient "github.com/docker/docker/client"
+)
+
+const (
+	dockerDaemonConfigPath    = "/etc/docker/daemon.json"
+	dockerDaemonInfoTimeout   = 30 * time.Second
+	dockerDaemonSourceDaemon  = "daemon"
+	dockerDaemonSourceConfig  = "config"
+	dockerDaemonSourceDefault = "default"
+)
+
+func init() {
+	registerCustomCheck("dockerDaemonConfig", dockerDaemonConfigCheck)
+}
+
+// dockerDaemonConfigCheck checks a setting of the docker daemon both in its configuration file,
+// with the `option` jq query on `path` (defaults to /etc/docker/daemon.json), and in the running
+// daemon, with the `template` go template evaluated on the daemon info.
+// The running daemon wins as its command line flags override the configuration file and the file
+// is only applied on restart, the file is used when the daemon cannot be reached.
+func dockerDaemonConfigCheck(e env.Env, ruleID string, vars map[string]string, expr *eval.IterableExpression) (*compliance.Report, error) {
+	if expr == nil {
+		return nil, fmt.Errorf("unable to run dockerDaemonConfig check for rule: %s - missing condition", ruleID)
+	}
+
+	option, tmpl := vars["option"], vars["template"]
+	if option == "" || tmpl == "" {
+		return nil, fmt.Errorf("unable to run dockerDaemonConfig check for rule: %s - option and template variables are required", ruleID)
+	}
+
+	path := vars["path"]
+	if path == "" {
+		path = dockerDaemonConfigPath
+	}
+
+	configValue, configFound, err := dockerDaemonConfigValue(e, path, option)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read docker daemon configuration - rule: %s - err: %v", ruleID, err)
+	}
+
+	runtimeValue, runtimeFound, err := dockerDaemonRuntimeValue(e, tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read docker daemon info - rule: %s - err: %v", ruleID, err)
+	}
+
+	value, source := "", dockerDaemonSourceDefault
+	switch {
+	case runtimeFound:
+		value, source = runtimeValue, dockerDaemonSourceDaemon
+		if configFound && configValue != runtimeValue {
+			log.Infof("%s: docker daemon runs with %q while %s sets %q", ruleID, runtimeValue, path, configValue)
+		}
+	case configFound:
+		value, source = configValue, dockerDaemonSourceConfig
+	}
+
+	instance := &eval.Instance{
+		Vars: eval.VarMap{
+			compliance.DockerDaemonFieldConfigValue:  configValue,
+			compliance.DockerDaemonFieldRuntimeValue: runtimeValue,
+			compliance.DockerDaemonFieldValue:        value,
+			compliance.DockerDaemonFieldSource:       source,
+		},
+	}
+
+	passed, err := expr.Evaluate(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &compliance.Report{
+		Passed: passed,
+		Data: event.Data{
+			compliance.DockerDaemonFieldValue:  value,
+			compliance.DockerDaemonFieldSource: source,
+		},
+	}, nil
+}
+
+// dockerDaemonConfigValue queries the docker daemon configuration file, a missing file or option is not an error
+func dockerDaemonConfigValue(e env.Env, path, query string) (string, bool, error) {
+	f, err := e.FileSystem().Open(e.NormalizeToHostRoot(path))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	defer f.Close()
+
+	data, err := ioutil.ReadAll(f)
+	if err != nil {
+		return "", false, err
+	}
+
+	var content interface{}
+	if err := json.Unmarshal(data, &content); err != nil {
+		return "", false, err
+	}
+	return jsonquery.RunSingleOutput(query, content)
+}
+
+// dockerDaemonRuntimeValue evaluates a template on the info of the running docker daemon,
+// nothing is found when the daemon cannot be reached
+func dockerDaemonRuntimeValue(e env.Env, tmpl string) (string, bool, error) {
+	client := e.DockerClient()
+	if client == nil {
+		return "", false, nil
+	}
+
+	t, err := template.New("tmpl").Funcs(sprig.TxtFuncMap()).Parse(tmpl)
+	if err != nil {
+		return "", false, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), dockerDaemonInfoTimeout)
+	defer cancel()
+
+	info, err := client.Info(ctx)
+	if err != nil {
+		if dockerclient.IsErrConnectionFailed(err) {
+			log.Debugf("docker daemon not reachable, using its configuration file: %v", err)
+			return "", false, nil
+		}
+		return "", false, err
+	}
+
+	b := &strings.Builder{}
+	if err := t.Execute(b, info); err != nil {
+		return "", false, err
+	}
+	return b.String(), b.Len() != 0, nil
+}
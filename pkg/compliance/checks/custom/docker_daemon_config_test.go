@@ -0,0 +1,105 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package custom
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/eval"
+	"github.com/DataDog/datadog-agent/pkg/compliance/event"
+	"github.com/DataDog/datadog-agent/pkg/compliance/mocks"
+	"github.com/docker/docker/api/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDockerDaemonConfig(t *testing.T) {
+	vars := map[string]string{
+		"option":   `."live-restore"`,
+		"template": "{{ .LiveRestoreEnabled }}",
+	}
+
+	tests := []struct {
+		name         string
+		config       string
+		info         *types.Info
+		expectReport *compliance.Report
+	}{
+		{
+			name:   "daemon overrides configuration file",
+			config: `{"live-restore": false}`,
+			info:   &types.Info{LiveRestoreEnabled: true},
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					compliance.DockerDaemonFieldValue:  "true",
+					compliance.DockerDaemonFieldSource: "daemon",
+				},
+			},
+		},
+		{
+			name:   "daemon not reachable",
+			config: `{"live-restore": true}`,
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					compliance.DockerDaemonFieldValue:  "true",
+					compliance.DockerDaemonFieldSource: "config",
+				},
+			},
+		},
+		{
+			name: "no configuration file and daemon not reachable",
+			expectReport: &compliance.Report{
+				Passed: false,
+				Data: event.Data{
+					compliance.DockerDaemonFieldValue:  "",
+					compliance.DockerDaemonFieldSource: "default",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			fs := &mocks.FileSystem{}
+			defer fs.AssertExpectations(t)
+			if test.config != "" {
+				fs.On("Open", "/host/etc/docker/daemon.json").Return(ioutil.NopCloser(strings.NewReader(test.config)), nil)
+			} else {
+				fs.On("Open", "/host/etc/docker/daemon.json").Return(nil, &os.PathError{Op: "open", Path: "/host/etc/docker/daemon.json", Err: os.ErrNotExist})
+			}
+
+			env := &mocks.Env{}
+			defer env.AssertExpectations(t)
+			env.On("FileSystem").Return(fs)
+			env.On("NormalizeToHostRoot", "/etc/docker/daemon.json").Return("/host/etc/docker/daemon.json")
+
+			if test.info != nil {
+				client := &mocks.DockerClient{}
+				defer client.AssertExpectations(t)
+				client.On("Info", mock.Anything).Return(*test.info, nil)
+				env.On("DockerClient").Return(client)
+			} else {
+				env.On("DockerClient").Return(nil)
+			}
+
+			expr, err := eval.ParseIterable(`daemon.value == "true"`)
+			assert.NoError(err)
+
+			report, err := dockerDaemonConfigCheck(env, "rule-id", vars, expr)
+			assert.NoError(err)
+			assert.Equal(test.expectReport, report)
+		})
+	}
+}
@@ -0,0 +1,183 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package custom
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/checks/env"
+	"github.com/DataDog/datadog-agent/pkg/compliance/eval"
+	"github.com/DataDog/datadog-agent/pkg/compliance/event"
+)
+
+const (
+	moduleSigEnforcePath      = "/sys/module/module/parameters/sig_enforce"
+	modulesDisabledPath       = "/proc/sys/kernel/modules_disabled"
+	kernelReleasePath         = "/proc/sys/kernel/osrelease"
+	kernelConfigProcPath      = "/proc/config.gz"
+	kernelConfigBootPath      = "/boot/config-%s"
+	kernelConfigSigForce      = "CONFIG_MODULE_SIG_FORCE"
+	moduleSigningSourceSysfs  = "sysfs"
+	moduleSigningSourceConfig = "kernelConfig"
+)
+
+func init() {
+	registerCustomCheck("kernelModuleSigning", kernelModuleSigningCheck)
+}
+
+// kernelModuleSigningCheck checks whether the kernel only loads signed modules. The running kernel
+// exposes it in /sys/module/module/parameters/sig_enforce, which accounts for the module.sig_enforce
+// boot parameter, the kernel configuration (CONFIG_MODULE_SIG_FORCE) is used when the parameter is missing.
+// The check is not applicable when none of them can be read.
+func kernelModuleSigningCheck(e env.Env, ruleID string, vars map[string]string, expr *eval.IterableExpression) (*compliance.Report, error) {
+	if expr == nil {
+		return nil, fmt.Errorf("unable to run kernelModuleSigning check for rule: %s - missing condition", ruleID)
+	}
+
+	enabled, source, err := kernelModuleSigningEnabled(e)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read kernel module signing settings - rule: %s - err: %w", ruleID, err)
+	}
+
+	modulesDisabled, _, err := readKernelFile(e, modulesDisabledPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read %s - rule: %s - err: %v", modulesDisabledPath, ruleID, err)
+	}
+
+	instance := &eval.Instance{
+		Vars: eval.VarMap{
+			compliance.KernelModuleSigningFieldEnabled:         enabled,
+			compliance.KernelModuleSigningFieldSource:          source,
+			compliance.KernelModuleSigningFieldModulesDisabled: modulesDisabled == "1",
+		},
+	}
+
+	passed, err := expr.Evaluate(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &compliance.Report{
+		Passed: passed,
+		Data: event.Data{
+			compliance.KernelModuleSigningFieldEnabled: enabled,
+			compliance.KernelModuleSigningFieldSource:  source,
+		},
+	}, nil
+}
+
+// kernelModuleSigningEnabled returns whether module signatures are enforced and where it was read from
+func kernelModuleSigningEnabled(e env.Env) (bool, string, error) {
+	sigEnforce, found, err := readKernelFile(e, moduleSigEnforcePath)
+	if err != nil {
+		return false, "", err
+	}
+	if found {
+		return sigEnforce == "Y", moduleSigningSourceSysfs, nil
+	}
+
+	sigForce, found, err := kernelConfigValue(e, kernelConfigSigForce)
+	if err != nil {
+		return false, "", err
+	}
+	if found {
+		return sigForce == "y", moduleSigningSourceConfig, nil
+	}
+
+	return false, "", fmt.Errorf("%w: no kernel module signing indicator found", compliance.ErrResourceNotApplicable)
+}
+
+// kernelConfigValue returns the value of an option of the configuration of the running kernel, read from
+// /proc/config.gz or /boot/config-<release>. An option that is not set is found with an empty value,
+// nothing is found when the configuration is not available.
+func kernelConfigValue(e env.Env, option string) (string, bool, error) {
+	r, err := openKernelConfig(e)
+	if err != nil || r == nil {
+		return "", false, err
+	}
+	defer r.Close()
+
+	prefix := option + "="
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, prefix) {
+			return strings.TrimPrefix(line, prefix), true, nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", false, err
+	}
+	return "", true, nil
+}
+
+// openKernelConfig opens the configuration of the running kernel, it returns nil when it cannot be found
+func openKernelConfig(e env.Env) (io.ReadCloser, error) {
+	fs := e.FileSystem()
+
+	f, err := fs.Open(e.NormalizeToHostRoot(kernelConfigProcPath))
+	if err == nil {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		return &gzipFile{Reader: gz, file: f}, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	release, found, err := readKernelFile(e, kernelReleasePath)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	f, err = fs.Open(e.NormalizeToHostRoot(fmt.Sprintf(kernelConfigBootPath, release)))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return f, nil
+}
+
+// gzipFile closes both the gzip reader and the underlying file
+type gzipFile struct {
+	*gzip.Reader
+	file io.Closer
+}
+
+func (f *gzipFile) Close() error {
+	f.Reader.Close()
+	return f.file.Close()
+}
+
+// readKernelFile reads a single value file of sysfs or procfs, a missing file is not an error
+func readKernelFile(e env.Env, path string) (string, bool, error) {
+	f, err := e.FileSystem().Open(e.NormalizeToHostRoot(path))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	defer f.Close()
+
+	data, err := ioutil.ReadAll(f)
+	if err != nil {
+		return "", false, err
+	}
+	return strings.TrimSpace(string(data)), true, nil
+}
@@ -0,0 +1,143 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package custom
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/eval"
+	"github.com/DataDog/datadog-agent/pkg/compliance/event"
+	"github.com/DataDog/datadog-agent/pkg/compliance/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func gzipString(t *testing.T, s string) string {
+	b := &bytes.Buffer{}
+	w := gzip.NewWriter(b)
+	_, err := w.Write([]byte(s))
+	assert.NoError(t, err)
+	assert.NoError(t, w.Close())
+	return b.String()
+}
+
+func TestKernelModuleSigning(t *testing.T) {
+	tests := []struct {
+		name                string
+		files               map[string]string
+		expectReport        *compliance.Report
+		expectNotApplicable bool
+	}{
+		{
+			name: "enforced by the running kernel",
+			files: map[string]string{
+				"/sys/module/module/parameters/sig_enforce": "Y\n",
+				"/proc/sys/kernel/modules_disabled":         "0\n",
+			},
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					compliance.KernelModuleSigningFieldEnabled: true,
+					compliance.KernelModuleSigningFieldSource:  "sysfs",
+				},
+			},
+		},
+		{
+			name: "not enforced by the running kernel",
+			files: map[string]string{
+				"/sys/module/module/parameters/sig_enforce": "N\n",
+				"/boot/config-5.4.0-48-generic":             "CONFIG_MODULE_SIG_FORCE=y\n",
+			},
+			expectReport: &compliance.Report{
+				Passed: false,
+				Data: event.Data{
+					compliance.KernelModuleSigningFieldEnabled: false,
+					compliance.KernelModuleSigningFieldSource:  "sysfs",
+				},
+			},
+		},
+		{
+			name: "boot kernel configuration",
+			files: map[string]string{
+				"/proc/sys/kernel/osrelease":    "5.4.0-48-generic\n",
+				"/boot/config-5.4.0-48-generic": "CONFIG_MODULE_SIG=y\n# CONFIG_MODULE_SIG_FORCE is not set\nCONFIG_MODULE_SIG_ALL=y\n",
+			},
+			expectReport: &compliance.Report{
+				Passed: false,
+				Data: event.Data{
+					compliance.KernelModuleSigningFieldEnabled: false,
+					compliance.KernelModuleSigningFieldSource:  "kernelConfig",
+				},
+			},
+		},
+		{
+			name: "proc kernel configuration",
+			files: map[string]string{
+				"/proc/config.gz": gzipString(t, "CONFIG_MODULE_SIG=y\nCONFIG_MODULE_SIG_FORCE=y\n"),
+			},
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					compliance.KernelModuleSigningFieldEnabled: true,
+					compliance.KernelModuleSigningFieldSource:  "kernelConfig",
+				},
+			},
+		},
+		{
+			name: "no indicator",
+			files: map[string]string{
+				"/proc/sys/kernel/osrelease": "5.4.0-48-generic\n",
+			},
+			expectNotApplicable: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			fs := &mocks.FileSystem{}
+			fs.On("Open", mock.Anything).Return(
+				func(name string) io.ReadCloser {
+					if content, ok := test.files[name]; ok {
+						return ioutil.NopCloser(strings.NewReader(content))
+					}
+					return nil
+				},
+				func(name string) error {
+					if _, ok := test.files[name]; ok {
+						return nil
+					}
+					return &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+				},
+			)
+
+			env := &mocks.Env{}
+			env.On("FileSystem").Return(fs)
+			env.On("NormalizeToHostRoot", mock.Anything).Return(func(path string) string { return path })
+
+			expr, err := eval.ParseIterable(`moduleSigning.enabled || moduleSigning.modulesDisabled`)
+			assert.NoError(err)
+
+			report, err := kernelModuleSigningCheck(env, "rule-id", nil, expr)
+			if test.expectNotApplicable {
+				assert.True(errors.Is(err, compliance.ErrResourceNotApplicable))
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(test.expectReport, report)
+		})
+	}
+}
@@ -6,6 +6,7 @@
 package checks
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -60,6 +61,7 @@ func newCustomCheck(ruleID string, res compliance.Resource) (checkable, error) {
 	}, nil
 }
 
-func (c *customCheck) check(e env.Env) (*compliance.Report, error) {
+// check runs the custom check, custom checks don't support cancellation and ignore ctx
+func (c *customCheck) check(_ context.Context, e env.Env) (*compliance.Report, error) {
 	return c.checkFunc(e, c.ruleID, c.custom.Variables, c.expr)
 }
@@ -6,6 +6,7 @@
 package checks
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -115,7 +116,7 @@ func TestNewCustomCheck(t *testing.T) {
 			} else {
 				assert.NotNil(check)
 				env := &mocks.Env{}
-				report, err := check.check(env)
+				report, err := check.check(context.Background(), env)
 				if test.expectCheckError != nil {
 					assert.EqualError(err, test.expectCheckError.Error())
 
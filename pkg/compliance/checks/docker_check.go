@@ -8,26 +8,33 @@ package checks
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
 
 	"github.com/DataDog/datadog-agent/pkg/compliance"
 	"github.com/DataDog/datadog-agent/pkg/compliance/checks/env"
 	"github.com/DataDog/datadog-agent/pkg/compliance/eval"
 	"github.com/DataDog/datadog-agent/pkg/util/log"
 	"github.com/docker/docker/api/types"
+	dockerclient "github.com/docker/docker/client"
 )
 
 var (
 	dockerReportedFields = []string{
 		compliance.DockerImageFieldID,
 		compliance.DockerImageFieldTags,
+		compliance.DockerImageFieldUser,
 		compliance.DockerContainerFieldID,
 		compliance.DockerContainerFieldName,
 		compliance.DockerContainerFieldImage,
 		compliance.DockerNetworkFieldName,
+		compliance.DockerNetworkFieldDriver,
 		compliance.DockerVersionFieldVersion,
 	}
 )
 
+const dockerNetworkICCOption = "com.docker.network.bridge.enable_icc"
+
 func dockerKindNotSupported(kind string) error {
 	return fmt.Errorf("unsupported docker object kind '%s'", kind)
 }
@@ -42,20 +49,30 @@ func resolveDocker(ctx context.Context, e env.Env, ruleID string, res compliance
 		return nil, fmt.Errorf("docker client not configured")
 	}
 
+	var (
+		resolved interface{}
+		err      error
+	)
+
 	switch res.Docker.Kind {
 	case "image":
-		return newDockerImageIterator(ctx, client)
+		resolved, err = newDockerImageIterator(ctx, client)
 	case "container":
-		return newDockerContainerIterator(ctx, client)
+		resolved, err = newDockerContainerIterator(ctx, client)
 	case "network":
-		return newDockerNetworkIterator(ctx, client)
+		resolved, err = newDockerNetworkIterator(ctx, client)
 	case "info":
-		return newDockerInfoInstance(ctx, client)
+		resolved, err = newDockerInfoInstance(ctx, client)
 	case "version":
-		return newDockerVersionInstance(ctx, client)
+		resolved, err = newDockerVersionInstance(ctx, client)
 	default:
 		return nil, dockerKindNotSupported(res.Docker.Kind)
 	}
+
+	if err != nil && dockerclient.IsErrConnectionFailed(err) {
+		return nil, fmt.Errorf("%w: docker daemon not reachable: %v", ErrResourceNotApplicable, err)
+	}
+	return resolved, err
 }
 
 func newDockerInfoInstance(ctx context.Context, client env.DockerClient) (*eval.Instance, error) {
@@ -110,6 +127,21 @@ func dockerTemplateQuery(funcName, obj interface{}) eval.Function {
 	}
 }
 
+func dockerLabelQuery(funcName string, labels map[string]string) eval.Function {
+	return func(_ *eval.Instance, args ...interface{}) (interface{}, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf(`invalid number of arguments in "%s()", expecting 1 got %d`, funcName, len(args))
+		}
+
+		label, ok := args[0].(string)
+		if !ok {
+			return nil, fmt.Errorf(`expecting string value for label argument in "%s()"`, funcName)
+		}
+
+		return labels[label], nil
+	}
+}
+
 type dockerImageIterator struct {
 	ctx    context.Context
 	client env.DockerClient
@@ -144,13 +176,34 @@ func (it *dockerImageIterator) Next() (*eval.Instance, error) {
 
 	it.index++
 
+	var (
+		user         string
+		exposedPorts []string
+		healthcheck  bool
+		labels       map[string]string
+	)
+
+	if config := imageInspect.Config; config != nil {
+		user = config.User
+		for port := range config.ExposedPorts {
+			exposedPorts = append(exposedPorts, string(port))
+		}
+		sort.Strings(exposedPorts)
+		healthcheck = config.Healthcheck != nil && len(config.Healthcheck.Test) > 0 && config.Healthcheck.Test[0] != "NONE"
+		labels = config.Labels
+	}
+
 	return &eval.Instance{
 		Vars: eval.VarMap{
-			compliance.DockerImageFieldID:   image.ID,
-			compliance.DockerImageFieldTags: imageInspect.RepoTags,
+			compliance.DockerImageFieldID:           image.ID,
+			compliance.DockerImageFieldTags:         imageInspect.RepoTags,
+			compliance.DockerImageFieldUser:         user,
+			compliance.DockerImageFieldExposedPorts: exposedPorts,
+			compliance.DockerImageFieldHealthcheck:  healthcheck,
 		},
 		Functions: eval.FunctionMap{
-			compliance.DockerFuncTemplate: dockerTemplateQuery(compliance.DockerFuncTemplate, imageInspect),
+			compliance.DockerImageFuncLabel: dockerLabelQuery(compliance.DockerImageFuncLabel, labels),
+			compliance.DockerFuncTemplate:   dockerTemplateQuery(compliance.DockerFuncTemplate, imageInspect),
 		},
 	}, nil
 }
@@ -234,14 +287,29 @@ func (it *dockerNetworkIterator) Next() (*eval.Instance, error) {
 		return nil, ErrInvalidIteration
 	}
 
-	network := it.networks[it.index]
+	networkSummary := it.networks[it.index]
+
+	// Attached containers are only reported when inspecting a network
+	network, err := it.client.NetworkInspect(it.ctx, networkSummary.ID, types.NetworkInspectOptions{})
+	if err != nil {
+		return nil, log.Errorf("failed to inspect network %s", networkSummary.ID)
+	}
 
 	it.index++
 
+	var containers []string
+	for _, endpoint := range network.Containers {
+		containers = append(containers, endpoint.Name)
+	}
+	sort.Strings(containers)
+
 	return &eval.Instance{
 		Vars: eval.VarMap{
-			compliance.DockerNetworkFieldID:   network.ID,
-			compliance.DockerNetworkFieldName: network.Name,
+			compliance.DockerNetworkFieldID:         network.ID,
+			compliance.DockerNetworkFieldName:       network.Name,
+			compliance.DockerNetworkFieldDriver:     network.Driver,
+			compliance.DockerNetworkFieldICC:        dockerNetworkICC(network),
+			compliance.DockerNetworkFieldContainers: containers,
 		},
 		Functions: eval.FunctionMap{
 			compliance.DockerFuncTemplate: dockerTemplateQuery(compliance.DockerFuncTemplate, network),
@@ -249,6 +317,16 @@ func (it *dockerNetworkIterator) Next() (*eval.Instance, error) {
 	}, nil
 }
 
+// dockerNetworkICC returns whether inter-container communication is enabled on a network
+func dockerNetworkICC(network types.NetworkResource) bool {
+	icc, err := strconv.ParseBool(network.Options[dockerNetworkICCOption])
+	if err != nil {
+		// Inter-container communication is enabled unless explicitly disabled
+		return true
+	}
+	return icc
+}
+
 func (it *dockerNetworkIterator) Done() bool {
 	return it.index >= len(it.networks)
 }
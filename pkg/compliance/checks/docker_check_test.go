@@ -6,7 +6,9 @@
 package checks
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"os"
 	"testing"
@@ -14,6 +16,7 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/compliance"
 	"github.com/DataDog/datadog-agent/pkg/compliance/mocks"
 	"github.com/docker/docker/api/types"
+	dockerclient "github.com/docker/docker/client"
 
 	"github.com/stretchr/testify/mock"
 	assert "github.com/stretchr/testify/require"
@@ -72,7 +75,7 @@ func TestDockerImageCheck(t *testing.T) {
 	dockerCheck, err := newResourceCheck(env, "rule-id", resource)
 	assert.NoError(err)
 
-	report, err := dockerCheck.check(env)
+	report, err := dockerCheck.check(context.Background(), env)
 	assert.NoError(err)
 
 	assert.False(report.Passed)
@@ -80,6 +83,95 @@ func TestDockerImageCheck(t *testing.T) {
 	assert.Equal([]string{"redis:latest"}, report.Data["image.tags"])
 }
 
+func TestDockerImageFieldsCheck(t *testing.T) {
+	assert := assert.New(t)
+
+	images := []struct {
+		id   string
+		path string
+	}{
+		{
+			id:   "sha256:09f3f4e9394f7620fb6f1025755c85dac07f7e7aa4fca4ba19e4a03590b63750",
+			path: "./testdata/docker/image-09f3f4e9394f.json",
+		},
+		{
+			id:   "sha256:f9b9909726890b00d2098081642edf32e5211b7ab53563929a47f250bcdc1d7c",
+			path: "./testdata/docker/image-f9b990972689.json",
+		},
+	}
+
+	tests := []struct {
+		name         string
+		condition    string
+		inspected    int
+		expectPassed bool
+	}{
+		{
+			name:         "healthcheck",
+			condition:    `image.healthcheck`,
+			inspected:    2,
+			expectPassed: false,
+		},
+		{
+			name:         "user",
+			condition:    `image.user not in ["", "root"]`,
+			inspected:    1,
+			expectPassed: false,
+		},
+		{
+			name:         "exposed ports",
+			condition:    `"80/tcp" in image.exposedPorts`,
+			inspected:    2,
+			expectPassed: false,
+		},
+		{
+			name:         "label",
+			condition:    `image.label("maintainer") != ""`,
+			inspected:    2,
+			expectPassed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resource := compliance.Resource{
+				Docker: &compliance.DockerResource{
+					Kind: "image",
+				},
+				Condition: test.condition,
+			}
+
+			client := &mocks.DockerClient{}
+			defer client.AssertExpectations(t)
+
+			var imageList []types.ImageSummary
+			assert.NoError(loadTestJSON("./testdata/docker/image-list.json", &imageList))
+			client.On("ImageList", mockCtx, types.ImageListOptions{All: true}).Return(imageList, nil)
+
+			// Only iterated images here (failing item stops the iteration)
+			for _, i := range images[:test.inspected] {
+				var image types.ImageInspect
+				assert.NoError(loadTestJSON(i.path, &image))
+				client.On("ImageInspectWithRaw", mockCtx, i.id).Return(image, nil, nil)
+			}
+
+			env := &mocks.Env{}
+			defer env.AssertExpectations(t)
+			env.On("DockerClient").Return(client)
+
+			dockerCheck, err := newResourceCheck(env, "rule-id", resource)
+			assert.NoError(err)
+
+			report, err := dockerCheck.check(context.Background(), env)
+			assert.NoError(err)
+
+			assert.Equal(test.expectPassed, report.Passed)
+			assert.Equal(images[test.inspected-1].id, report.Data["image.id"])
+			assert.Equal("", report.Data["image.user"])
+		})
+	}
+}
+
 func TestDockerNetworkCheck(t *testing.T) {
 	assert := assert.New(t)
 
@@ -96,6 +188,9 @@ func TestDockerNetworkCheck(t *testing.T) {
 	var networks []types.NetworkResource
 	assert.NoError(loadTestJSON("./testdata/docker/network-list.json", &networks))
 	client.On("NetworkList", mockCtx, types.NetworkListOptions{}).Return(networks, nil)
+	for _, network := range networks {
+		client.On("NetworkInspect", mockCtx, network.ID, types.NetworkInspectOptions{}).Return(network, nil)
+	}
 
 	env := &mocks.Env{}
 	defer env.AssertExpectations(t)
@@ -104,13 +199,79 @@ func TestDockerNetworkCheck(t *testing.T) {
 	dockerCheck, err := newResourceCheck(env, "rule-id", resource)
 	assert.NoError(err)
 
-	report, err := dockerCheck.check(env)
+	report, err := dockerCheck.check(context.Background(), env)
 	assert.NoError(err)
 
 	assert.True(report.Passed)
 	assert.Equal("bridge", report.Data["network.name"])
 }
 
+func TestDockerNetworkFieldsCheck(t *testing.T) {
+	assert := assert.New(t)
+
+	resource := compliance.Resource{
+		Docker: &compliance.DockerResource{
+			Kind: "network",
+		},
+		Condition: `network.driver != "bridge" || !network.icc || "/sharp_cori" not in network.containers`,
+	}
+
+	client := &mocks.DockerClient{}
+	defer client.AssertExpectations(t)
+
+	var networks []types.NetworkResource
+	assert.NoError(loadTestJSON("./testdata/docker/network-list.json", &networks))
+	client.On("NetworkList", mockCtx, types.NetworkListOptions{}).Return(networks, nil)
+
+	// Only the bridge network is inspected here (first item stops the iteration)
+	bridge := networks[0]
+	bridge.Containers = map[string]types.EndpointResource{
+		"3c4bd9d35d42efb2314b636da42d4edb3882dc93ef0b1931ed0e919efdceec87": {
+			Name: "/sharp_cori",
+		},
+	}
+	client.On("NetworkInspect", mockCtx, bridge.ID, types.NetworkInspectOptions{}).Return(bridge, nil)
+
+	env := &mocks.Env{}
+	defer env.AssertExpectations(t)
+	env.On("DockerClient").Return(client)
+
+	dockerCheck, err := newResourceCheck(env, "rule-id", resource)
+	assert.NoError(err)
+
+	report, err := dockerCheck.check(context.Background(), env)
+	assert.NoError(err)
+
+	assert.False(report.Passed)
+	assert.Equal("bridge", report.Data["network.name"])
+	assert.Equal("bridge", report.Data["network.driver"])
+}
+
+func TestDockerNotReachable(t *testing.T) {
+	assert := assert.New(t)
+
+	resource := compliance.Resource{
+		Docker: &compliance.DockerResource{
+			Kind: "network",
+		},
+		Condition: `network.icc`,
+	}
+
+	client := &mocks.DockerClient{}
+	defer client.AssertExpectations(t)
+	client.On("NetworkList", mockCtx, types.NetworkListOptions{}).Return(nil, dockerclient.ErrorConnectionFailed("unix:///var/run/docker.sock"))
+
+	env := &mocks.Env{}
+	defer env.AssertExpectations(t)
+	env.On("DockerClient").Return(client)
+
+	dockerCheck, err := newResourceCheck(env, "rule-id", resource)
+	assert.NoError(err)
+
+	_, err = dockerCheck.check(context.Background(), env)
+	assert.True(errors.Is(err, ErrResourceNotApplicable))
+}
+
 func TestDockerContainerCheck(t *testing.T) {
 	assert := assert.New(t)
 
@@ -218,7 +379,7 @@ func TestDockerContainerCheck(t *testing.T) {
 			dockerCheck, err := newResourceCheck(env, "rule-id", resource)
 			assert.NoError(err)
 
-			report, err := dockerCheck.check(env)
+			report, err := dockerCheck.check(context.Background(), env)
 			assert.NoError(err)
 
 			assert.Equal(test.expectPassed, report.Passed)
@@ -253,7 +414,7 @@ func TestDockerInfoCheck(t *testing.T) {
 	dockerCheck, err := newResourceCheck(env, "rule-id", resource)
 	assert.NoError(err)
 
-	report, err := dockerCheck.check(env)
+	report, err := dockerCheck.check(context.Background(), env)
 	assert.NoError(err)
 
 	assert.False(report.Passed)
@@ -283,7 +444,7 @@ func TestDockerVersionCheck(t *testing.T) {
 	dockerCheck, err := newResourceCheck(env, "rule-id", resource)
 	assert.NoError(err)
 
-	report, err := dockerCheck.check(env)
+	report, err := dockerCheck.check(context.Background(), env)
 	assert.NoError(err)
 
 	assert.False(report.Passed)
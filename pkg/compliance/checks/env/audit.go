@@ -12,5 +12,16 @@ import (
 // AuditClient defines the interface for interacting with the auditd client
 type AuditClient interface {
 	GetFileWatchRules() ([]*rule.FileWatchRule, error)
+	GetStatus() (*AuditStatus, error)
 	Close() error
 }
+
+// AuditStatus is the status of the kernel audit subsystem
+type AuditStatus struct {
+	// Enabled is 0 when auditing is disabled, 1 when enabled and 2 when the configuration is locked
+	Enabled uint32
+	// Failure is the failure mode: 0 is silent, 1 logs to the kernel log and 2 panics
+	Failure uint32
+	// PID is the process receiving the audit messages, 0 when no audit daemon is registered
+	PID uint32
+}
@@ -22,12 +22,14 @@ type Clients interface {
 	DockerClient() DockerClient
 	AuditClient() AuditClient
 	KubeClient() KubeClient
+	FileSystem() FileSystem
 }
 
 // Configuration provides an abstraction for various environment methods used by checks
 type Configuration interface {
 	Hostname() string
 	EtcGroupPath() string
+	IsCommandAllowed(name string) bool
 	NormalizeToHostRoot(path string) string
 	RelativeToHostRoot(path string) string
 	EvaluateFromCache(e eval.Evaluatable) (interface{}, error)
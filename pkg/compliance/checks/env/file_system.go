@@ -0,0 +1,21 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package env
+
+import (
+	"io"
+	"os"
+)
+
+// FileSystem abstracts the access to the files of the checked host
+type FileSystem interface {
+	Open(name string) (io.ReadCloser, error)
+	Stat(name string) (os.FileInfo, error)
+	ReadDir(dirname string) ([]os.FileInfo, error)
+	Glob(pattern string) ([]string, error)
+	// Getxattr returns the value of an extended attribute of a file, nil when the file doesn't have it
+	Getxattr(name, attr string) ([]byte, error)
+}
@@ -0,0 +1,110 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package checks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/checks/env"
+	"github.com/DataDog/datadog-agent/pkg/compliance/eval"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+var envReportedFields = []string{
+	compliance.EnvFieldProcess,
+	compliance.EnvFieldName,
+	compliance.EnvFieldValue,
+}
+
+var (
+	environReader func(path string) ([]byte, error) = ioutil.ReadFile
+)
+
+func resolveEnv(_ context.Context, e env.Env, id string, res compliance.Resource) (interface{}, error) {
+	if res.Env == nil {
+		return nil, fmt.Errorf("%s: expecting env resource in env check", id)
+	}
+
+	envVar := res.Env
+
+	log.Debugf("%s: running env check: %s in %s", id, envVar.Name, envVar.Process)
+
+	processes, err := getProcesses(cacheValidity)
+	if err != nil {
+		return nil, log.Errorf("%s: Unable to fetch processes: %v", id, err)
+	}
+
+	matchedProcesses := processes.findProcessesByName(envVar.Process)
+	if len(matchedProcesses) == 0 {
+		return nil, fmt.Errorf("no process found for env check %q", envVar.Process)
+	}
+
+	var (
+		instances []*eval.Instance
+		deniedErr error
+	)
+	for _, mp := range matchedProcesses {
+		environ, err := readProcessEnviron(e, mp.Pid)
+		if err != nil {
+			if os.IsPermission(err) {
+				// Reading the environment of a process owned by another user requires privileges
+				log.Debugf("%s: env check not allowed to read environment of %s (pid %d): %v", id, mp.Name, mp.Pid, err)
+				deniedErr = err
+				continue
+			}
+			return nil, err
+		}
+
+		value, set := environ[envVar.Name]
+		instances = append(instances, &eval.Instance{
+			Vars: eval.VarMap{
+				compliance.EnvFieldProcess: mp.Name,
+				compliance.EnvFieldName:    envVar.Name,
+				compliance.EnvFieldValue:   value,
+				compliance.EnvFieldSet:     set,
+			},
+		})
+	}
+
+	if len(instances) == 0 {
+		return nil, fmt.Errorf("unable to read environment of process %q: %w", envVar.Process, deniedErr)
+	}
+
+	if len(instances) == 1 {
+		return instances[0], nil
+	}
+
+	return &instanceIterator{
+		instances: instances,
+	}, nil
+}
+
+// readProcessEnviron reads and parses the environment of a process from procfs
+func readProcessEnviron(e env.Env, pid int32) (map[string]string, error) {
+	data, err := environReader(e.NormalizeToHostRoot(fmt.Sprintf("/proc/%d/environ", pid)))
+	if err != nil {
+		return nil, err
+	}
+
+	environ := make(map[string]string)
+	for _, entry := range bytes.Split(data, []byte{0}) {
+		if len(entry) == 0 {
+			continue
+		}
+		parts := strings.SplitN(string(entry), "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		environ[parts[0]] = parts[1]
+	}
+	return environ, nil
+}
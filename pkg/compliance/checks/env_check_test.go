@@ -0,0 +1,156 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package checks
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"syscall"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/event"
+	"github.com/DataDog/datadog-agent/pkg/compliance/mocks"
+	"github.com/DataDog/datadog-agent/pkg/util/cache"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestEnvCheck(t *testing.T) {
+	tests := []struct {
+		name         string
+		resource     compliance.Resource
+		processes    processes
+		environs     map[string][]byte
+		expectReport *compliance.Report
+		expectError  error
+	}{
+		{
+			name: "variable set",
+			resource: compliance.Resource{
+				Env: &compliance.Env{
+					Process: "dockerd",
+					Name:    "DOCKER_DEBUG",
+				},
+				Condition: `env.value != "1"`,
+			},
+			processes: processes{
+				42: {
+					Pid:  42,
+					Name: "dockerd",
+				},
+			},
+			environs: map[string][]byte{
+				"/proc/42/environ": []byte("PATH=/usr/bin\x00DOCKER_DEBUG=1\x00"),
+			},
+			expectReport: &compliance.Report{
+				Passed: false,
+				Data: event.Data{
+					"env.process": "dockerd",
+					"env.name":    "DOCKER_DEBUG",
+					"env.value":   "1",
+				},
+			},
+		},
+		{
+			name: "variable not set",
+			resource: compliance.Resource{
+				Env: &compliance.Env{
+					Process: "dockerd",
+					Name:    "DOCKER_DEBUG",
+				},
+				Condition: `!env.set`,
+			},
+			processes: processes{
+				42: {
+					Pid:  42,
+					Name: "dockerd",
+				},
+			},
+			environs: map[string][]byte{
+				"/proc/42/environ": []byte("PATH=/usr/bin\x00"),
+			},
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					"env.process": "dockerd",
+					"env.name":    "DOCKER_DEBUG",
+					"env.value":   "",
+				},
+			},
+		},
+		{
+			name: "permission denied",
+			resource: compliance.Resource{
+				Env: &compliance.Env{
+					Process: "dockerd",
+					Name:    "DOCKER_DEBUG",
+				},
+				Condition: `!env.set`,
+			},
+			processes: processes{
+				42: {
+					Pid:  42,
+					Name: "dockerd",
+				},
+			},
+			expectError: errors.New(`unable to read environment of process "dockerd": open /proc/42/environ: permission denied`),
+		},
+		{
+			name: "no process",
+			resource: compliance.Resource{
+				Env: &compliance.Env{
+					Process: "dockerd",
+					Name:    "DOCKER_DEBUG",
+				},
+				Condition: `!env.set`,
+			},
+			expectError: errors.New(`no process found for env check "dockerd"`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			cache.Cache.Delete(processCacheKey)
+			processFetcher = func() (processes, error) {
+				return test.processes, nil
+			}
+			environReader = func(path string) ([]byte, error) {
+				data, ok := test.environs[path]
+				if !ok {
+					return nil, &os.PathError{Op: "open", Path: path, Err: syscall.EACCES}
+				}
+				return data, nil
+			}
+			defer func() {
+				environReader = ioutil.ReadFile
+			}()
+
+			env := &mocks.Env{}
+			defer env.AssertExpectations(t)
+			for pid := range test.processes {
+				path := "/proc/" + strconv.Itoa(int(pid)) + "/environ"
+				env.On("NormalizeToHostRoot", path).Return(path)
+			}
+
+			envCheck, err := newResourceCheck(env, "rule-id", test.resource)
+			assert.NoError(err)
+
+			report, err := envCheck.check(context.Background(), env)
+			if test.expectError != nil {
+				assert.EqualError(err, test.expectError.Error())
+			} else {
+				assert.NoError(err)
+				assert.Equal(test.expectReport, report)
+			}
+		})
+	}
+}
@@ -24,6 +24,9 @@ func getFileStatt(fi os.FileInfo) (*syscall.Stat_t, error) {
 }
 
 func getFileUser(fi os.FileInfo) (string, error) {
+	if remote, ok := fi.Sys().(*remoteFileStat); ok {
+		return remote.user, nil
+	}
 	statt, err := getFileStatt(fi)
 	if err != nil {
 		return "", nil
@@ -36,6 +39,9 @@ func getFileUser(fi os.FileInfo) (string, error) {
 }
 
 func getFileGroup(fi os.FileInfo) (string, error) {
+	if remote, ok := fi.Sys().(*remoteFileStat); ok {
+		return remote.group, nil
+	}
 	statt, err := getFileStatt(fi)
 	if err != nil {
 		return "", nil
@@ -46,3 +52,14 @@ func getFileGroup(fi os.FileInfo) (string, error) {
 	}
 	return g, nil
 }
+
+func getFileDevice(fi os.FileInfo) (uint64, error) {
+	if remote, ok := fi.Sys().(*remoteFileStat); ok {
+		return remote.device, nil
+	}
+	statt, err := getFileStatt(fi)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(statt.Dev), nil
+}
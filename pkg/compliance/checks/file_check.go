@@ -14,14 +14,22 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/compliance"
 	"github.com/DataDog/datadog-agent/pkg/compliance/checks/env"
 	"github.com/DataDog/datadog-agent/pkg/compliance/eval"
+	"github.com/DataDog/datadog-agent/pkg/compliance/event"
 	"github.com/DataDog/datadog-agent/pkg/util/log"
 )
 
+const (
+	defaultFileMaxDepth      = 8
+	defaultFileMaxViolations = 10
+)
+
 var fileReportedFields = []string{
 	compliance.FileFieldPath,
+	compliance.FileFieldExists,
 	compliance.FileFieldPermissions,
 	compliance.FileFieldUser,
 	compliance.FileFieldGroup,
+	compliance.FileFieldCaptures,
 }
 
 func resolveFile(_ context.Context, e env.Env, ruleID string, res compliance.Resource) (interface{}, error) {
@@ -33,63 +41,213 @@ func resolveFile(_ context.Context, e env.Env, ruleID string, res compliance.Res
 
 	log.Debugf("%s: running file check for %q", ruleID, file.Path)
 
+	if file.ExistenceOnly && file.Recursive {
+		return nil, fmt.Errorf("%s: file check cannot be both recursive and existence only", ruleID)
+	}
+
 	path, err := resolvePath(e, file.Path)
 	if err != nil {
 		return nil, err
 	}
 
-	paths, err := filepath.Glob(e.NormalizeToHostRoot(path))
+	fs := e.FileSystem()
+
+	paths, err := fs.Glob(e.NormalizeToHostRoot(path))
 	if err != nil {
 		return nil, err
 	}
 
+	if file.ExistenceOnly {
+		return newFileExistenceInstance(e, fs, path, paths), nil
+	}
+
 	var instances []*eval.Instance
 
 	for _, path := range paths {
 		// Re-computing relative after glob filtering
 		relPath := e.RelativeToHostRoot(path)
-		fi, err := os.Stat(path)
+		fi, err := fs.Stat(path)
 		if err != nil {
 			// This is not a failure unless we don't have any paths to act on
 			log.Debugf("%s: file check failed to stat %s [%s]", ruleID, path, relPath)
 			continue
 		}
 
-		instance := &eval.Instance{
-			Vars: eval.VarMap{
-				compliance.FileFieldPath:        relPath,
-				compliance.FileFieldPermissions: uint64(fi.Mode() & os.ModePerm),
-			},
-			Functions: eval.FunctionMap{
-				compliance.FileFuncJQ:     fileJQ(path),
-				compliance.FileFuncYAML:   fileYAML(path),
-				compliance.FileFuncRegexp: fileRegexp(path),
-			},
+		if !file.Recursive {
+			instances = append(instances, newFileInstance(fs, path, relPath, fi))
+			continue
 		}
 
-		user, err := getFileUser(fi)
-		if err == nil {
-			instance.Vars[compliance.FileFieldUser] = user
+		walkFiles(fs, ruleID, path, fi, fileMaxDepth(file), func(path string, fi os.FileInfo) {
+			instances = append(instances, newFileInstance(fs, path, e.RelativeToHostRoot(path), fi))
+		})
+	}
+
+	if len(instances) == 0 {
+		return nil, fmt.Errorf("no files found for file check %q", file.Path)
+	}
+
+	it := &instanceIterator{
+		instances: instances,
+	}
+
+	if file.Recursive {
+		return &fileWalkIterator{
+			instanceIterator: it,
+			maxViolations:    fileMaxViolations(file),
+		}, nil
+	}
+
+	return it, nil
+}
+
+// newFileExistenceInstance returns an instance telling whether any of the paths matched by a
+// file check exists, the path reported is the first one found or the configured one
+func newFileExistenceInstance(e env.Env, fs env.FileSystem, path string, paths []string) *eval.Instance {
+	instance := &eval.Instance{
+		Vars: eval.VarMap{
+			compliance.FileFieldPath:   path,
+			compliance.FileFieldExists: false,
+		},
+	}
+
+	for _, p := range paths {
+		if _, err := fs.Stat(p); err == nil {
+			instance.Vars[compliance.FileFieldPath] = e.RelativeToHostRoot(p)
+			instance.Vars[compliance.FileFieldExists] = true
+			break
 		}
+	}
+
+	return instance
+}
+
+func newFileInstance(fs env.FileSystem, path, relPath string, fi os.FileInfo) *eval.Instance {
+	instance := &eval.Instance{
+		Vars: eval.VarMap{
+			compliance.FileFieldPath:        relPath,
+			compliance.FileFieldPermissions: uint64(fi.Mode() & os.ModePerm),
+		},
+		Functions: eval.FunctionMap{
+			compliance.FileFuncJQ:     fileJQ(fs, path),
+			compliance.FileFuncYAML:   fileYAML(fs, path),
+			compliance.FileFuncRegexp: fileRegexp(fs, path),
+		},
+	}
+
+	user, err := getFileUser(fi)
+	if err == nil {
+		instance.Vars[compliance.FileFieldUser] = user
+	}
+
+	group, err := getFileGroup(fi)
+	if err == nil {
+		instance.Vars[compliance.FileFieldGroup] = group
+	}
 
-		group, err := getFileGroup(fi)
-		if err == nil {
-			instance.Vars[compliance.FileFieldGroup] = group
+	return instance
+}
+
+func fileMaxDepth(file *compliance.File) int {
+	if file.MaxDepth > 0 {
+		return file.MaxDepth
+	}
+	return defaultFileMaxDepth
+}
+
+func fileMaxViolations(file *compliance.File) int {
+	if file.MaxViolations > 0 {
+		return file.MaxViolations
+	}
+	return defaultFileMaxViolations
+}
+
+// walkFiles calls fn for root and every file below it, up to maxDepth levels deep.
+// Symlinks are skipped to avoid loops and directories on other filesystems are not entered.
+func walkFiles(fs env.FileSystem, ruleID string, root string, rootInfo os.FileInfo, maxDepth int, fn func(path string, fi os.FileInfo)) {
+	rootDevice, rootDeviceErr := getFileDevice(rootInfo)
+
+	var walk func(dir string, depth int)
+	walk = func(dir string, depth int) {
+		if depth >= maxDepth {
+			log.Debugf("%s: file check reached max depth in %s", ruleID, dir)
+			return
 		}
 
-		instances = append(instances, instance)
+		entries, err := fs.ReadDir(dir)
+		if err != nil {
+			log.Debugf("%s: file check failed to read directory %s: %v", ruleID, dir, err)
+			return
+		}
+
+		for _, fi := range entries {
+			if fi.Mode()&os.ModeSymlink != 0 {
+				continue
+			}
+
+			path := filepath.Join(dir, fi.Name())
+
+			if fi.IsDir() && rootDeviceErr == nil {
+				if device, err := getFileDevice(fi); err == nil && device != rootDevice {
+					log.Debugf("%s: file check skipping %s on another filesystem", ruleID, path)
+					continue
+				}
+			}
+
+			fn(path, fi)
+
+			if fi.IsDir() {
+				walk(path, depth+1)
+			}
+		}
 	}
 
-	if len(instances) == 0 {
-		return nil, fmt.Errorf("no files found for file check %q", file.Path)
+	fn(root, rootInfo)
+
+	if rootInfo.IsDir() {
+		walk(root, 0)
 	}
+}
 
-	return &instanceIterator{
-		instances: instances,
-	}, nil
+// fileWalkIterator iterates over the files found by a recursive file check
+type fileWalkIterator struct {
+	*instanceIterator
+	maxViolations int
 }
 
-func fileQuery(path string, get getter) eval.Function {
+// reportViolations adds the paths of the first files failing the condition to the report
+func (it *fileWalkIterator) reportViolations(expression *eval.IterableExpression, report *compliance.Report) error {
+	// Aggregate conditions (all, none, count) do not fail for a single file
+	if expression.IterableComparison != nil {
+		return nil
+	}
+
+	var violations []string
+	for _, instance := range it.instances {
+		passed, err := expression.Evaluate(instance)
+		if err != nil {
+			return err
+		}
+		if passed {
+			continue
+		}
+
+		violations = append(violations, instance.Vars[compliance.FileFieldPath].(string))
+		if len(violations) >= it.maxViolations {
+			break
+		}
+	}
+
+	if len(violations) != 0 {
+		if report.Data == nil {
+			report.Data = event.Data{}
+		}
+		report.Data[compliance.FileFieldViolations] = violations
+	}
+	return nil
+}
+
+func fileQuery(fs env.FileSystem, path string, get getter) eval.Function {
 	return func(_ *eval.Instance, args ...interface{}) (interface{}, error) {
 		if len(args) != 1 {
 			return nil, fmt.Errorf(`invalid number of arguments, expecting 1 got %d`, len(args))
@@ -98,18 +256,43 @@ func fileQuery(path string, get getter) eval.Function {
 		if !ok {
 			return nil, fmt.Errorf(`expecting string value for query argument`)
 		}
-		return queryValueFromFile(path, query, get)
+		return queryValueFromFile(fs, path, query, get)
 	}
 }
 
-func fileJQ(path string) eval.Function {
-	return fileQuery(path, jsonGetter)
+func fileJQ(fs env.FileSystem, path string) eval.Function {
+	return fileQuery(fs, path, jsonGetter)
 }
 
-func fileYAML(path string) eval.Function {
-	return fileQuery(path, yamlGetter)
+func fileYAML(fs env.FileSystem, path string) eval.Function {
+	return fileQuery(fs, path, yamlGetter)
 }
 
-func fileRegexp(path string) eval.Function {
-	return fileQuery(path, regexpGetter)
+// fileRegexp returns the leftmost match of a regexp in a file. Groups captured by the
+// regexp are stored in the instance to be reported, no match leaves the decision to the condition.
+func fileRegexp(fs env.FileSystem, path string) eval.Function {
+	return func(instance *eval.Instance, args ...interface{}) (interface{}, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf(`invalid number of arguments, expecting 1 got %d`, len(args))
+		}
+		expr, ok := args[0].(string)
+		if !ok {
+			return nil, fmt.Errorf(`expecting string value for query argument`)
+		}
+
+		data, err := readFile(fs, path)
+		if err != nil {
+			return nil, err
+		}
+
+		match, captures, err := regexpCaptures(data, expr)
+		if err != nil {
+			return nil, err
+		}
+
+		if captures != nil && instance != nil && instance.Vars != nil {
+			instance.Vars[compliance.FileFieldCaptures] = captures
+		}
+		return match, nil
+	}
 }
@@ -8,6 +8,7 @@
 package checks
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -275,6 +276,112 @@ func TestFileCheck(t *testing.T) {
 				assert.NotEmpty(report.Data["file.group"])
 			},
 		},
+		{
+			name: "regexp capture groups",
+			resource: compliance.Resource{
+				File: &compliance.File{
+					Path: "/proc/mounts",
+				},
+				Condition: `file.regexp("(?P<device>[a-zA-Z0-9-_/]+) /boot/efi ([a-zA-Z0-9-_/]+)") != ""`,
+			},
+			setup: func(t *testing.T, env *mocks.Env, file *compliance.File) {
+				env.On("NormalizeToHostRoot", file.Path).Return("./testdata/file/mounts")
+				env.On("RelativeToHostRoot", "./testdata/file/mounts").Return(file.Path)
+			},
+			validate: func(t *testing.T, file *compliance.File, report *compliance.Report) {
+				assert.True(report.Passed)
+				assert.Equal("/proc/mounts", report.Data["file.path"])
+				assert.Equal(map[string]string{
+					"device": "/dev/sda15",
+					"2":      "vfat",
+				}, report.Data["file.captures"])
+			},
+		},
+		{
+			name: "regexp capture groups - no match",
+			resource: compliance.Resource{
+				File: &compliance.File{
+					Path: "/proc/mounts",
+				},
+				Condition: `file.regexp("(?P<device>[a-zA-Z0-9-_/]+) /var ([a-zA-Z0-9-_/]+)") == ""`,
+			},
+			setup: func(t *testing.T, env *mocks.Env, file *compliance.File) {
+				env.On("NormalizeToHostRoot", file.Path).Return("./testdata/file/mounts")
+				env.On("RelativeToHostRoot", "./testdata/file/mounts").Return(file.Path)
+			},
+			validate: func(t *testing.T, file *compliance.File, report *compliance.Report) {
+				assert.True(report.Passed)
+				assert.Equal("/proc/mounts", report.Data["file.path"])
+				assert.NotContains(report.Data, "file.captures")
+			},
+		},
+		{
+			name: "recursive",
+			resource: compliance.Resource{
+				File: &compliance.File{
+					Path:      "/var/lib/test",
+					Recursive: true,
+				},
+				Condition: "file.permissions & 0002 == 0",
+			},
+			setup: func(t *testing.T, env *mocks.Env, file *compliance.File) {
+				tempDir, filePaths := createTempFiles(t, 1)
+				subDir := path.Join(tempDir, "sub")
+				assert.NoError(os.Mkdir(subDir, 0755))
+				nested := path.Join(subDir, "nested.dat")
+				assert.NoError(ioutil.WriteFile(nested, nil, 0644))
+				assert.NoError(os.Chmod(nested, 0666))
+				assert.NoError(os.Symlink(nested, path.Join(tempDir, "link.dat")))
+
+				env.On("NormalizeToHostRoot", file.Path).Return(tempDir)
+				env.On("RelativeToHostRoot", mock.Anything).Return(func(p string) string {
+					return path.Join(file.Path, p[len(tempDir):])
+				})
+
+				assert.NoError(os.Chmod(filePaths[0], 0666))
+			},
+			validate: func(t *testing.T, file *compliance.File, report *compliance.Report) {
+				assert.False(report.Passed)
+				assert.Equal("/var/lib/test/sub/nested.dat", report.Data["file.path"])
+				assert.Len(report.Data["file.violations"], 2)
+				assert.Contains(report.Data["file.violations"], "/var/lib/test/sub/nested.dat")
+				assert.NotContains(report.Data["file.violations"], "/var/lib/test/link.dat")
+			},
+		},
+		{
+			name: "recursive with max depth and max violations",
+			resource: compliance.Resource{
+				File: &compliance.File{
+					Path:          "/var/lib/test",
+					Recursive:     true,
+					MaxDepth:      1,
+					MaxViolations: 1,
+				},
+				Condition: "file.permissions & 0002 == 0",
+			},
+			setup: func(t *testing.T, env *mocks.Env, file *compliance.File) {
+				tempDir, filePaths := createTempFiles(t, 2)
+				subDir := path.Join(tempDir, "sub")
+				assert.NoError(os.Mkdir(subDir, 0755))
+				nested := path.Join(subDir, "nested.dat")
+				assert.NoError(ioutil.WriteFile(nested, nil, 0644))
+				assert.NoError(os.Chmod(nested, 0666))
+
+				env.On("NormalizeToHostRoot", file.Path).Return(tempDir)
+				env.On("RelativeToHostRoot", mock.Anything).Return(func(p string) string {
+					return path.Join(file.Path, p[len(tempDir):])
+				})
+
+				for _, filePath := range filePaths {
+					assert.NoError(os.Chmod(filePath, 0666))
+				}
+			},
+			validate: func(t *testing.T, file *compliance.File, report *compliance.Report) {
+				assert.False(report.Passed)
+				assert.Len(report.Data["file.violations"], 1)
+				assert.NotContains(report.Data["file.violations"], "/var/lib/test/sub/nested.dat")
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -285,11 +392,14 @@ func TestFileCheck(t *testing.T) {
 			if test.setup != nil {
 				test.setup(t, env, test.resource.File)
 			}
+			if test.expectError == nil {
+				env.On("FileSystem").Return(osFileSystem{})
+			}
 
 			fileCheck, err := newResourceCheck(env, "rule-id", test.resource)
 			assert.NoError(err)
 
-			report, err := fileCheck.check(env)
+			report, err := fileCheck.check(context.Background(), env)
 
 			if test.expectError != nil {
 				assert.EqualError(err, test.expectError.Error())
@@ -304,3 +414,141 @@ func TestFileCheck(t *testing.T) {
 		os.RemoveAll(dir)
 	}
 }
+
+func TestFileCheckMemFileSystem(t *testing.T) {
+	fs := memFileSystem{
+		"/etc":                           {mode: os.ModeDir | 0755, user: "root", group: "root"},
+		"/etc/kubernetes":                {mode: os.ModeDir | 0755, user: "root", group: "root"},
+		"/etc/kubernetes/admin.conf":     {content: "apiVersion: v1\nkind: Config\n", mode: 0600, user: "root", group: "root"},
+		"/etc/kubernetes/scheduler.conf": {content: "apiVersion: v1\nkind: Config\n", mode: 0644, user: "root", group: "root"},
+		"/etc/kubernetes/pki":            {mode: os.ModeDir | 0755, user: "root", group: "root"},
+		"/etc/kubernetes/pki/ca.key":     {mode: 0640, user: "root", group: "kube"},
+	}
+
+	tests := []struct {
+		name     string
+		resource compliance.Resource
+		validate func(t *testing.T, report *compliance.Report)
+	}{
+		{
+			name: "file user, group and permissions",
+			resource: compliance.Resource{
+				File: &compliance.File{
+					Path: "/etc/kubernetes/admin.conf",
+				},
+				Condition: `file.permissions == 0600 && file.user == "root" && file.group == "root"`,
+			},
+			validate: func(t *testing.T, report *compliance.Report) {
+				assert.True(t, report.Passed)
+				assert.Equal(t, "/etc/kubernetes/admin.conf", report.Data["file.path"])
+				assert.Equal(t, uint64(0600), report.Data["file.permissions"])
+			},
+		},
+		{
+			name: "file permissions (glob)",
+			resource: compliance.Resource{
+				File: &compliance.File{
+					Path: "/etc/kubernetes/*.conf",
+				},
+				Condition: "file.permissions == 0600",
+			},
+			validate: func(t *testing.T, report *compliance.Report) {
+				assert.False(t, report.Passed)
+				assert.Equal(t, "/etc/kubernetes/scheduler.conf", report.Data["file.path"])
+			},
+		},
+		{
+			name: "yaml file",
+			resource: compliance.Resource{
+				File: &compliance.File{
+					Path: "/etc/kubernetes/scheduler.conf",
+				},
+				Condition: `file.yaml(".kind") == "Config"`,
+			},
+			validate: func(t *testing.T, report *compliance.Report) {
+				assert.True(t, report.Passed)
+			},
+		},
+		{
+			name: "recursive",
+			resource: compliance.Resource{
+				File: &compliance.File{
+					Path:      "/etc/kubernetes",
+					Recursive: true,
+				},
+				Condition: "file.permissions & 0044 == 0",
+			},
+			validate: func(t *testing.T, report *compliance.Report) {
+				assert.False(t, report.Passed)
+				assert.ElementsMatch(t, []string{
+					"/etc/kubernetes",
+					"/etc/kubernetes/scheduler.conf",
+					"/etc/kubernetes/pki",
+					"/etc/kubernetes/pki/ca.key",
+				}, report.Data["file.violations"])
+			},
+		},
+		{
+			name: "existence only",
+			resource: compliance.Resource{
+				File: &compliance.File{
+					Path:          "/etc/kubernetes/admin.conf",
+					ExistenceOnly: true,
+				},
+				Condition: "file.exists",
+			},
+			validate: func(t *testing.T, report *compliance.Report) {
+				assert.True(t, report.Passed)
+				assert.Equal(t, "/etc/kubernetes/admin.conf", report.Data["file.path"])
+				assert.Equal(t, true, report.Data["file.exists"])
+			},
+		},
+		{
+			name: "existence only (glob, no match)",
+			resource: compliance.Resource{
+				File: &compliance.File{
+					Path:          "/home/*/.rhosts",
+					ExistenceOnly: true,
+				},
+				Condition: "!file.exists",
+			},
+			validate: func(t *testing.T, report *compliance.Report) {
+				assert.True(t, report.Passed)
+				assert.Equal(t, "/home/*/.rhosts", report.Data["file.path"])
+				assert.Equal(t, false, report.Data["file.exists"])
+			},
+		},
+		{
+			name: "existence only (glob, unexpected match)",
+			resource: compliance.Resource{
+				File: &compliance.File{
+					Path:          "/etc/kubernetes/*.conf",
+					ExistenceOnly: true,
+				},
+				Condition: "!file.exists",
+			},
+			validate: func(t *testing.T, report *compliance.Report) {
+				assert.False(t, report.Passed)
+				assert.Equal(t, "/etc/kubernetes/admin.conf", report.Data["file.path"])
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			env := &mocks.Env{}
+			defer env.AssertExpectations(t)
+
+			env.On("FileSystem").Return(fs)
+			env.On("NormalizeToHostRoot", mock.Anything).Return(func(p string) string { return p })
+			env.On("RelativeToHostRoot", mock.Anything).Return(func(p string) string { return p })
+
+			fileCheck, err := newResourceCheck(env, "rule-id", test.resource)
+			assert.NoError(t, err)
+
+			report, err := fileCheck.check(context.Background(), env)
+			assert.NoError(t, err)
+			test.validate(t, report)
+		})
+	}
+}
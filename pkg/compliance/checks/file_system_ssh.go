@@ -0,0 +1,276 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package checks
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+// sshStatFormat is the format given to stat(1), the name comes last as it may contain spaces
+const sshStatFormat = "%f %s %Y %U %G %d %n"
+
+// errNoXattr is returned when a remote file doesn't have the requested extended attribute
+var errNoXattr = errors.New("no such attribute")
+
+// sshClientPool holds a connection per remote host shared by all the checks
+var sshClientPool = struct {
+	sync.Mutex
+	clients map[string]*ssh.Client
+}{
+	clients: make(map[string]*ssh.Client),
+}
+
+// sshFileSystem gives access to the files of a remote host by running commands over SSH
+type sshFileSystem struct {
+	addr   string
+	config *ssh.ClientConfig
+}
+
+func newSSHFileSystem(addr string, config *ssh.ClientConfig) *sshFileSystem {
+	return &sshFileSystem{
+		addr:   addr,
+		config: config,
+	}
+}
+
+func (fs *sshFileSystem) poolKey() string {
+	return fs.config.User + "@" + fs.addr
+}
+
+// client returns the pooled connection to the remote host, dialing it if needed
+func (fs *sshFileSystem) client() (*ssh.Client, error) {
+	sshClientPool.Lock()
+	defer sshClientPool.Unlock()
+
+	key := fs.poolKey()
+	if client, found := sshClientPool.clients[key]; found {
+		return client, nil
+	}
+
+	client, err := ssh.Dial("tcp", fs.addr, fs.config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", fs.addr, err)
+	}
+	sshClientPool.clients[key] = client
+	return client, nil
+}
+
+// discardClient closes a broken connection and removes it from the pool
+func (fs *sshFileSystem) discardClient(client *ssh.Client) {
+	sshClientPool.Lock()
+	defer sshClientPool.Unlock()
+
+	key := fs.poolKey()
+	if sshClientPool.clients[key] == client {
+		delete(sshClientPool.clients, key)
+	}
+	client.Close()
+}
+
+// run executes a command on the remote host and returns its output
+func (fs *sshFileSystem) run(op, path, cmd string) ([]byte, error) {
+	client, err := fs.client()
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := client.NewSession()
+	if err != nil {
+		log.Debugf("Discarding connection to %s: %v", fs.addr, err)
+		fs.discardClient(client)
+		return nil, fmt.Errorf("failed to open session on %s: %w", fs.addr, err)
+	}
+	defer session.Close()
+
+	var stderr bytes.Buffer
+	session.Stderr = &stderr
+
+	output, err := session.Output(cmd)
+	if err != nil {
+		if _, ok := err.(*ssh.ExitError); ok {
+			return nil, &os.PathError{Op: op, Path: path, Err: remoteError(stderr.String())}
+		}
+		return nil, fmt.Errorf("failed to run %s on %s: %w", op, fs.addr, err)
+	}
+	return output, nil
+}
+
+func (fs *sshFileSystem) Open(name string) (io.ReadCloser, error) {
+	output, err := fs.run("open", name, "cat -- "+shellQuote(name))
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.NopCloser(bytes.NewReader(output)), nil
+}
+
+func (fs *sshFileSystem) Stat(name string) (os.FileInfo, error) {
+	output, err := fs.run("stat", name, fmt.Sprintf("stat -L -c '%s' -- %s", sshStatFormat, shellQuote(name)))
+	if err != nil {
+		return nil, err
+	}
+
+	fi, err := parseRemoteFileInfo(strings.TrimSuffix(string(output), "\n"))
+	if err != nil {
+		return nil, &os.PathError{Op: "stat", Path: name, Err: err}
+	}
+	fi.name = filepath.Base(name)
+	return fi, nil
+}
+
+// ReadDir returns the entries of a directory, symlinks are not followed
+func (fs *sshFileSystem) ReadDir(dirname string) ([]os.FileInfo, error) {
+	cmd := fmt.Sprintf("find %s -mindepth 1 -maxdepth 1 -exec stat -c '%s' -- {} +", shellQuote(dirname), sshStatFormat)
+	output, err := fs.run("readdir", dirname, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []os.FileInfo
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		fi, err := parseRemoteFileInfo(scanner.Text())
+		if err != nil {
+			return nil, &os.PathError{Op: "readdir", Path: dirname, Err: err}
+		}
+		fi.name = filepath.Base(fi.name)
+		entries = append(entries, fi)
+	}
+	return entries, scanner.Err()
+}
+
+func (fs *sshFileSystem) Glob(pattern string) ([]string, error) {
+	return globFileSystem(fs, pattern)
+}
+
+// Getxattr reads an extended attribute with getfattr(1), symlinks are followed
+func (fs *sshFileSystem) Getxattr(name, attr string) ([]byte, error) {
+	cmd := fmt.Sprintf("getfattr --only-values -n %s -- %s", shellQuote(attr), shellQuote(name))
+	output, err := fs.run("getxattr", name, cmd)
+	if errors.Is(err, errNoXattr) {
+		return nil, nil
+	}
+	return output, err
+}
+
+// remoteFileInfo implements os.FileInfo for the files of a remote host
+type remoteFileInfo struct {
+	name    string
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+	stat    *remoteFileStat
+}
+
+func (fi *remoteFileInfo) Name() string       { return fi.name }
+func (fi *remoteFileInfo) Size() int64        { return fi.size }
+func (fi *remoteFileInfo) Mode() os.FileMode  { return fi.mode }
+func (fi *remoteFileInfo) ModTime() time.Time { return fi.modTime }
+func (fi *remoteFileInfo) IsDir() bool        { return fi.mode.IsDir() }
+func (fi *remoteFileInfo) Sys() interface{}   { return fi.stat }
+
+// parseRemoteFileInfo parses a line printed by stat(1) with sshStatFormat
+func parseRemoteFileInfo(line string) (*remoteFileInfo, error) {
+	fields := strings.SplitN(line, " ", 7)
+	if len(fields) != 7 {
+		return nil, fmt.Errorf("unexpected stat output %q", line)
+	}
+
+	rawMode, err := strconv.ParseUint(fields[0], 16, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mode %q", fields[0])
+	}
+	size, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid size %q", fields[1])
+	}
+	mtime, err := strconv.ParseInt(fields[2], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modification time %q", fields[2])
+	}
+	device, err := strconv.ParseUint(fields[5], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid device %q", fields[5])
+	}
+
+	return &remoteFileInfo{
+		name:    fields[6],
+		size:    size,
+		mode:    unixModeToFileMode(uint32(rawMode)),
+		modTime: time.Unix(mtime, 0),
+		stat: &remoteFileStat{
+			user:   fields[3],
+			group:  fields[4],
+			device: device,
+		},
+	}, nil
+}
+
+// unixModeToFileMode converts a st_mode value to an os.FileMode
+func unixModeToFileMode(mode uint32) os.FileMode {
+	fm := os.FileMode(mode & 0777)
+	switch mode & 0170000 {
+	case 0010000:
+		fm |= os.ModeNamedPipe
+	case 0020000:
+		fm |= os.ModeDevice | os.ModeCharDevice
+	case 0040000:
+		fm |= os.ModeDir
+	case 0060000:
+		fm |= os.ModeDevice
+	case 0120000:
+		fm |= os.ModeSymlink
+	case 0140000:
+		fm |= os.ModeSocket
+	}
+	if mode&04000 != 0 {
+		fm |= os.ModeSetuid
+	}
+	if mode&02000 != 0 {
+		fm |= os.ModeSetgid
+	}
+	if mode&01000 != 0 {
+		fm |= os.ModeSticky
+	}
+	return fm
+}
+
+// remoteError turns the error output of a remote command into an error, the usual
+// errors are mapped so that os.IsNotExist and os.IsPermission keep working
+func remoteError(stderr string) error {
+	msg := strings.TrimSpace(stderr)
+	switch {
+	case strings.Contains(msg, "No such file or directory"):
+		return os.ErrNotExist
+	case strings.Contains(msg, "Permission denied"):
+		return os.ErrPermission
+	case strings.Contains(msg, "No such attribute"):
+		return errNoXattr
+	case msg == "":
+		return fmt.Errorf("remote command failed")
+	default:
+		return fmt.Errorf("%s", msg)
+	}
+}
+
+// shellQuote quotes a string to be passed as a single argument to a shell command
+func shellQuote(s string) string {
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
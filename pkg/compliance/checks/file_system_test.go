@@ -0,0 +1,214 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build !windows
+
+package checks
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+// memFile is a file of memFileSystem
+type memFile struct {
+	content string
+	mode    os.FileMode
+	user    string
+	group   string
+	xattrs  map[string]string
+}
+
+// memFileSystem is an in-memory file system indexed by absolute path, the parent
+// directories of the files have to be added explicitly
+type memFileSystem map[string]memFile
+
+func (fs memFileSystem) info(name string) *remoteFileInfo {
+	f := fs[name]
+	return &remoteFileInfo{
+		name: filepath.Base(name),
+		size: int64(len(f.content)),
+		mode: f.mode,
+		stat: &remoteFileStat{
+			user:  f.user,
+			group: f.group,
+		},
+	}
+}
+
+func (fs memFileSystem) Open(name string) (io.ReadCloser, error) {
+	f, found := fs[name]
+	if !found {
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+	}
+	return ioutil.NopCloser(bytes.NewBufferString(f.content)), nil
+}
+
+func (fs memFileSystem) Stat(name string) (os.FileInfo, error) {
+	if _, found := fs[name]; !found {
+		return nil, &os.PathError{Op: "stat", Path: name, Err: os.ErrNotExist}
+	}
+	return fs.info(name), nil
+}
+
+func (fs memFileSystem) ReadDir(dirname string) ([]os.FileInfo, error) {
+	if f, found := fs[dirname]; !found || !f.mode.IsDir() {
+		return nil, &os.PathError{Op: "readdir", Path: dirname, Err: os.ErrNotExist}
+	}
+
+	var names []string
+	for name := range fs {
+		if name != dirname && filepath.Dir(name) == dirname {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	entries := make([]os.FileInfo, 0, len(names))
+	for _, name := range names {
+		entries = append(entries, fs.info(name))
+	}
+	return entries, nil
+}
+
+func (fs memFileSystem) Glob(pattern string) ([]string, error) {
+	return globFileSystem(fs, pattern)
+}
+
+func (fs memFileSystem) Getxattr(name, attr string) ([]byte, error) {
+	f, found := fs[name]
+	if !found {
+		return nil, &os.PathError{Op: "getxattr", Path: name, Err: os.ErrNotExist}
+	}
+	if value, found := f.xattrs[attr]; found {
+		return []byte(value), nil
+	}
+	return nil, nil
+}
+
+func TestParseRemoteFileInfo(t *testing.T) {
+	assert := assert.New(t)
+
+	fi, err := parseRemoteFileInfo("81a4 1024 1600000000 root adm 2049 /var/log/my file.log")
+	assert.NoError(err)
+	assert.Equal("/var/log/my file.log", fi.Name())
+	assert.Equal(int64(1024), fi.Size())
+	assert.Equal(os.FileMode(0644), fi.Mode())
+	assert.Equal(time.Unix(1600000000, 0), fi.ModTime())
+	assert.False(fi.IsDir())
+
+	user, err := getFileUser(fi)
+	assert.NoError(err)
+	assert.Equal("root", user)
+
+	group, err := getFileGroup(fi)
+	assert.NoError(err)
+	assert.Equal("adm", group)
+
+	device, err := getFileDevice(fi)
+	assert.NoError(err)
+	assert.Equal(uint64(2049), device)
+
+	fi, err = parseRemoteFileInfo("43ff 4096 1600000000 root root 2049 /tmp")
+	assert.NoError(err)
+	assert.True(fi.IsDir())
+	assert.Equal(os.ModeDir|os.ModeSticky|0777, fi.Mode())
+
+	_, err = parseRemoteFileInfo("81a4 1024")
+	assert.Error(err)
+}
+
+func TestShellQuote(t *testing.T) {
+	assert.Equal(t, `'/etc/passwd'`, shellQuote("/etc/passwd"))
+	assert.Equal(t, `'/tmp/it'\''s; rm -rf /'`, shellQuote("/tmp/it's; rm -rf /"))
+}
+
+func TestGlobFileSystem(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "cmplGlobTest")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	for _, name := range []string{"a/x.conf", "a/y.conf", "b/z.conf", "b/z.txt"} {
+		path := filepath.Join(dir, name)
+		assert.NoError(os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(ioutil.WriteFile(path, nil, 0644))
+	}
+
+	for _, pattern := range []string{"*/*.conf", "a/*", "b/z.txt", "c/*", "[ab]/?.conf"} {
+		expected, err := filepath.Glob(filepath.Join(dir, pattern))
+		assert.NoError(err)
+
+		matches, err := globFileSystem(osFileSystem{}, filepath.Join(dir, pattern))
+		assert.NoError(err)
+		assert.Equal(expected, matches, pattern)
+	}
+}
+
+func TestMemFileSystem(t *testing.T) {
+	assert := assert.New(t)
+
+	fs := memFileSystem{
+		"/etc":             {mode: os.ModeDir | 0755, user: "root", group: "root"},
+		"/etc/hosts":       {content: "127.0.0.1 localhost\n", mode: 0644, user: "root", group: "root"},
+		"/etc/hostname":    {content: "host\n", mode: 0644, user: "root", group: "root"},
+		"/etc/ssl":         {mode: os.ModeDir | 0755, user: "root", group: "root"},
+		"/etc/ssl/key.pem": {mode: 0600, user: "root", group: "ssl-cert"},
+	}
+
+	content, err := readFile(fs, "/etc/hosts")
+	assert.NoError(err)
+	assert.Equal("127.0.0.1 localhost\n", string(content))
+
+	_, err = readFile(fs, "/etc/passwd")
+	assert.True(os.IsNotExist(err))
+
+	matches, err := fs.Glob("/etc/host*")
+	assert.NoError(err)
+	assert.Equal([]string{"/etc/hostname", "/etc/hosts"}, matches)
+
+	entries, err := fs.ReadDir("/etc/ssl")
+	assert.NoError(err)
+	assert.Len(entries, 1)
+	assert.Equal("key.pem", entries[0].Name())
+
+	group, err := getFileGroup(entries[0])
+	assert.NoError(err)
+	assert.Equal("ssl-cert", group)
+}
+
+func TestSSHFileSystemConnectionError(t *testing.T) {
+	assert := assert.New(t)
+
+	// grab a free port nobody listens on
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(err)
+	addr := l.Addr().String()
+	l.Close()
+
+	fs := newSSHFileSystem(addr, &ssh.ClientConfig{
+		User:            "compliance",
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+		Timeout:         time.Second,
+	})
+
+	_, err = fs.Stat("/etc/passwd")
+	assert.Error(err)
+
+	_, err = fs.Open("/etc/passwd")
+	assert.Error(err)
+}
@@ -0,0 +1,154 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package checks
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/checks/env"
+	"github.com/DataDog/datadog-agent/pkg/compliance/eval"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+const (
+	iptablesBinary     = "iptables"
+	iptablesSaveBinary = "iptables-save"
+
+	firewallBackendLegacy = "iptables-legacy"
+	firewallBackendNft    = "nft"
+
+	defaultFirewallTable = "filter"
+)
+
+var firewallReportedFields = []string{
+	compliance.FirewallFieldBackend,
+	compliance.FirewallFieldTable,
+	compliance.FirewallFieldChain,
+	compliance.FirewallFieldPolicy,
+}
+
+func resolveFirewall(ctx context.Context, _ env.Env, ruleID string, res compliance.Resource) (interface{}, error) {
+	if res.Firewall == nil {
+		return nil, fmt.Errorf("%s: expecting firewall resource in firewall check", ruleID)
+	}
+
+	firewall := res.Firewall
+
+	table := firewall.Table
+	if table == "" {
+		table = defaultFirewallTable
+	}
+
+	log.Debugf("%s: running firewall check for chain %s in table %s", ruleID, firewall.Chain, table)
+
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	backend, err := iptablesBackend(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to detect firewall backend: %v", ErrResourceNotApplicable, err)
+	}
+
+	exitCode, stdout, err := commandRunner(ctx, iptablesSaveBinary, []string{"-t", table}, true)
+	if err == nil && exitCode != 0 {
+		err = fmt.Errorf("%s exited with code %d", iptablesSaveBinary, exitCode)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to read %s firewall ruleset: %v", ErrResourceNotApplicable, backend, err)
+	}
+
+	policies, rules := parseIptablesSave(stdout)
+
+	policy, ok := policies[firewall.Chain]
+	if !ok {
+		return nil, fmt.Errorf("chain %s not found in table %s", firewall.Chain, table)
+	}
+
+	chainRules := rules[firewall.Chain]
+
+	return &eval.Instance{
+		Vars: eval.VarMap{
+			compliance.FirewallFieldBackend: backend,
+			compliance.FirewallFieldTable:   table,
+			compliance.FirewallFieldChain:   firewall.Chain,
+			compliance.FirewallFieldPolicy:  policy,
+			compliance.FirewallFieldRules:   chainRules,
+		},
+		Functions: eval.FunctionMap{
+			compliance.FirewallFuncHasRule: firewallHasRule(chainRules),
+		},
+	}, nil
+}
+
+// iptablesBackend detects whether iptables is backed by nf_tables or by the legacy xtables
+func iptablesBackend(ctx context.Context) (string, error) {
+	exitCode, stdout, err := commandRunner(ctx, iptablesBinary, []string{"-V"}, true)
+	if err != nil {
+		return "", err
+	}
+	if exitCode != 0 {
+		return "", fmt.Errorf("%s exited with code %d", iptablesBinary, exitCode)
+	}
+
+	// Output looks like `iptables v1.8.4 (nf_tables)`, older versions only support legacy
+	if strings.Contains(string(stdout), "(nf_tables)") {
+		return firewallBackendNft, nil
+	}
+	return firewallBackendLegacy, nil
+}
+
+// parseIptablesSave returns the policy and the rules of every chain output by iptables-save
+func parseIptablesSave(output []byte) (map[string]string, map[string][]string) {
+	policies := make(map[string]string)
+	rules := make(map[string][]string)
+
+	scanner := bufio.NewScanner(strings.NewReader(string(output)))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case strings.HasPrefix(line, ":"):
+			// :INPUT DROP [0:0]
+			fields := strings.Fields(line[1:])
+			if len(fields) >= 2 {
+				policies[fields[0]] = fields[1]
+			}
+		case strings.HasPrefix(line, "-A "):
+			// -A INPUT -i lo -j ACCEPT
+			fields := strings.SplitN(line[3:], " ", 2)
+			if len(fields) == 2 {
+				rules[fields[0]] = append(rules[fields[0]], fields[1])
+			}
+		}
+	}
+
+	return policies, rules
+}
+
+func firewallHasRule(rules []string) eval.Function {
+	return func(_ *eval.Instance, args ...interface{}) (interface{}, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf(`invalid number of arguments, expecting 1 got %d`, len(args))
+		}
+		rule, ok := args[0].(string)
+		if !ok {
+			return nil, errors.New(`expecting string value for rule argument`)
+		}
+
+		rule = strings.Join(strings.Fields(rule), " ")
+		for _, r := range rules {
+			if r == rule {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
@@ -0,0 +1,147 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package checks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/event"
+	"github.com/DataDog/datadog-agent/pkg/compliance/mocks"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+const iptablesSaveOutput = `# Generated by iptables-save v1.8.4 on Tue Oct 13 10:00:00 2020
+*filter
+:INPUT DROP [0:0]
+:FORWARD DROP [0:0]
+:OUTPUT ACCEPT [12:1024]
+-A INPUT -i lo -j ACCEPT
+-A INPUT -p tcp -m tcp --dport 22 -j ACCEPT
+COMMIT
+# Completed on Tue Oct 13 10:00:00 2020
+`
+
+func TestFirewallCheck(t *testing.T) {
+	tests := []struct {
+		name                string
+		resource            compliance.Resource
+		versionOutput       string
+		saveOutput          string
+		commandError        error
+		expectReport        *compliance.Report
+		expectError         error
+		expectNotApplicable bool
+	}{
+		{
+			name: "default deny policy",
+			resource: compliance.Resource{
+				Firewall: &compliance.Firewall{
+					Chain: "INPUT",
+				},
+				Condition: `firewall.policy == "DROP" && firewall.hasRule("-i lo  -j ACCEPT")`,
+			},
+			versionOutput: "iptables v1.8.4 (nf_tables)\n",
+			saveOutput:    iptablesSaveOutput,
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					"firewall.backend": "nft",
+					"firewall.table":   "filter",
+					"firewall.chain":   "INPUT",
+					"firewall.policy":  "DROP",
+				},
+			},
+		},
+		{
+			name: "accept policy",
+			resource: compliance.Resource{
+				Firewall: &compliance.Firewall{
+					Table: "filter",
+					Chain: "OUTPUT",
+				},
+				Condition: `firewall.policy == "DROP"`,
+			},
+			versionOutput: "iptables v1.6.1\n",
+			saveOutput:    iptablesSaveOutput,
+			expectReport: &compliance.Report{
+				Passed: false,
+				Data: event.Data{
+					"firewall.backend": "iptables-legacy",
+					"firewall.table":   "filter",
+					"firewall.chain":   "OUTPUT",
+					"firewall.policy":  "ACCEPT",
+				},
+			},
+		},
+		{
+			name: "unknown chain",
+			resource: compliance.Resource{
+				Firewall: &compliance.Firewall{
+					Chain: "DOCKER-USER",
+				},
+				Condition: `firewall.policy == "DROP"`,
+			},
+			versionOutput: "iptables v1.8.4 (legacy)\n",
+			saveOutput:    iptablesSaveOutput,
+			expectError:   errors.New("chain DOCKER-USER not found in table filter"),
+		},
+		{
+			name: "iptables not available",
+			resource: compliance.Resource{
+				Firewall: &compliance.Firewall{
+					Chain: "INPUT",
+				},
+				Condition: `firewall.policy == "DROP"`,
+			},
+			commandError:        errors.New("command 'iptables' not found"),
+			expectError:         errors.New("resource not applicable: unable to detect firewall backend: command 'iptables' not found"),
+			expectNotApplicable: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			commandRunner = func(ctx context.Context, name string, args []string, captureStdout bool) (int, []byte, error) {
+				if test.commandError != nil {
+					return 0, nil, test.commandError
+				}
+				switch name {
+				case "iptables":
+					assert.Equal([]string{"-V"}, args)
+					return 0, []byte(test.versionOutput), nil
+				case "iptables-save":
+					assert.Equal([]string{"-t", "filter"}, args)
+					return 0, []byte(test.saveOutput), nil
+				}
+				return 0, nil, errors.New("unexpected command")
+			}
+			defer func() {
+				commandRunner = runCommand
+			}()
+
+			env := &mocks.Env{}
+			defer env.AssertExpectations(t)
+
+			firewallCheck, err := newResourceCheck(env, "rule-id", test.resource)
+			assert.NoError(err)
+
+			report, err := firewallCheck.check(context.Background(), env)
+			if test.expectError != nil {
+				assert.EqualError(err, test.expectError.Error())
+				assert.Equal(test.expectNotApplicable, errors.Is(err, ErrResourceNotApplicable))
+			} else {
+				assert.NoError(err)
+				assert.Equal(test.expectReport, report)
+			}
+		})
+	}
+}
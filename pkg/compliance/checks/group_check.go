@@ -12,7 +12,6 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"os"
 	"strconv"
 	"strings"
 
@@ -38,7 +37,7 @@ func resolveGroup(_ context.Context, e env.Env, id string, res compliance.Resour
 
 	group := res.Group
 
-	f, err := os.Open(e.EtcGroupPath())
+	f, err := e.FileSystem().Open(e.EtcGroupPath())
 
 	if err != nil {
 		log.Errorf("%s: failed to open %s: %v", id, e.EtcGroupPath(), err)
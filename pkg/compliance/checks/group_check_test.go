@@ -6,6 +6,7 @@
 package checks
 
 import (
+	"context"
 	"testing"
 
 	"github.com/DataDog/datadog-agent/pkg/compliance"
@@ -70,11 +71,12 @@ func TestGroupCheck(t *testing.T) {
 
 			env := &mocks.Env{}
 			env.On("EtcGroupPath").Return(test.etcGroupFile)
+			env.On("FileSystem").Return(osFileSystem{})
 
 			groupCheck, err := newResourceCheck(env, "rule-id", test.resource)
 			assert.NoError(err)
 
-			result, err := groupCheck.check(env)
+			result, err := groupCheck.check(context.Background(), env)
 			assert.Equal(test.expectReport, result)
 			assert.Equal(test.expectError, err)
 		})
@@ -8,13 +8,13 @@ package checks
 import (
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
-	"os"
 	"regexp"
+	"strconv"
 	"strings"
 	"text/template"
 
 	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/checks/env"
 	"github.com/DataDog/datadog-agent/pkg/compliance/eval"
 	"github.com/DataDog/datadog-agent/pkg/compliance/event"
 	"github.com/DataDog/datadog-agent/pkg/util/jsonquery"
@@ -48,28 +48,50 @@ func yamlGetter(data []byte, query string) (string, error) {
 
 // regexpGetter retrieves the leftmost property matching regexp
 func regexpGetter(data []byte, expr string) (string, error) {
+	match, _, err := regexpCaptures(data, expr)
+	return match, err
+}
+
+// regexpCaptures retrieves the leftmost property matching regexp along with its captured groups.
+// Named groups are mapped by name, unnamed groups by index.
+func regexpCaptures(data []byte, expr string) (string, map[string]string, error) {
 	re, err := regexp.Compile(expr)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
-	match := re.Find(data)
-	if match == nil {
+	match, captures := regexpFindCaptures(re, data)
+	return match, captures, nil
+}
+
+// regexpFindCaptures returns the leftmost match of a compiled regexp in data along with its captured groups
+func regexpFindCaptures(re *regexp.Regexp, data []byte) (string, map[string]string) {
+	loc := re.FindSubmatchIndex(data)
+	if loc == nil {
 		return "", nil
 	}
 
-	return string(match), nil
+	var captures map[string]string
+	if re.NumSubexp() > 0 {
+		captures = make(map[string]string, re.NumSubexp())
+		for i, name := range re.SubexpNames() {
+			// Group 0 is the whole match and unmatched optional groups are omitted
+			if i == 0 || loc[2*i] < 0 {
+				continue
+			}
+			if name == "" {
+				name = strconv.Itoa(i)
+			}
+			captures[name] = string(data[loc[2*i]:loc[2*i+1]])
+		}
+	}
+
+	return string(data[loc[0]:loc[1]]), captures
 }
 
 // queryValueFromFile retrieves a value from a file with the provided getter func
-func queryValueFromFile(filePath string, query string, get getter) (string, error) {
-	f, err := os.Open(filePath)
-	if err != nil {
-		return "", err
-	}
-	defer f.Close()
-
-	data, err := ioutil.ReadAll(f)
+func queryValueFromFile(fs env.FileSystem, filePath string, query string, get getter) (string, error) {
+	data, err := readFile(fs, filePath)
 	if err != nil {
 		return "", err
 	}
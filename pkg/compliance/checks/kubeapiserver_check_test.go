@@ -5,6 +5,7 @@
 package checks
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -68,7 +69,7 @@ func (f *kubeApiserverFixture) run(t *testing.T) {
 	kubeCheck, err := newResourceCheck(env, "rule-id", f.resource)
 	assert.NoError(err)
 
-	report, err := kubeCheck.check(env)
+	report, err := kubeCheck.check(context.Background(), env)
 	assert.Equal(f.expectReport, report)
 	if f.expectError != nil {
 		assert.EqualError(err, f.expectError.Error())
@@ -0,0 +1,118 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package checks
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/checks/env"
+	"github.com/DataDog/datadog-agent/pkg/compliance/eval"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+const defaultLoginDefsPath = "/etc/login.defs"
+
+var loginDefsReportedFields = []string{
+	compliance.LoginDefsFieldPath,
+	compliance.LoginDefsFieldKey,
+	compliance.LoginDefsFieldFound,
+	compliance.LoginDefsFieldValue,
+	compliance.LoginDefsFieldExpected,
+}
+
+// resolveLoginDefs looks up the value of a key in a password or login policy file. The resource is not
+// applicable when the file doesn't exist, a missing key is reported as not found.
+func resolveLoginDefs(_ context.Context, e env.Env, ruleID string, res compliance.Resource) (interface{}, error) {
+	if res.LoginDefs == nil {
+		return nil, fmt.Errorf("%s: expecting loginDefs resource in loginDefs check", ruleID)
+	}
+
+	loginDefs := res.LoginDefs
+
+	if err := loginDefs.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", ruleID, err)
+	}
+
+	path := loginDefs.Path
+	if path == "" {
+		path = defaultLoginDefsPath
+	}
+
+	path, err := resolvePath(e, path)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("%s: running loginDefs check for %s in %s", ruleID, loginDefs.Key, path)
+
+	data, err := readFile(e.FileSystem(), e.NormalizeToHostRoot(path))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w: %s not found", ErrResourceNotApplicable, path)
+		}
+		return nil, fmt.Errorf("%s: loginDefs check failed to read %s: %w", ruleID, path, err)
+	}
+
+	value, found := lookupLoginDefsValue(loginDefs.Key, data)
+
+	return &eval.Instance{
+		Vars: eval.VarMap{
+			compliance.LoginDefsFieldPath:        path,
+			compliance.LoginDefsFieldKey:         loginDefs.Key,
+			compliance.LoginDefsFieldFound:       found,
+			compliance.LoginDefsFieldValue:       value,
+			compliance.LoginDefsFieldIntValue:    parseLoginDefsInt(value),
+			compliance.LoginDefsFieldExpected:    loginDefs.Expected,
+			compliance.LoginDefsFieldIntExpected: parseLoginDefsInt(loginDefs.Expected),
+		},
+	}, nil
+}
+
+// lookupLoginDefsValue returns the value of a key, separated from it by blanks or an equal sign. Comments,
+// trailing ones included, and the quotes around the value are dropped. As for the shadow utilities, the
+// last occurrence of a key wins.
+func lookupLoginDefsValue(key string, data []byte) (string, bool) {
+	re := regexp.MustCompile(`^` + regexp.QuoteMeta(key) + `(?:\s*=\s*|\s+)(.*)$`)
+
+	value, found := "", false
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		m := re.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+
+		v := m[1]
+		if i := strings.Index(v, "#"); i >= 0 && (i == 0 || v[i-1] == ' ' || v[i-1] == '\t') {
+			v = v[:i]
+		}
+		value, found = strings.Trim(strings.TrimSpace(v), `"`), true
+	}
+	return value, found
+}
+
+// parseLoginDefsInt returns the numeric value of a setting, 0 when it isn't a number. As for the shadow
+// utilities, a leading 0 is for octal values such as UMASK 027 and 0x for hexadecimal ones.
+func parseLoginDefsInt(value string) int64 {
+	i, err := strconv.ParseInt(value, 0, 64)
+	if err != nil {
+		return 0
+	}
+	return i
+}
@@ -0,0 +1,206 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build !windows
+
+package checks
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/event"
+	"github.com/DataDog/datadog-agent/pkg/compliance/mocks"
+
+	"github.com/stretchr/testify/mock"
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestLoginDefsCheck(t *testing.T) {
+	fs := memFileSystem{
+		"/etc":          {mode: os.ModeDir | 0755},
+		"/etc/security": {mode: os.ModeDir | 0755},
+		"/etc/login.defs": {content: `#
+# /etc/login.defs - Configuration control definitions for the login package.
+#
+MAIL_DIR        /var/mail
+#PASS_MAX_DAYS	99999
+PASS_MAX_DAYS	99999
+  PASS_MAX_DAYS   90
+PASS_MIN_DAYS 7 # at least a week
+PASS_WARN_AGE
+UMASK		027
+ENCRYPT_METHOD "SHA512"
+`, mode: 0644},
+		"/etc/security/pwquality.conf": {content: `# minlen = 9
+minlen = 14
+dcredit=-1
+`, mode: 0644},
+	}
+
+	tests := []struct {
+		name                string
+		resource            compliance.Resource
+		expectReport        *compliance.Report
+		expectNotApplicable bool
+	}{
+		{
+			name: "last occurrence wins",
+			resource: compliance.Resource{
+				LoginDefs: &compliance.LoginDefs{
+					Key:      "PASS_MAX_DAYS",
+					Expected: "365",
+				},
+				Condition: `loginDefs.found && loginDefs.intValue <= loginDefs.intExpected`,
+			},
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					"loginDefs.path":     "/etc/login.defs",
+					"loginDefs.key":      "PASS_MAX_DAYS",
+					"loginDefs.found":    true,
+					"loginDefs.value":    "90",
+					"loginDefs.expected": "365",
+				},
+			},
+		},
+		{
+			name: "trailing comment",
+			resource: compliance.Resource{
+				LoginDefs: &compliance.LoginDefs{
+					Key:      "PASS_MIN_DAYS",
+					Expected: "7",
+				},
+				Condition: `loginDefs.intValue >= loginDefs.intExpected`,
+			},
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					"loginDefs.path":     "/etc/login.defs",
+					"loginDefs.key":      "PASS_MIN_DAYS",
+					"loginDefs.found":    true,
+					"loginDefs.value":    "7",
+					"loginDefs.expected": "7",
+				},
+			},
+		},
+		{
+			name: "octal value",
+			resource: compliance.Resource{
+				LoginDefs: &compliance.LoginDefs{
+					Key:      "UMASK",
+					Expected: "027",
+				},
+				Condition: `loginDefs.intValue & 0027 == loginDefs.intExpected`,
+			},
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					"loginDefs.path":     "/etc/login.defs",
+					"loginDefs.key":      "UMASK",
+					"loginDefs.found":    true,
+					"loginDefs.value":    "027",
+					"loginDefs.expected": "027",
+				},
+			},
+		},
+		{
+			name: "quoted value",
+			resource: compliance.Resource{
+				LoginDefs: &compliance.LoginDefs{
+					Key:      "ENCRYPT_METHOD",
+					Expected: "SHA512",
+				},
+				Condition: `loginDefs.value == loginDefs.expected`,
+			},
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					"loginDefs.path":     "/etc/login.defs",
+					"loginDefs.key":      "ENCRYPT_METHOD",
+					"loginDefs.found":    true,
+					"loginDefs.value":    "SHA512",
+					"loginDefs.expected": "SHA512",
+				},
+			},
+		},
+		{
+			name: "key without value",
+			resource: compliance.Resource{
+				LoginDefs: &compliance.LoginDefs{
+					Key:      "PASS_WARN_AGE",
+					Expected: "7",
+				},
+				Condition: `loginDefs.intValue >= loginDefs.intExpected`,
+			},
+			expectReport: &compliance.Report{
+				Passed: false,
+				Data: event.Data{
+					"loginDefs.path":     "/etc/login.defs",
+					"loginDefs.key":      "PASS_WARN_AGE",
+					"loginDefs.found":    false,
+					"loginDefs.value":    "",
+					"loginDefs.expected": "7",
+				},
+			},
+		},
+		{
+			name: "password quality",
+			resource: compliance.Resource{
+				LoginDefs: &compliance.LoginDefs{
+					Path:     "/etc/security/pwquality.conf",
+					Key:      "minlen",
+					Expected: "14",
+				},
+				Condition: `loginDefs.intValue >= loginDefs.intExpected`,
+			},
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					"loginDefs.path":     "/etc/security/pwquality.conf",
+					"loginDefs.key":      "minlen",
+					"loginDefs.found":    true,
+					"loginDefs.value":    "14",
+					"loginDefs.expected": "14",
+				},
+			},
+		},
+		{
+			name: "missing file",
+			resource: compliance.Resource{
+				LoginDefs: &compliance.LoginDefs{
+					Path: "/etc/security/pwquality.conf.d/50-cis.conf",
+					Key:  "minlen",
+				},
+				Condition: `loginDefs.found`,
+			},
+			expectNotApplicable: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			env := &mocks.Env{}
+			env.On("FileSystem").Return(fs)
+			env.On("NormalizeToHostRoot", mock.Anything).Return(func(path string) string { return path })
+
+			loginDefsCheck, err := newResourceCheck(env, "rule-id", test.resource)
+			assert.NoError(err)
+
+			report, err := loginDefsCheck.check(context.Background(), env)
+			if test.expectNotApplicable {
+				assert.True(errors.Is(err, ErrResourceNotApplicable))
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(test.expectReport, report)
+		})
+	}
+}
@@ -6,6 +6,8 @@
 package checks
 
 import (
+	"context"
+
 	"github.com/DataDog/datadog-agent/pkg/compliance"
 	"github.com/DataDog/datadog-agent/pkg/compliance/checks/env"
 
@@ -16,7 +18,7 @@ type mockCheckable struct {
 	mock.Mock
 }
 
-func (m *mockCheckable) check(env env.Env) (*compliance.Report, error) {
-	args := m.Called(env)
+func (m *mockCheckable) check(ctx context.Context, env env.Env) (*compliance.Report, error) {
+	args := m.Called(ctx, env)
 	return args.Get(0).(*compliance.Report), args.Error(1)
 }
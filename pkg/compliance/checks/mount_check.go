@@ -0,0 +1,178 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package checks
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/checks/env"
+	"github.com/DataDog/datadog-agent/pkg/compliance/eval"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+const procMountsPath = "/proc/mounts"
+
+var mountReportedFields = []string{
+	compliance.MountFieldPath,
+	compliance.MountFieldMountPoint,
+	compliance.MountFieldOptions,
+	compliance.MountFieldRequiredOptions,
+	compliance.MountFieldMissingOptions,
+}
+
+// mountEntry describes a line of /proc/mounts
+type mountEntry struct {
+	device     string
+	mountPoint string
+	fsType     string
+	options    []string
+}
+
+func resolveMount(_ context.Context, e env.Env, ruleID string, res compliance.Resource) (interface{}, error) {
+	if res.Mount == nil {
+		return nil, fmt.Errorf("%s: expecting mount resource in mount check", ruleID)
+	}
+
+	mount := res.Mount
+
+	log.Debugf("%s: running mount check for %q", ruleID, mount.Path)
+
+	path, err := resolvePath(e, mount.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := readMounts(e.NormalizeToHostRoot(procMountsPath))
+	if err != nil {
+		return nil, err
+	}
+
+	entry := findMountEntry(entries, filepath.Clean(path))
+	if entry == nil {
+		return nil, fmt.Errorf("no mount point found for %q", path)
+	}
+
+	var missingOptions []string
+	for _, option := range mount.RequiredOptions {
+		if !hasMountOption(entry.options, option) {
+			missingOptions = append(missingOptions, option)
+		}
+	}
+
+	return &eval.Instance{
+		Vars: eval.VarMap{
+			compliance.MountFieldPath:               path,
+			compliance.MountFieldMountPoint:         entry.mountPoint,
+			compliance.MountFieldDevice:             entry.device,
+			compliance.MountFieldFSType:             entry.fsType,
+			compliance.MountFieldOptions:            entry.options,
+			compliance.MountFieldRequiredOptions:    mount.RequiredOptions,
+			compliance.MountFieldMissingOptions:     missingOptions,
+			compliance.MountFieldHasRequiredOptions: len(missingOptions) == 0,
+		},
+		Functions: eval.FunctionMap{
+			compliance.MountFuncHasOption: mountHasOption(entry.options),
+		},
+	}, nil
+}
+
+func mountHasOption(options []string) eval.Function {
+	return func(_ *eval.Instance, args ...interface{}) (interface{}, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf(`invalid number of arguments, expecting 1 got %d`, len(args))
+		}
+		option, ok := args[0].(string)
+		if !ok {
+			return nil, errors.New(`expecting string value for option argument`)
+		}
+		return hasMountOption(options, option), nil
+	}
+}
+
+func hasMountOption(options []string, option string) bool {
+	for _, o := range options {
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
+
+// findMountEntry returns the entry with the longest mount point holding path.
+// Later entries take precedence as they are stacked on top of earlier ones.
+func findMountEntry(entries []*mountEntry, path string) *mountEntry {
+	var found *mountEntry
+	for _, entry := range entries {
+		if !isUnderMountPoint(path, entry.mountPoint) {
+			continue
+		}
+		if found == nil || len(entry.mountPoint) >= len(found.mountPoint) {
+			found = entry
+		}
+	}
+	return found
+}
+
+func isUnderMountPoint(path, mountPoint string) bool {
+	if mountPoint == "/" || path == mountPoint {
+		return true
+	}
+	return strings.HasPrefix(path, mountPoint+"/")
+}
+
+func readMounts(path string) ([]*mountEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []*mountEntry
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 4 {
+			continue
+		}
+		entries = append(entries, &mountEntry{
+			device:     unescapeMountField(fields[0]),
+			mountPoint: unescapeMountField(fields[1]),
+			fsType:     fields[2],
+			options:    strings.Split(fields[3], ","),
+		})
+	}
+
+	return entries, scanner.Err()
+}
+
+// unescapeMountField decodes the octal escapes (e.g. \040 for space) used in /proc/mounts
+func unescapeMountField(field string) string {
+	if !strings.Contains(field, `\`) {
+		return field
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(field); i++ {
+		if field[i] == '\\' && i+4 <= len(field) {
+			if c, err := strconv.ParseUint(field[i+1:i+4], 8, 8); err == nil {
+				b.WriteByte(byte(c))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(field[i])
+	}
+	return b.String()
+}
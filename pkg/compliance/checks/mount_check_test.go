@@ -0,0 +1,107 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package checks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/event"
+	"github.com/DataDog/datadog-agent/pkg/compliance/mocks"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestMountCheck(t *testing.T) {
+	tests := []struct {
+		name         string
+		resource     compliance.Resource
+		expectReport *compliance.Report
+	}{
+		{
+			name: "required options present",
+			resource: compliance.Resource{
+				Mount: &compliance.Mount{
+					Path:            "/run/lock",
+					RequiredOptions: []string{"nosuid", "nodev", "noexec"},
+				},
+				Condition: `mount.hasRequiredOptions`,
+			},
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					"mount.path":            "/run/lock",
+					"mount.mountPoint":      "/run/lock",
+					"mount.options":         []string{"rw", "nosuid", "nodev", "noexec", "relatime", "size=5120k"},
+					"mount.requiredOptions": []string{"nosuid", "nodev", "noexec"},
+					"mount.missingOptions":  []string(nil),
+				},
+			},
+		},
+		{
+			name: "required options missing",
+			resource: compliance.Resource{
+				Mount: &compliance.Mount{
+					Path:            "/dev/shm/test",
+					RequiredOptions: []string{"nosuid", "nodev", "noexec"},
+				},
+				Condition: `mount.hasRequiredOptions`,
+			},
+			expectReport: &compliance.Report{
+				Passed: false,
+				Data: event.Data{
+					"mount.path":            "/dev/shm/test",
+					"mount.mountPoint":      "/dev/shm",
+					"mount.options":         []string{"rw", "nosuid", "nodev"},
+					"mount.requiredOptions": []string{"nosuid", "nodev", "noexec"},
+					"mount.missingOptions":  []string{"noexec"},
+				},
+			},
+		},
+		{
+			name: "longest mount point",
+			resource: compliance.Resource{
+				Mount: &compliance.Mount{
+					Path: "/tmp",
+				},
+				Condition: `mount.mountPoint == "/" && !mount.hasOption("noexec")`,
+			},
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					"mount.path":            "/tmp",
+					"mount.mountPoint":      "/",
+					"mount.options":         []string{"rw", "relatime", "discard", "errors=remount-ro"},
+					"mount.requiredOptions": []string(nil),
+					"mount.missingOptions":  []string(nil),
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			env := &mocks.Env{}
+			defer env.AssertExpectations(t)
+			env.On("NormalizeToHostRoot", "/proc/mounts").Return("./testdata/file/mounts")
+
+			mountCheck, err := newResourceCheck(env, "rule-id", test.resource)
+			assert.NoError(err)
+
+			report, err := mountCheck.check(context.Background(), env)
+			assert.NoError(err)
+			assert.Equal(test.expectReport, report)
+		})
+	}
+}
+
+func TestUnescapeMountField(t *testing.T) {
+	assert.Equal(t, "/mnt/my disk", unescapeMountField(`/mnt/my\040disk`))
+	assert.Equal(t, "/mnt/disk", unescapeMountField("/mnt/disk"))
+}
@@ -5,6 +5,7 @@
 package checks
 
 import (
+	"context"
 	"testing"
 
 	"github.com/DataDog/datadog-agent/pkg/compliance"
@@ -42,7 +43,7 @@ func (f *processFixture) run(t *testing.T) {
 	processCheck, err := newResourceCheck(env, "rule-id", f.resource)
 	assert.NoError(err)
 
-	result, err := processCheck.check(env)
+	result, err := processCheck.check(context.Background(), env)
 	assert.Equal(f.expectReport, result)
 	assert.Equal(f.expectError, err)
 }
@@ -0,0 +1,16 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build !windows
+
+package checks
+
+import (
+	"fmt"
+)
+
+func getRegistryValue(key, value string) (string, bool, error) {
+	return "", false, fmt.Errorf("%w: registry check is only supported on windows", ErrResourceNotApplicable)
+}
@@ -0,0 +1,53 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/checks/env"
+	"github.com/DataDog/datadog-agent/pkg/compliance/eval"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+var registryReportedFields = []string{
+	compliance.WinRegistryFieldKey,
+	compliance.WinRegistryFieldValue,
+	compliance.WinRegistryFieldData,
+	compliance.WinRegistryFieldExpected,
+}
+
+var (
+	registryReader func(key, value string) (string, bool, error) = getRegistryValue
+)
+
+func resolveRegistry(_ context.Context, _ env.Env, ruleID string, res compliance.Resource) (interface{}, error) {
+	if res.WinRegistry == nil {
+		return nil, fmt.Errorf("%s: expecting registry resource in registry check", ruleID)
+	}
+
+	registry := res.WinRegistry
+
+	log.Debugf("%s: running registry check for %s\\%s", ruleID, registry.Key, registry.Value)
+
+	data, exists, err := registryReader(registry.Key, registry.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	// A missing key or value is not an error, the condition decides whether it is expected
+	return &eval.Instance{
+		Vars: eval.VarMap{
+			compliance.WinRegistryFieldKey:      registry.Key,
+			compliance.WinRegistryFieldValue:    registry.Value,
+			compliance.WinRegistryFieldExists:   exists,
+			compliance.WinRegistryFieldData:     data,
+			compliance.WinRegistryFieldExpected: registry.Expected,
+		},
+	}, nil
+}
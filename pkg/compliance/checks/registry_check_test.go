@@ -0,0 +1,121 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package checks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/event"
+	"github.com/DataDog/datadog-agent/pkg/compliance/mocks"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestRegistryCheck(t *testing.T) {
+	const (
+		key   = `HKLM\SOFTWARE\Policies\Microsoft\Windows\WinRM\Service`
+		value = "AllowBasic"
+	)
+
+	tests := []struct {
+		name         string
+		resource     compliance.Resource
+		data         string
+		exists       bool
+		expectReport *compliance.Report
+	}{
+		{
+			name: "expected value",
+			resource: compliance.Resource{
+				WinRegistry: &compliance.WinRegistry{
+					Key:      key,
+					Value:    value,
+					Expected: "0",
+				},
+				Condition: `registry.data == registry.expected`,
+			},
+			data:   "0",
+			exists: true,
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					"registry.key":      key,
+					"registry.value":    value,
+					"registry.data":     "0",
+					"registry.expected": "0",
+				},
+			},
+		},
+		{
+			name: "unexpected value",
+			resource: compliance.Resource{
+				WinRegistry: &compliance.WinRegistry{
+					Key:      key,
+					Value:    value,
+					Expected: "0",
+				},
+				Condition: `registry.data == registry.expected`,
+			},
+			data:   "1",
+			exists: true,
+			expectReport: &compliance.Report{
+				Passed: false,
+				Data: event.Data{
+					"registry.key":      key,
+					"registry.value":    value,
+					"registry.data":     "1",
+					"registry.expected": "0",
+				},
+			},
+		},
+		{
+			name: "missing value",
+			resource: compliance.Resource{
+				WinRegistry: &compliance.WinRegistry{
+					Key:   key,
+					Value: value,
+				},
+				Condition: `!registry.exists`,
+			},
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					"registry.key":      key,
+					"registry.value":    value,
+					"registry.data":     "",
+					"registry.expected": "",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			registryReader = func(k, v string) (string, bool, error) {
+				assert.Equal(key, k)
+				assert.Equal(value, v)
+				return test.data, test.exists, nil
+			}
+			defer func() {
+				registryReader = getRegistryValue
+			}()
+
+			env := &mocks.Env{}
+			defer env.AssertExpectations(t)
+
+			registryCheck, err := newResourceCheck(env, "rule-id", test.resource)
+			assert.NoError(err)
+
+			report, err := registryCheck.check(context.Background(), env)
+			assert.NoError(err)
+			assert.Equal(test.expectReport, report)
+		})
+	}
+}
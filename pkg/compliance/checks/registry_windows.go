@@ -0,0 +1,80 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build windows
+
+package checks
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sys/windows/registry"
+)
+
+var registryRootKeys = map[string]registry.Key{
+	"HKLM":                registry.LOCAL_MACHINE,
+	"HKEY_LOCAL_MACHINE":  registry.LOCAL_MACHINE,
+	"HKCU":                registry.CURRENT_USER,
+	"HKEY_CURRENT_USER":   registry.CURRENT_USER,
+	"HKU":                 registry.USERS,
+	"HKEY_USERS":          registry.USERS,
+	"HKCR":                registry.CLASSES_ROOT,
+	"HKEY_CLASSES_ROOT":   registry.CLASSES_ROOT,
+	"HKCC":                registry.CURRENT_CONFIG,
+	"HKEY_CURRENT_CONFIG": registry.CURRENT_CONFIG,
+}
+
+// getRegistryValue reads a registry value and returns its data as a string,
+// integers are formatted in base 10 and multi strings joined with commas
+func getRegistryValue(key, value string) (string, bool, error) {
+	parts := strings.SplitN(key, `\`, 2)
+	root, ok := registryRootKeys[strings.ToUpper(parts[0])]
+	if !ok {
+		return "", false, fmt.Errorf("unsupported registry root key in %s", key)
+	}
+
+	var path string
+	if len(parts) == 2 {
+		path = parts[1]
+	}
+
+	k, err := registry.OpenKey(root, path, registry.QUERY_VALUE)
+	if err != nil {
+		if errors.Is(err, registry.ErrNotExist) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	defer k.Close()
+
+	_, valType, err := k.GetValue(value, nil)
+	if err != nil {
+		if errors.Is(err, registry.ErrNotExist) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+
+	switch valType {
+	case registry.SZ, registry.EXPAND_SZ:
+		s, _, err := k.GetStringValue(value)
+		return s, err == nil, err
+	case registry.DWORD, registry.QWORD:
+		i, _, err := k.GetIntegerValue(value)
+		return strconv.FormatUint(i, 10), err == nil, err
+	case registry.MULTI_SZ:
+		s, _, err := k.GetStringsValue(value)
+		return strings.Join(s, ","), err == nil, err
+	case registry.BINARY:
+		b, _, err := k.GetBinaryValue(value)
+		return hex.EncodeToString(b), err == nil, err
+	default:
+		return "", true, fmt.Errorf("unsupported registry value type %d for %s\\%s", valType, key, value)
+	}
+}
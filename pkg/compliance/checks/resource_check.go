@@ -27,8 +27,16 @@ var (
 
 	// ErrResourceFailedToResolve is returned when a resource failed to resolve to any instances for evaluation
 	ErrResourceFailedToResolve = errors.New("failed to resolve resource")
+
+	// ErrResourceNotApplicable is returned when a resource cannot be evaluated on this host
+	ErrResourceNotApplicable = compliance.ErrResourceNotApplicable
 )
 
+// violationsReporter is implemented by iterators able to report every instance failing a condition
+type violationsReporter interface {
+	reportViolations(expression *eval.IterableExpression, report *compliance.Report) error
+}
+
 type resolveFunc func(ctx context.Context, e env.Env, ruleID string, resource compliance.Resource) (interface{}, error)
 
 type resourceCheck struct {
@@ -41,8 +49,8 @@ type resourceCheck struct {
 	reportedFields []string
 }
 
-func (c *resourceCheck) check(env env.Env) (*compliance.Report, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+func (c *resourceCheck) check(ctx context.Context, env env.Env) (*compliance.Report, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
 	defer cancel()
 
 	resolved, err := c.resolve(ctx, env, c.ruleID, c.resource)
@@ -50,10 +58,10 @@ func (c *resourceCheck) check(env env.Env) (*compliance.Report, error) {
 		return nil, err
 	}
 
-	return c.evaluate(env, resolved)
+	return c.evaluate(ctx, env, resolved)
 }
 
-func (c *resourceCheck) evaluate(env env.Env, resolved interface{}) (*compliance.Report, error) {
+func (c *resourceCheck) evaluate(ctx context.Context, env env.Env, resolved interface{}) (*compliance.Report, error) {
 	conditionExpression, err := eval.Cache.ParseIterable(c.resource.Condition)
 	if err != nil {
 		return nil, err
@@ -76,7 +84,7 @@ func (c *resourceCheck) evaluate(env env.Env, resolved interface{}) (*compliance
 				return nil, err
 			}
 			if useFallback {
-				return c.fallback.check(env)
+				return c.fallback.check(ctx, env)
 			}
 		}
 
@@ -95,7 +103,14 @@ func (c *resourceCheck) evaluate(env env.Env, resolved interface{}) (*compliance
 		if err != nil {
 			return nil, err
 		}
-		return instanceResultToReport(result, c.reportedFields), nil
+
+		report := instanceResultToReport(result, c.reportedFields)
+		if reporter, ok := resolved.(violationsReporter); ok && !report.Passed {
+			if err := reporter.reportViolations(conditionExpression, report); err != nil {
+				return nil, err
+			}
+		}
+		return report, nil
 	default:
 		return nil, ErrResourceFailedToResolve
 	}
@@ -160,6 +175,26 @@ func resourceKindToResolverAndFields(kind compliance.ResourceKind) (resolveFunc,
 		return resolveDocker, dockerReportedFields, nil
 	case compliance.KindKubernetes:
 		return resolveKubeapiserver, kubeResourceReportedFields, nil
+	case compliance.KindEnv:
+		return resolveEnv, envReportedFields, nil
+	case compliance.KindService:
+		return resolveService, serviceReportedFields, nil
+	case compliance.KindMount:
+		return resolveMount, mountReportedFields, nil
+	case compliance.KindFirewall:
+		return resolveFirewall, firewallReportedFields, nil
+	case compliance.KindWinRegistry:
+		return resolveRegistry, registryReportedFields, nil
+	case compliance.KindAuthConfig:
+		return resolveAuthConfig, authConfigReportedFields, nil
+	case compliance.KindCron:
+		return resolveCron, cronReportedFields, nil
+	case compliance.KindLoginDefs:
+		return resolveLoginDefs, loginDefsReportedFields, nil
+	case compliance.KindSSHDConfig:
+		return resolveSSHDConfig, sshdConfigReportedFields, nil
+	case compliance.KindSecurityContext:
+		return resolveSecurityContext, securityContextReportedFields, nil
 	default:
 		return nil, nil, ErrResourceKindNotSupported
 	}
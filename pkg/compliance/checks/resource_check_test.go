@@ -15,6 +15,7 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/compliance/event"
 	"github.com/DataDog/datadog-agent/pkg/compliance/mocks"
 
+	"github.com/stretchr/testify/mock"
 	assert "github.com/stretchr/testify/require"
 )
 
@@ -31,7 +32,7 @@ func TestResourceCheck(t *testing.T) {
 	}
 
 	fallback := &mockCheckable{}
-	fallback.On("check", e).Return(fallbackReport, nil)
+	fallback.On("check", mock.Anything, e).Return(fallbackReport, nil)
 
 	tests := []struct {
 		name              string
@@ -145,7 +146,7 @@ func TestResourceCheck(t *testing.T) {
 				reportedFields: test.reportedFields,
 			}
 
-			report, err := c.check(e)
+			report, err := c.check(context.Background(), e)
 			assert.Equal(test.expectReport, report)
 			assert.Equal(test.expectErr, err)
 		})
@@ -0,0 +1,188 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package checks
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/checks/env"
+	"github.com/DataDog/datadog-agent/pkg/compliance/eval"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+const (
+	lsmSELinux  = "selinux"
+	lsmAppArmor = "apparmor"
+
+	selinuxEnforcePath    = "/sys/fs/selinux/enforce"
+	apparmorEnabledPath   = "/sys/module/apparmor/parameters/enabled"
+	selinuxXattr          = "security.selinux"
+	processAttrCurrentFmt = "/proc/%d/attr/current"
+)
+
+var securityContextReportedFields = []string{
+	compliance.SecurityContextFieldPath,
+	compliance.SecurityContextFieldProcess,
+	compliance.SecurityContextFieldLSM,
+	compliance.SecurityContextFieldLabel,
+	compliance.SecurityContextFieldExpected,
+}
+
+// resolveSecurityContext resolves the SELinux context or AppArmor profile of a file or of the processes with a
+// given name. The resource is not applicable when neither SELinux is enforcing nor AppArmor is enabled, and
+// for files when AppArmor is the active LSM as it doesn't label them.
+func resolveSecurityContext(_ context.Context, e env.Env, ruleID string, res compliance.Resource) (interface{}, error) {
+	if res.SecurityContext == nil {
+		return nil, fmt.Errorf("%s: expecting securityContext resource in securityContext check", ruleID)
+	}
+
+	securityContext := res.SecurityContext
+
+	if err := securityContext.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", ruleID, err)
+	}
+
+	lsm, err := activeLSM(e)
+	if err != nil {
+		return nil, fmt.Errorf("%s: securityContext check failed to detect the active LSM: %w", ruleID, err)
+	}
+	if lsm == "" {
+		return nil, fmt.Errorf("%w: neither SELinux nor AppArmor is enforcing", ErrResourceNotApplicable)
+	}
+
+	if securityContext.Path != "" {
+		return resolveFileSecurityContext(e, ruleID, lsm, securityContext)
+	}
+	return resolveProcessSecurityContext(e, ruleID, lsm, securityContext)
+}
+
+func resolveFileSecurityContext(e env.Env, ruleID, lsm string, securityContext *compliance.SecurityContext) (interface{}, error) {
+	if lsm != lsmSELinux {
+		return nil, fmt.Errorf("%w: %s doesn't label files", ErrResourceNotApplicable, lsm)
+	}
+
+	path, err := resolvePath(e, securityContext.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("%s: running securityContext check for %s", ruleID, path)
+
+	value, err := e.FileSystem().Getxattr(e.NormalizeToHostRoot(path), selinuxXattr)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w: %s not found", ErrResourceNotApplicable, path)
+		}
+		return nil, fmt.Errorf("%s: securityContext check failed to read the context of %s: %w", ruleID, path, err)
+	}
+
+	instance := newSecurityContextInstance(lsm, string(value), securityContext.Expected)
+	instance.Vars[compliance.SecurityContextFieldPath] = path
+	return instance, nil
+}
+
+func resolveProcessSecurityContext(e env.Env, ruleID, lsm string, securityContext *compliance.SecurityContext) (interface{}, error) {
+	log.Debugf("%s: running securityContext check for process %s", ruleID, securityContext.Process)
+
+	processes, err := getProcesses(cacheValidity)
+	if err != nil {
+		return nil, log.Errorf("%s: Unable to fetch processes: %v", ruleID, err)
+	}
+
+	matchedProcesses := processes.findProcessesByName(securityContext.Process)
+	if len(matchedProcesses) == 0 {
+		return nil, fmt.Errorf("no process found for securityContext check %q", securityContext.Process)
+	}
+
+	var instances []*eval.Instance
+	for _, mp := range matchedProcesses {
+		value, err := readFile(e.FileSystem(), e.NormalizeToHostRoot(fmt.Sprintf(processAttrCurrentFmt, mp.Pid)))
+		if err != nil {
+			if os.IsNotExist(err) {
+				// the process exited since the processes were fetched
+				continue
+			}
+			return nil, fmt.Errorf("%s: securityContext check failed to read the context of %s (pid %d): %w", ruleID, mp.Name, mp.Pid, err)
+		}
+
+		instance := newSecurityContextInstance(lsm, string(value), securityContext.Expected)
+		instance.Vars[compliance.SecurityContextFieldProcess] = mp.Name
+		instance.Vars[compliance.SecurityContextFieldPID] = int(mp.Pid)
+		instances = append(instances, instance)
+	}
+
+	if len(instances) == 1 {
+		return instances[0], nil
+	}
+
+	return &instanceIterator{
+		instances: instances,
+	}, nil
+}
+
+// activeLSM returns the security module labelling the files or processes, SELinux when it is enforcing or AppArmor
+// when it is enabled, and an empty string when there is none
+func activeLSM(e env.Env) (string, error) {
+	fs := e.FileSystem()
+	for _, lsm := range []struct {
+		name, path, active string
+	}{
+		{lsmSELinux, selinuxEnforcePath, "1"},
+		{lsmAppArmor, apparmorEnabledPath, "Y"},
+	} {
+		data, err := readFile(fs, e.NormalizeToHostRoot(lsm.path))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return "", err
+		}
+		if strings.TrimSpace(string(data)) == lsm.active {
+			return lsm.name, nil
+		}
+	}
+	return "", nil
+}
+
+// newSecurityContextInstance returns the instance of a security context, as read from the security.selinux
+// extended attribute or the attr/current file of a process. The type is the type of an SELinux context, such
+// as shadow_t in system_u:object_r:shadow_t:s0, or an AppArmor profile without its mode. The label matches the
+// expected one when it is the full label or its type.
+func newSecurityContextInstance(lsm, value, expected string) *eval.Instance {
+	label := strings.TrimRight(value, "\x00\n")
+
+	var labelType, mode string
+	switch lsm {
+	case lsmSELinux:
+		if parts := strings.SplitN(label, ":", 4); len(parts) >= 3 {
+			labelType = parts[2]
+		}
+	case lsmAppArmor:
+		labelType = label
+		// confined processes have a mode, as in docker-default (enforce)
+		if i := strings.LastIndex(label, " ("); i >= 0 && strings.HasSuffix(label, ")") {
+			labelType, mode = label[:i], label[i+2:len(label)-1]
+		}
+	}
+
+	return &eval.Instance{
+		Vars: eval.VarMap{
+			compliance.SecurityContextFieldPath:     "",
+			compliance.SecurityContextFieldProcess:  "",
+			compliance.SecurityContextFieldPID:      0,
+			compliance.SecurityContextFieldLSM:      lsm,
+			compliance.SecurityContextFieldLabel:    label,
+			compliance.SecurityContextFieldType:     labelType,
+			compliance.SecurityContextFieldMode:     mode,
+			compliance.SecurityContextFieldExpected: expected,
+			compliance.SecurityContextFieldMatches:  expected != "" && (label == expected || labelType == expected),
+		},
+	}
+}
@@ -0,0 +1,189 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build !windows
+
+package checks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/event"
+	"github.com/DataDog/datadog-agent/pkg/compliance/mocks"
+	"github.com/DataDog/datadog-agent/pkg/util/cache"
+
+	"github.com/stretchr/testify/mock"
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestSecurityContextCheck(t *testing.T) {
+	selinuxFiles := memFileSystem{
+		"/sys/fs/selinux/enforce": {content: "1", mode: 0644},
+		"/etc/shadow": {mode: 0000, xattrs: map[string]string{
+			"security.selinux": "system_u:object_r:shadow_t:s0\x00",
+		}},
+		"/proc/42/attr/current": {content: "system_u:system_r:sshd_t:s0-s0:c0.c1023\x00", mode: 0666},
+	}
+	apparmorFiles := memFileSystem{
+		"/sys/fs/selinux/enforce":                 {content: "0", mode: 0644},
+		"/sys/module/apparmor/parameters/enabled": {content: "Y\n", mode: 0644},
+		"/etc/shadow":                             {mode: 0000},
+		"/proc/42/attr/current":                   {content: "/usr/sbin/sshd (complain)\n", mode: 0666},
+	}
+	noLSMFiles := memFileSystem{
+		"/sys/module/apparmor/parameters/enabled": {content: "N\n", mode: 0644},
+		"/etc/shadow": {mode: 0000},
+	}
+
+	tests := []struct {
+		name                string
+		fs                  memFileSystem
+		resource            compliance.Resource
+		expectReport        *compliance.Report
+		expectNotApplicable bool
+	}{
+		{
+			name: "selinux file type",
+			fs:   selinuxFiles,
+			resource: compliance.Resource{
+				SecurityContext: &compliance.SecurityContext{
+					Path:     "/etc/shadow",
+					Expected: "shadow_t",
+				},
+				Condition: `securityContext.matches`,
+			},
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					"securityContext.path":     "/etc/shadow",
+					"securityContext.process":  "",
+					"securityContext.lsm":      "selinux",
+					"securityContext.label":    "system_u:object_r:shadow_t:s0",
+					"securityContext.expected": "shadow_t",
+				},
+			},
+		},
+		{
+			name: "selinux process",
+			fs:   selinuxFiles,
+			resource: compliance.Resource{
+				SecurityContext: &compliance.SecurityContext{
+					Process:  "sshd",
+					Expected: "unconfined_t",
+				},
+				Condition: `securityContext.type == securityContext.expected`,
+			},
+			expectReport: &compliance.Report{
+				Passed: false,
+				Data: event.Data{
+					"securityContext.path":     "",
+					"securityContext.process":  "sshd",
+					"securityContext.lsm":      "selinux",
+					"securityContext.label":    "system_u:system_r:sshd_t:s0-s0:c0.c1023",
+					"securityContext.expected": "unconfined_t",
+				},
+			},
+		},
+		{
+			name: "apparmor profile mode",
+			fs:   apparmorFiles,
+			resource: compliance.Resource{
+				SecurityContext: &compliance.SecurityContext{
+					Process:  "sshd",
+					Expected: "/usr/sbin/sshd",
+				},
+				Condition: `securityContext.matches && securityContext.mode == "enforce"`,
+			},
+			expectReport: &compliance.Report{
+				Passed: false,
+				Data: event.Data{
+					"securityContext.path":     "",
+					"securityContext.process":  "sshd",
+					"securityContext.lsm":      "apparmor",
+					"securityContext.label":    "/usr/sbin/sshd (complain)",
+					"securityContext.expected": "/usr/sbin/sshd",
+				},
+			},
+		},
+		{
+			name: "apparmor file",
+			fs:   apparmorFiles,
+			resource: compliance.Resource{
+				SecurityContext: &compliance.SecurityContext{
+					Path:     "/etc/shadow",
+					Expected: "shadow_t",
+				},
+				Condition: `securityContext.matches`,
+			},
+			expectNotApplicable: true,
+		},
+		{
+			name: "no lsm",
+			fs:   noLSMFiles,
+			resource: compliance.Resource{
+				SecurityContext: &compliance.SecurityContext{
+					Path:     "/etc/shadow",
+					Expected: "shadow_t",
+				},
+				Condition: `securityContext.matches`,
+			},
+			expectNotApplicable: true,
+		},
+		{
+			name: "missing file",
+			fs:   selinuxFiles,
+			resource: compliance.Resource{
+				SecurityContext: &compliance.SecurityContext{
+					Path:     "/etc/gshadow",
+					Expected: "shadow_t",
+				},
+				Condition: `securityContext.matches`,
+			},
+			expectNotApplicable: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			cache.Cache.Delete(processCacheKey)
+			processFetcher = func() (processes, error) {
+				return processes{
+					42: {
+						Pid:  42,
+						Name: "sshd",
+					},
+				}, nil
+			}
+
+			env := &mocks.Env{}
+			env.On("FileSystem").Return(test.fs)
+			env.On("NormalizeToHostRoot", mock.Anything).Return(func(path string) string { return path })
+
+			securityContextCheck, err := newResourceCheck(env, "rule-id", test.resource)
+			assert.NoError(err)
+
+			report, err := securityContextCheck.check(context.Background(), env)
+			if test.expectNotApplicable {
+				assert.True(errors.Is(err, ErrResourceNotApplicable))
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(test.expectReport, report)
+		})
+	}
+}
+
+func TestSecurityContextValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Error((&compliance.SecurityContext{}).Validate())
+	assert.Error((&compliance.SecurityContext{Path: "/etc/shadow", Process: "sshd"}).Validate())
+	assert.NoError((&compliance.SecurityContext{Path: "/etc/shadow"}).Validate())
+}
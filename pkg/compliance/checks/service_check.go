@@ -0,0 +1,87 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package checks
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/checks/env"
+	"github.com/DataDog/datadog-agent/pkg/compliance/eval"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+const (
+	// systemdRuntimeDir only exists when systemd is the init system (see sd_booted(3))
+	systemdRuntimeDir = "/run/systemd/system"
+
+	systemctlBinary = "systemctl"
+)
+
+var serviceReportedFields = []string{
+	compliance.ServiceFieldName,
+	compliance.ServiceFieldActiveState,
+	compliance.ServiceFieldUnitFileState,
+}
+
+func resolveService(ctx context.Context, e env.Env, ruleID string, res compliance.Resource) (interface{}, error) {
+	if res.Service == nil {
+		return nil, fmt.Errorf("%s: expecting service resource in service check", ruleID)
+	}
+
+	service := res.Service
+
+	log.Debugf("%s: running service check: %s", ruleID, service.Name)
+
+	if _, err := os.Stat(e.NormalizeToHostRoot(systemdRuntimeDir)); err != nil {
+		return nil, fmt.Errorf("%w: service check is only supported on systemd hosts", ErrResourceNotApplicable)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	args := []string{"show", service.Name, "--no-page", "--property=ActiveState,UnitFileState"}
+	exitCode, stdout, err := commandRunner(ctx, systemctlBinary, args, true)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query systemd for unit %s: %v", service.Name, err)
+	}
+	if exitCode != 0 {
+		return nil, fmt.Errorf("unable to query systemd for unit %s: %s exited with code %d", service.Name, systemctlBinary, exitCode)
+	}
+
+	properties := parseSystemdProperties(stdout)
+	activeState := properties["ActiveState"]
+	unitFileState := properties["UnitFileState"]
+
+	return &eval.Instance{
+		Vars: eval.VarMap{
+			compliance.ServiceFieldName:          service.Name,
+			compliance.ServiceFieldActiveState:   activeState,
+			compliance.ServiceFieldUnitFileState: unitFileState,
+			compliance.ServiceFieldRunning:       activeState == "active",
+			compliance.ServiceFieldEnabled:       unitFileState == "enabled" || unitFileState == "enabled-runtime",
+		},
+	}, nil
+}
+
+// parseSystemdProperties parses the Key=Value lines output by systemctl show
+func parseSystemdProperties(output []byte) map[string]string {
+	properties := make(map[string]string)
+
+	scanner := bufio.NewScanner(strings.NewReader(string(output)))
+	for scanner.Scan() {
+		parts := strings.SplitN(scanner.Text(), "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		properties[parts[0]] = parts[1]
+	}
+	return properties
+}
@@ -0,0 +1,120 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package checks
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/event"
+	"github.com/DataDog/datadog-agent/pkg/compliance/mocks"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestServiceCheck(t *testing.T) {
+	systemdDir, err := ioutil.TempDir("", "cmplServiceTest")
+	assert.NoError(t, err)
+	defer os.RemoveAll(systemdDir)
+
+	tests := []struct {
+		name          string
+		resource      compliance.Resource
+		systemd       bool
+		commandOutput string
+		expectReport  *compliance.Report
+		expectError   error
+	}{
+		{
+			name: "running and enabled",
+			resource: compliance.Resource{
+				Service: &compliance.Service{
+					Name: "auditd.service",
+				},
+				Condition: `service.running && service.enabled`,
+			},
+			systemd:       true,
+			commandOutput: "ActiveState=active\nUnitFileState=enabled\n",
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					"service.name":          "auditd.service",
+					"service.activeState":   "active",
+					"service.unitFileState": "enabled",
+				},
+			},
+		},
+		{
+			name: "stopped and disabled",
+			resource: compliance.Resource{
+				Service: &compliance.Service{
+					Name: "rsyncd.service",
+				},
+				Condition: `!service.running && !service.enabled`,
+			},
+			systemd:       true,
+			commandOutput: "ActiveState=inactive\nUnitFileState=disabled\n",
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					"service.name":          "rsyncd.service",
+					"service.activeState":   "inactive",
+					"service.unitFileState": "disabled",
+				},
+			},
+		},
+		{
+			name: "non systemd host",
+			resource: compliance.Resource{
+				Service: &compliance.Service{
+					Name: "auditd.service",
+				},
+				Condition: `service.running`,
+			},
+			systemd:     false,
+			expectError: errors.New("resource not applicable: service check is only supported on systemd hosts"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			commandRunner = func(ctx context.Context, name string, args []string, captureStdout bool) (int, []byte, error) {
+				assert.Equal("systemctl", name)
+				assert.Equal([]string{"show", test.resource.Service.Name, "--no-page", "--property=ActiveState,UnitFileState"}, args)
+				return 0, []byte(test.commandOutput), nil
+			}
+			defer func() {
+				commandRunner = runCommand
+			}()
+
+			env := &mocks.Env{}
+			defer env.AssertExpectations(t)
+			if test.systemd {
+				env.On("NormalizeToHostRoot", "/run/systemd/system").Return(systemdDir)
+			} else {
+				env.On("NormalizeToHostRoot", "/run/systemd/system").Return(systemdDir + "/missing")
+			}
+
+			serviceCheck, err := newResourceCheck(env, "rule-id", test.resource)
+			assert.NoError(err)
+
+			report, err := serviceCheck.check(context.Background(), env)
+			if test.expectError != nil {
+				assert.EqualError(err, test.expectError.Error())
+				assert.True(errors.Is(err, ErrResourceNotApplicable))
+			} else {
+				assert.NoError(err)
+				assert.Equal(test.expectReport, report)
+			}
+		})
+	}
+}
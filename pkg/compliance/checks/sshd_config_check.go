@@ -0,0 +1,256 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package checks
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/checks/env"
+	"github.com/DataDog/datadog-agent/pkg/compliance/eval"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+const (
+	defaultSSHDConfigPath = "/etc/ssh/sshd_config"
+	sshdConfigDir         = "/etc/ssh"
+	sshdSourceDefault     = "default"
+	// sshdMaxIncludeDepth is the maximum nesting of Include directives accepted by sshd
+	sshdMaxIncludeDepth = 16
+)
+
+// sshdConfigDefaults holds the default values of the directives commonly checked, as documented by
+// sshd_config(5) for OpenSSH 8.x. Keys are lowercase as the directives are case-insensitive.
+var sshdConfigDefaults = map[string]string{
+	"allowagentforwarding":            "yes",
+	"allowtcpforwarding":              "yes",
+	"banner":                          "none",
+	"challengeresponseauthentication": "yes",
+	"clientalivecountmax":             "3",
+	"clientaliveinterval":             "0",
+	"compression":                     "yes",
+	"gssapiauthentication":            "no",
+	"hostbasedauthentication":         "no",
+	"ignorerhosts":                    "yes",
+	"kbdinteractiveauthentication":    "yes",
+	"logingracetime":                  "120",
+	"loglevel":                        "INFO",
+	"maxauthtries":                    "6",
+	"maxsessions":                     "10",
+	"maxstartups":                     "10:30:100",
+	"passwordauthentication":          "yes",
+	"permitemptypasswords":            "no",
+	"permitrootlogin":                 "prohibit-password",
+	"permittunnel":                    "no",
+	"permituserenvironment":           "no",
+	"port":                            "22",
+	"printmotd":                       "yes",
+	"pubkeyauthentication":            "yes",
+	"strictmodes":                     "yes",
+	"tcpkeepalive":                    "yes",
+	"usedns":                          "no",
+	"usepam":                          "no",
+	"x11forwarding":                   "no",
+}
+
+var sshdConfigReportedFields = []string{
+	compliance.SSHDConfigFieldPath,
+	compliance.SSHDConfigFieldDirective,
+	compliance.SSHDConfigFieldFound,
+	compliance.SSHDConfigFieldValue,
+	compliance.SSHDConfigFieldSource,
+	compliance.SSHDConfigFieldMatchOverride,
+	compliance.SSHDConfigFieldExpected,
+}
+
+// resolveSSHDConfig resolves the effective value of a directive of the OpenSSH server configuration. The
+// resource is not applicable when the configuration file doesn't exist.
+func resolveSSHDConfig(_ context.Context, e env.Env, ruleID string, res compliance.Resource) (interface{}, error) {
+	if res.SSHDConfig == nil {
+		return nil, fmt.Errorf("%s: expecting sshdConfig resource in sshdConfig check", ruleID)
+	}
+
+	sshdConfig := res.SSHDConfig
+
+	if err := sshdConfig.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", ruleID, err)
+	}
+
+	path := sshdConfig.Path
+	if path == "" {
+		path = defaultSSHDConfigPath
+	}
+
+	path, err := resolvePath(e, path)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("%s: running sshdConfig check for %s in %s", ruleID, sshdConfig.Directive, path)
+
+	data, err := readFile(e.FileSystem(), e.NormalizeToHostRoot(path))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w: %s not found", ErrResourceNotApplicable, path)
+		}
+		return nil, fmt.Errorf("%s: sshdConfig check failed to read %s: %w", ruleID, path, err)
+	}
+
+	p := &sshdConfigParser{
+		env:       e,
+		directive: strings.ToLower(sshdConfig.Directive),
+	}
+	if err := p.parse(path, data, 0, false); err != nil {
+		return nil, fmt.Errorf("%s: sshdConfig check failed to parse %s: %w", ruleID, path, err)
+	}
+
+	isDefault := false
+	if !p.found {
+		p.value, p.found = sshdConfigDefaults[p.directive]
+		if p.found {
+			p.source, isDefault = sshdSourceDefault, true
+		}
+	}
+
+	return &eval.Instance{
+		Vars: eval.VarMap{
+			compliance.SSHDConfigFieldPath:          path,
+			compliance.SSHDConfigFieldDirective:     sshdConfig.Directive,
+			compliance.SSHDConfigFieldFound:         p.found,
+			compliance.SSHDConfigFieldValue:         p.value,
+			compliance.SSHDConfigFieldIntValue:      parseSSHDConfigInt(p.value),
+			compliance.SSHDConfigFieldSource:        p.source,
+			compliance.SSHDConfigFieldDefault:       isDefault,
+			compliance.SSHDConfigFieldMatchOverride: p.matchOverride,
+			compliance.SSHDConfigFieldExpected:      sshdConfig.Expected,
+			compliance.SSHDConfigFieldIntExpected:   parseSSHDConfigInt(sshdConfig.Expected),
+		},
+	}, nil
+}
+
+// sshdConfigParser looks up the effective value of a directive as sshd does: keywords are case-insensitive,
+// the first value obtained is used, included files are read in place in lexical order and the directives
+// of the Match blocks only apply to the connections matching their criteria. The global value is reported,
+// matchOverride telling whether a Match block sets the directive as well.
+type sshdConfigParser struct {
+	env       env.Env
+	directive string
+
+	found         bool
+	value         string
+	source        string
+	matchOverride bool
+}
+
+// parse reads the directives of a configuration file, inMatch telling whether it is included from a Match
+// block. The Match blocks opened by a file end with it.
+func (p *sshdConfigParser) parse(path string, data []byte, depth int, inMatch bool) error {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+
+		keyword, args := splitSSHDConfigLine(scanner.Text())
+		if keyword == "" {
+			continue
+		}
+
+		switch strings.ToLower(keyword) {
+		case "match":
+			// Match all applies to every connection, the directives following it are global
+			inMatch = !strings.EqualFold(args, "all")
+		case "include":
+			if err := p.include(args, depth, inMatch); err != nil {
+				return err
+			}
+		case p.directive:
+			if inMatch {
+				p.matchOverride = true
+			} else if !p.found {
+				p.found = true
+				p.value = unquoteSSHDConfigValue(args)
+				p.source = fmt.Sprintf("%s:%d", path, lineNumber)
+			}
+		}
+	}
+	return scanner.Err()
+}
+
+// include reads the files of an Include directive, relative paths being relative to /etc/ssh
+func (p *sshdConfigParser) include(args string, depth int, inMatch bool) error {
+	if depth >= sshdMaxIncludeDepth {
+		return fmt.Errorf("too many nested includes")
+	}
+
+	fs := p.env.FileSystem()
+	for _, pattern := range strings.Fields(args) {
+		pattern = unquoteSSHDConfigValue(pattern)
+		if !filepath.IsAbs(pattern) {
+			pattern = filepath.Join(sshdConfigDir, pattern)
+		}
+
+		paths, err := fs.Glob(p.env.NormalizeToHostRoot(pattern))
+		if err != nil {
+			return err
+		}
+		sort.Strings(paths)
+
+		for _, path := range paths {
+			data, err := readFile(fs, path)
+			if err != nil {
+				log.Debugf("sshdConfig check failed to read included file %s: %v", path, err)
+				continue
+			}
+			if err := p.parse(p.env.RelativeToHostRoot(path), data, depth+1, inMatch); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// splitSSHDConfigLine returns the keyword of a configuration line and its arguments, separated from it by
+// blanks or an equal sign. Comment and empty lines have no keyword.
+func splitSSHDConfigLine(line string) (string, string) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return "", ""
+	}
+
+	i := strings.IndexAny(line, " \t=")
+	if i < 0 {
+		return line, ""
+	}
+
+	args := strings.TrimSpace(line[i:])
+	args = strings.TrimSpace(strings.TrimPrefix(args, "="))
+	return line[:i], args
+}
+
+// unquoteSSHDConfigValue removes the double quotes around a value
+func unquoteSSHDConfigValue(value string) string {
+	if len(value) >= 2 && strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`) {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
+
+// parseSSHDConfigInt returns the numeric value of a directive, 0 when it isn't a number
+func parseSSHDConfigInt(value string) int64 {
+	i, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return i
+}
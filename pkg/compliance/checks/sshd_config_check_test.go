@@ -0,0 +1,270 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build !windows
+
+package checks
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/event"
+	"github.com/DataDog/datadog-agent/pkg/compliance/mocks"
+
+	"github.com/stretchr/testify/mock"
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestSSHDConfigCheck(t *testing.T) {
+	fs := memFileSystem{
+		"/etc":                   {mode: os.ModeDir | 0755},
+		"/etc/ssh":               {mode: os.ModeDir | 0755},
+		"/etc/ssh/sshd_config.d": {mode: os.ModeDir | 0755},
+		"/etc/ssh/sshd_config": {content: `# OpenSSH server configuration
+Include /etc/ssh/sshd_config.d/*.conf
+
+#PermitRootLogin prohibit-password
+permitrootlogin yes
+PermitRootLogin no
+MaxAuthTries=4
+Banner "/etc/issue.net"
+
+Match User backup
+	PasswordAuthentication no
+	Include match.conf
+
+Match all
+X11Forwarding yes
+`, mode: 0644},
+		"/etc/ssh/sshd_config.d/10-hardening.conf": {content: `ClientAliveInterval 300
+PasswordAuthentication no
+`, mode: 0644},
+		"/etc/ssh/sshd_config.d/00-cloud.conf": {content: `PasswordAuthentication yes
+Match Address 10.0.0.0/8
+	ClientAliveInterval 0
+`, mode: 0644},
+		"/etc/ssh/match.conf": {content: `AllowTcpForwarding yes
+`, mode: 0644},
+	}
+
+	tests := []struct {
+		name                string
+		resource            compliance.Resource
+		expectReport        *compliance.Report
+		expectNotApplicable bool
+	}{
+		{
+			name: "first value wins",
+			resource: compliance.Resource{
+				SSHDConfig: &compliance.SSHDConfig{
+					Directive: "PermitRootLogin",
+					Expected:  "no",
+				},
+				Condition: `sshdConfig.value == sshdConfig.expected`,
+			},
+			expectReport: &compliance.Report{
+				Passed: false,
+				Data: event.Data{
+					"sshdConfig.path":          "/etc/ssh/sshd_config",
+					"sshdConfig.directive":     "PermitRootLogin",
+					"sshdConfig.found":         true,
+					"sshdConfig.value":         "yes",
+					"sshdConfig.source":        "/etc/ssh/sshd_config:5",
+					"sshdConfig.matchOverride": false,
+					"sshdConfig.expected":      "no",
+				},
+			},
+		},
+		{
+			name: "included files first",
+			resource: compliance.Resource{
+				SSHDConfig: &compliance.SSHDConfig{
+					Directive: "passwordauthentication",
+					Expected:  "no",
+				},
+				Condition: `sshdConfig.value == sshdConfig.expected`,
+			},
+			expectReport: &compliance.Report{
+				Passed: false,
+				Data: event.Data{
+					"sshdConfig.path":          "/etc/ssh/sshd_config",
+					"sshdConfig.directive":     "passwordauthentication",
+					"sshdConfig.found":         true,
+					"sshdConfig.value":         "yes",
+					"sshdConfig.source":        "/etc/ssh/sshd_config.d/00-cloud.conf:1",
+					"sshdConfig.matchOverride": true,
+					"sshdConfig.expected":      "no",
+				},
+			},
+		},
+		{
+			name: "match block ends with the included file",
+			resource: compliance.Resource{
+				SSHDConfig: &compliance.SSHDConfig{
+					Directive: "ClientAliveInterval",
+					Expected:  "300",
+				},
+				Condition: `sshdConfig.intValue > 0 && sshdConfig.intValue <= sshdConfig.intExpected`,
+			},
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					"sshdConfig.path":          "/etc/ssh/sshd_config",
+					"sshdConfig.directive":     "ClientAliveInterval",
+					"sshdConfig.found":         true,
+					"sshdConfig.value":         "300",
+					"sshdConfig.source":        "/etc/ssh/sshd_config.d/10-hardening.conf:1",
+					"sshdConfig.matchOverride": true,
+					"sshdConfig.expected":      "300",
+				},
+			},
+		},
+		{
+			name: "equal sign separator",
+			resource: compliance.Resource{
+				SSHDConfig: &compliance.SSHDConfig{
+					Directive: "MaxAuthTries",
+					Expected:  "4",
+				},
+				Condition: `sshdConfig.intValue <= sshdConfig.intExpected`,
+			},
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					"sshdConfig.path":          "/etc/ssh/sshd_config",
+					"sshdConfig.directive":     "MaxAuthTries",
+					"sshdConfig.found":         true,
+					"sshdConfig.value":         "4",
+					"sshdConfig.source":        "/etc/ssh/sshd_config:7",
+					"sshdConfig.matchOverride": false,
+					"sshdConfig.expected":      "4",
+				},
+			},
+		},
+		{
+			name: "quoted value",
+			resource: compliance.Resource{
+				SSHDConfig: &compliance.SSHDConfig{
+					Directive: "Banner",
+				},
+				Condition: `sshdConfig.value != "none"`,
+			},
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					"sshdConfig.path":          "/etc/ssh/sshd_config",
+					"sshdConfig.directive":     "Banner",
+					"sshdConfig.found":         true,
+					"sshdConfig.value":         "/etc/issue.net",
+					"sshdConfig.source":        "/etc/ssh/sshd_config:8",
+					"sshdConfig.matchOverride": false,
+					"sshdConfig.expected":      "",
+				},
+			},
+		},
+		{
+			name: "global after match all",
+			resource: compliance.Resource{
+				SSHDConfig: &compliance.SSHDConfig{
+					Directive: "X11Forwarding",
+					Expected:  "no",
+				},
+				Condition: `sshdConfig.value == sshdConfig.expected`,
+			},
+			expectReport: &compliance.Report{
+				Passed: false,
+				Data: event.Data{
+					"sshdConfig.path":          "/etc/ssh/sshd_config",
+					"sshdConfig.directive":     "X11Forwarding",
+					"sshdConfig.found":         true,
+					"sshdConfig.value":         "yes",
+					"sshdConfig.source":        "/etc/ssh/sshd_config:15",
+					"sshdConfig.matchOverride": false,
+					"sshdConfig.expected":      "no",
+				},
+			},
+		},
+		{
+			name: "default value",
+			resource: compliance.Resource{
+				SSHDConfig: &compliance.SSHDConfig{
+					Directive: "AllowTcpForwarding",
+					Expected:  "no",
+				},
+				Condition: `sshdConfig.value == sshdConfig.expected`,
+			},
+			expectReport: &compliance.Report{
+				Passed: false,
+				Data: event.Data{
+					"sshdConfig.path":          "/etc/ssh/sshd_config",
+					"sshdConfig.directive":     "AllowTcpForwarding",
+					"sshdConfig.found":         true,
+					"sshdConfig.value":         "yes",
+					"sshdConfig.source":        "default",
+					"sshdConfig.matchOverride": true,
+					"sshdConfig.expected":      "no",
+				},
+			},
+		},
+		{
+			name: "unknown directive",
+			resource: compliance.Resource{
+				SSHDConfig: &compliance.SSHDConfig{
+					Directive: "AllowGroups",
+				},
+				Condition: `!sshdConfig.found`,
+			},
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					"sshdConfig.path":          "/etc/ssh/sshd_config",
+					"sshdConfig.directive":     "AllowGroups",
+					"sshdConfig.found":         false,
+					"sshdConfig.value":         "",
+					"sshdConfig.source":        "",
+					"sshdConfig.matchOverride": false,
+					"sshdConfig.expected":      "",
+				},
+			},
+		},
+		{
+			name: "missing file",
+			resource: compliance.Resource{
+				SSHDConfig: &compliance.SSHDConfig{
+					Path:      "/etc/ssh/sshd_config.missing",
+					Directive: "PermitRootLogin",
+				},
+				Condition: `sshdConfig.found`,
+			},
+			expectNotApplicable: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			env := &mocks.Env{}
+			env.On("FileSystem").Return(fs)
+			env.On("NormalizeToHostRoot", mock.Anything).Return(func(path string) string { return path })
+			env.On("RelativeToHostRoot", mock.Anything).Return(func(path string) string { return path })
+
+			sshdConfigCheck, err := newResourceCheck(env, "rule-id", test.resource)
+			assert.NoError(err)
+
+			report, err := sshdConfigCheck.check(context.Background(), env)
+			if test.expectNotApplicable {
+				assert.True(errors.Is(err, ErrResourceNotApplicable))
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(test.expectReport, report)
+		})
+	}
+}
@@ -0,0 +1,116 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package checks
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/eval"
+)
+
+// RuleValidation describes the outcome of building a rule in dry run mode
+type RuleValidation struct {
+	RuleID string
+	// Inapplicable is set when the rule does not apply to the environment
+	Inapplicable bool
+	// Err is set when the rule is malformed
+	Err error
+}
+
+// ValidateSuite builds every rule of a suite in dry run mode without running anything against the host,
+// reporting which rules are malformed or inapplicable
+func ValidateSuite(file string, options ...BuilderOption) ([]*RuleValidation, error) {
+	options = append(options, WithDryRun())
+
+	builder, err := NewBuilder(nil, options...)
+	if err != nil {
+		return nil, err
+	}
+	defer builder.Close()
+
+	var validations []*RuleValidation
+
+	err = builder.ChecksFromFile(file, func(rule *compliance.Rule, check compliance.Check, err error) bool {
+		validation := &RuleValidation{
+			RuleID: rule.ID,
+		}
+		if errors.Is(err, ErrRuleDoesNotApply) {
+			validation.Inapplicable = true
+		} else {
+			validation.Err = err
+		}
+		validations = append(validations, validation)
+		return true
+	})
+
+	return validations, err
+}
+
+// validateRule validates the resources of a rule without resolving them
+func validateRule(rule *compliance.Rule) error {
+	for _, resource := range rule.Resources {
+		if err := validateResource(rule.ID, resource); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateResource(ruleID string, resource compliance.Resource) error {
+	kind := resource.Kind()
+
+	switch kind {
+	case compliance.KindInvalid:
+		return errors.New("resource kind is missing or invalid")
+	case compliance.KindCustom:
+		// Building a custom check only validates its configuration
+		_, err := newCustomCheck(ruleID, resource)
+		return err
+	case compliance.KindAudit:
+		if err := resource.Audit.Validate(); err != nil {
+			return err
+		}
+	case compliance.KindAuthConfig:
+		if err := resource.AuthConfig.Validate(); err != nil {
+			return err
+		}
+	case compliance.KindCron:
+		if err := resource.Cron.Validate(); err != nil {
+			return err
+		}
+	case compliance.KindLoginDefs:
+		if err := resource.LoginDefs.Validate(); err != nil {
+			return err
+		}
+	case compliance.KindSSHDConfig:
+		if err := resource.SSHDConfig.Validate(); err != nil {
+			return err
+		}
+	case compliance.KindSecurityContext:
+		if err := resource.SecurityContext.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if _, _, err := resourceKindToResolverAndFields(kind); err != nil {
+		return fmt.Errorf("%s resource: %w", kind, err)
+	}
+
+	if _, err := eval.Cache.ParseIterable(resource.Condition); err != nil {
+		return fmt.Errorf("%s resource: invalid condition: %w", kind, err)
+	}
+
+	if resource.Fallback != nil {
+		if _, err := eval.Cache.ParseExpression(resource.Fallback.Condition); err != nil {
+			return fmt.Errorf("%s resource: invalid fallback condition: %w", kind, err)
+		}
+		return validateResource(ruleID, resource.Fallback.Resource)
+	}
+
+	return nil
+}
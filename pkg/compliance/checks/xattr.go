@@ -0,0 +1,41 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux
+
+package checks
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+func (osFileSystem) Getxattr(name, attr string) ([]byte, error) {
+	for {
+		size, err := unix.Getxattr(name, attr, nil)
+		if err != nil {
+			return xattrError(name, err)
+		}
+
+		value := make([]byte, size)
+		size, err = unix.Getxattr(name, attr, value)
+		if err == unix.ERANGE {
+			// the attribute grew in the meantime
+			continue
+		}
+		if err != nil {
+			return xattrError(name, err)
+		}
+		return value[:size], nil
+	}
+}
+
+func xattrError(name string, err error) ([]byte, error) {
+	if err == unix.ENODATA {
+		return nil, nil
+	}
+	return nil, &os.PathError{Op: "getxattr", Path: name, Err: err}
+}
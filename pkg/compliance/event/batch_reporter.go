@@ -0,0 +1,124 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package event
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+// defaultBatchSize is the size of the buffer of a BatchReporter created without a valid size
+const defaultBatchSize = 100
+
+// BatchReporter buffers the events and forwards them to another reporter on every flush interval, or as soon
+// as the buffer is full. The identical events reported between two flushes are only forwarded once.
+type BatchReporter struct {
+	reporter Reporter
+	maxSize  int
+
+	sync.Mutex
+	events []*Event
+	seen   map[string]struct{}
+	// stopped is set once the final flush is done, the events are then forwarded directly
+	stopped bool
+
+	stop     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewBatchReporter returns a BatchReporter forwarding the events to reporter, it has to be stopped to flush the
+// remaining events. The buffer holds defaultBatchSize events when maxSize is not positive.
+func NewBatchReporter(reporter Reporter, flushInterval time.Duration, maxSize int) *BatchReporter {
+	if maxSize <= 0 {
+		maxSize = defaultBatchSize
+	}
+
+	r := &BatchReporter{
+		reporter: reporter,
+		maxSize:  maxSize,
+		seen:     make(map[string]struct{}),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+
+	go func() {
+		defer close(r.done)
+
+		ticker := time.NewTicker(flushInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				r.Flush()
+			case <-r.stop:
+				r.flush(true)
+				return
+			}
+		}
+	}()
+
+	return r
+}
+
+// Report buffers an event unless an identical one is already buffered. Once the reporter is stopped, the
+// events are forwarded directly.
+func (r *BatchReporter) Report(event *Event) {
+	key, err := json.Marshal(event)
+	if err != nil {
+		log.Errorf("Failed to serialize rule event for rule %s", event.AgentRuleID)
+		return
+	}
+
+	r.Lock()
+	if r.stopped {
+		r.Unlock()
+		r.reporter.Report(event)
+		return
+	}
+	if _, found := r.seen[string(key)]; found {
+		r.Unlock()
+		return
+	}
+	r.seen[string(key)] = struct{}{}
+	r.events = append(r.events, event)
+	full := len(r.events) >= r.maxSize
+	r.Unlock()
+
+	if full {
+		r.Flush()
+	}
+}
+
+// Flush forwards the buffered events
+func (r *BatchReporter) Flush() {
+	r.flush(false)
+}
+
+func (r *BatchReporter) flush(stop bool) {
+	r.Lock()
+	r.stopped = r.stopped || stop
+	events := r.events
+	r.events = nil
+	r.seen = make(map[string]struct{})
+	r.Unlock()
+
+	for _, event := range events {
+		r.reporter.Report(event)
+	}
+}
+
+// Stop flushes the remaining events and stops the periodic flush
+func (r *BatchReporter) Stop() {
+	r.stopOnce.Do(func() {
+		close(r.stop)
+	})
+	<-r.done
+}
@@ -0,0 +1,86 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package event
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+type testReporter struct {
+	sync.Mutex
+	events []*Event
+}
+
+func (r *testReporter) Report(event *Event) {
+	r.Lock()
+	defer r.Unlock()
+	r.events = append(r.events, event)
+}
+
+func (r *testReporter) reported() []*Event {
+	r.Lock()
+	defer r.Unlock()
+	return r.events
+}
+
+func TestBatchReporter(t *testing.T) {
+	assert := assert.New(t)
+
+	reporter := &testReporter{}
+	batchReporter := NewBatchReporter(reporter, time.Hour, 3)
+
+	passed := &Event{AgentRuleID: "rule", Result: Passed, Data: Data{"file.path": "/etc/passwd"}}
+	failed := &Event{AgentRuleID: "rule", Result: Failed, Data: Data{"file.path": "/etc/shadow"}}
+
+	// identical events are only reported once
+	batchReporter.Report(passed)
+	batchReporter.Report(&Event{AgentRuleID: "rule", Result: Passed, Data: Data{"file.path": "/etc/passwd"}})
+	batchReporter.Report(failed)
+	assert.Empty(reporter.reported())
+
+	// a full buffer is flushed
+	other := &Event{AgentRuleID: "other", Result: Passed}
+	batchReporter.Report(other)
+	assert.Equal([]*Event{passed, failed, other}, reporter.reported())
+
+	// deduplication only applies within a flush
+	batchReporter.Report(passed)
+	assert.Len(reporter.reported(), 3)
+
+	// the remaining events are flushed on stop
+	batchReporter.Stop()
+	assert.Equal([]*Event{passed, failed, other, passed}, reporter.reported())
+
+	// the events reported after stop are forwarded directly
+	batchReporter.Report(failed)
+	assert.Equal([]*Event{passed, failed, other, passed, failed}, reporter.reported())
+}
+
+func TestBatchReporterInvalidSize(t *testing.T) {
+	reporter := &testReporter{}
+	batchReporter := NewBatchReporter(reporter, time.Hour, 0)
+	defer batchReporter.Stop()
+
+	// the events are buffered instead of being flushed one by one
+	batchReporter.Report(&Event{AgentRuleID: "rule", Result: Passed})
+	batchReporter.Report(&Event{AgentRuleID: "other", Result: Passed})
+	assert.Empty(t, reporter.reported())
+}
+
+func TestBatchReporterFlushInterval(t *testing.T) {
+	reporter := &testReporter{}
+	batchReporter := NewBatchReporter(reporter, 10*time.Millisecond, 100)
+	defer batchReporter.Stop()
+
+	batchReporter.Report(&Event{AgentRuleID: "rule", Result: Passed})
+	assert.Eventually(t, func() bool {
+		return len(reporter.reported()) == 1
+	}, time.Second, 10*time.Millisecond)
+}
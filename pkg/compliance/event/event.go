@@ -12,6 +12,8 @@ const (
 	Failed = "failed"
 	// Error is used to report result of a rule check that resulted in an error (unable to evaluate condition)
 	Error = "error"
+	// NotApplicable is used to report result of a rule check that cannot apply to the host (e.g. missing service)
+	NotApplicable = "not_applicable"
 )
 
 // Data defines a key value map for storing attributes of a reported rule event
@@ -24,6 +26,7 @@ type Event struct {
 	Result           string      `json:"result,omitempty"`
 	ResourceType     string      `json:"resource_type,omitempty"`
 	ResourceID       string      `json:"resource_id,omitempty"`
+	Host             string      `json:"host,omitempty"`
 	Tags             []string    `json:"tags"`
 	Data             interface{} `json:"data,omitempty"`
 }
@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	env "github.com/DataDog/datadog-agent/pkg/compliance/checks/env"
 	rule "github.com/elastic/go-libaudit/rule"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -48,3 +49,26 @@ func (_m *AuditClient) GetFileWatchRules() ([]*rule.FileWatchRule, error) {
 
 	return r0, r1
 }
+
+// GetStatus provides a mock function with given fields:
+func (_m *AuditClient) GetStatus() (*env.AuditStatus, error) {
+	ret := _m.Called()
+
+	var r0 *env.AuditStatus
+	if rf, ok := ret.Get(0).(func() *env.AuditStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*env.AuditStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -44,6 +44,22 @@ func (_m *Clients) DockerClient() env.DockerClient {
 	return r0
 }
 
+// FileSystem provides a mock function with given fields:
+func (_m *Clients) FileSystem() env.FileSystem {
+	ret := _m.Called()
+
+	var r0 env.FileSystem
+	if rf, ok := ret.Get(0).(func() env.FileSystem); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(env.FileSystem)
+		}
+	}
+
+	return r0
+}
+
 // KubeClient provides a mock function with given fields:
 func (_m *Clients) KubeClient() env.KubeClient {
 	ret := _m.Called()
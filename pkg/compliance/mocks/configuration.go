@@ -63,6 +63,20 @@ func (_m *Configuration) Hostname() string {
 	return r0
 }
 
+// IsCommandAllowed provides a mock function with given fields: name
+func (_m *Configuration) IsCommandAllowed(name string) bool {
+	ret := _m.Called(name)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // NormalizeToHostRoot provides a mock function with given fields: path
 func (_m *Configuration) NormalizeToHostRoot(path string) string {
 	ret := _m.Called(path)
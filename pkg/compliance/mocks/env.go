@@ -85,6 +85,22 @@ func (_m *Env) EvaluateFromCache(e eval.Evaluatable) (interface{}, error) {
 	return r0, r1
 }
 
+// FileSystem provides a mock function with given fields:
+func (_m *Env) FileSystem() env.FileSystem {
+	ret := _m.Called()
+
+	var r0 env.FileSystem
+	if rf, ok := ret.Get(0).(func() env.FileSystem); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(env.FileSystem)
+		}
+	}
+
+	return r0
+}
+
 // Hostname provides a mock function with given fields:
 func (_m *Env) Hostname() string {
 	ret := _m.Called()
@@ -99,6 +115,20 @@ func (_m *Env) Hostname() string {
 	return r0
 }
 
+// IsCommandAllowed provides a mock function with given fields: name
+func (_m *Env) IsCommandAllowed(name string) bool {
+	ret := _m.Called(name)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // KubeClient provides a mock function with given fields:
 func (_m *Env) KubeClient() env.KubeClient {
 	ret := _m.Called()
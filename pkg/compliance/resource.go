@@ -8,8 +8,13 @@ package compliance
 import (
 	"errors"
 	"fmt"
+	"regexp"
+	"strings"
 )
 
+// ErrResourceNotApplicable is returned when a resource cannot be evaluated on this host
+var ErrResourceNotApplicable = errors.New("resource not applicable")
+
 // ResourceKind represents resource kind
 type ResourceKind string
 
@@ -30,22 +35,53 @@ const (
 	KindAudit = ResourceKind("audit")
 	// KindKubernetes is used for a KubernetesResource
 	KindKubernetes = ResourceKind("kubernetes")
+	// KindEnv is used for an Env resource
+	KindEnv = ResourceKind("env")
+	// KindService is used for a Service resource
+	KindService = ResourceKind("service")
+	// KindMount is used for a Mount resource
+	KindMount = ResourceKind("mount")
+	// KindFirewall is used for a Firewall resource
+	KindFirewall = ResourceKind("firewall")
+	// KindWinRegistry is used for a WinRegistry resource
+	KindWinRegistry = ResourceKind("registry")
+	// KindAuthConfig is used for an AuthConfig resource
+	KindAuthConfig = ResourceKind("authConfig")
+
+	// KindCron is used for a Cron resource
+	KindCron = ResourceKind("cron")
+	// KindLoginDefs is used for a LoginDefs resource
+	KindLoginDefs = ResourceKind("loginDefs")
+	// KindSSHDConfig is used for a SSHDConfig resource
+	KindSSHDConfig = ResourceKind("sshdConfig")
+	// KindSecurityContext is used for a SecurityContext resource
+	KindSecurityContext = ResourceKind("securityContext")
 	// KindCustom is used for a Custom check
 	KindCustom = ResourceKind("custom")
 )
 
 // Resource describes supported resource types observed by a Rule
 type Resource struct {
-	File          *File               `yaml:"file,omitempty"`
-	Process       *Process            `yaml:"process,omitempty"`
-	Group         *Group              `yaml:"group,omitempty"`
-	Command       *Command            `yaml:"command,omitempty"`
-	Audit         *Audit              `yaml:"audit,omitempty"`
-	Docker        *DockerResource     `yaml:"docker,omitempty"`
-	KubeApiserver *KubernetesResource `yaml:"kubeApiserver,omitempty"`
-	Custom        *Custom             `yaml:"custom,omitempty"`
-	Condition     string              `yaml:"condition"`
-	Fallback      *Fallback           `yaml:"fallback,omitempty"`
+	File            *File               `yaml:"file,omitempty"`
+	Process         *Process            `yaml:"process,omitempty"`
+	Group           *Group              `yaml:"group,omitempty"`
+	Command         *Command            `yaml:"command,omitempty"`
+	Audit           *Audit              `yaml:"audit,omitempty"`
+	Docker          *DockerResource     `yaml:"docker,omitempty"`
+	KubeApiserver   *KubernetesResource `yaml:"kubeApiserver,omitempty"`
+	Env             *Env                `yaml:"env,omitempty"`
+	Service         *Service            `yaml:"service,omitempty"`
+	Mount           *Mount              `yaml:"mount,omitempty"`
+	Firewall        *Firewall           `yaml:"firewall,omitempty"`
+	WinRegistry     *WinRegistry        `yaml:"registry,omitempty"`
+	AuthConfig      *AuthConfig         `yaml:"authConfig,omitempty"`
+	Cron            *Cron               `yaml:"cron,omitempty"`
+	LoginDefs       *LoginDefs          `yaml:"loginDefs,omitempty"`
+	SSHDConfig      *SSHDConfig         `yaml:"sshdConfig,omitempty"`
+	SecurityContext *SecurityContext    `yaml:"securityContext,omitempty"`
+	Custom          *Custom             `yaml:"custom,omitempty"`
+	Condition       string              `yaml:"condition"`
+	Fallback        *Fallback           `yaml:"fallback,omitempty"`
 }
 
 // Kind returns ResourceKind of the resource
@@ -65,6 +101,26 @@ func (r *Resource) Kind() ResourceKind {
 		return KindDocker
 	case r.KubeApiserver != nil:
 		return KindKubernetes
+	case r.Env != nil:
+		return KindEnv
+	case r.Service != nil:
+		return KindService
+	case r.Mount != nil:
+		return KindMount
+	case r.Firewall != nil:
+		return KindFirewall
+	case r.WinRegistry != nil:
+		return KindWinRegistry
+	case r.AuthConfig != nil:
+		return KindAuthConfig
+	case r.Cron != nil:
+		return KindCron
+	case r.LoginDefs != nil:
+		return KindLoginDefs
+	case r.SSHDConfig != nil:
+		return KindSSHDConfig
+	case r.SecurityContext != nil:
+		return KindSecurityContext
 	case r.Custom != nil:
 		return KindCustom
 	default:
@@ -81,9 +137,12 @@ type Fallback struct {
 // Fields & functions available for File
 const (
 	FileFieldPath        = "file.path"
+	FileFieldExists      = "file.exists"
 	FileFieldPermissions = "file.permissions"
 	FileFieldUser        = "file.user"
 	FileFieldGroup       = "file.group"
+	FileFieldViolations  = "file.violations"
+	FileFieldCaptures    = "file.captures"
 
 	FileFuncJQ     = "file.jq"
 	FileFuncYAML   = "file.yaml"
@@ -93,6 +152,16 @@ const (
 // File describes a file resource
 type File struct {
 	Path string `yaml:"path"`
+
+	// Recursive walks the directories matching Path and evaluates the condition for every file found
+	Recursive bool `yaml:"recursive,omitempty"`
+	// MaxDepth bounds the depth of a recursive walk (defaults to 8)
+	MaxDepth int `yaml:"maxDepth,omitempty"`
+	// MaxViolations bounds the number of violations reported by a recursive walk (defaults to 10)
+	MaxViolations int `yaml:"maxViolations,omitempty"`
+	// ExistenceOnly only checks whether files match Path, their content is never read and
+	// the condition is evaluated once with file.exists, even when no file matches
+	ExistenceOnly bool `yaml:"existenceOnly,omitempty"`
 }
 
 // Fields & functions available for Process
@@ -226,15 +295,23 @@ func (a *Audit) Validate() error {
 
 // Fields & functions available for Docker
 const (
-	DockerImageFieldID   = "image.id"
-	DockerImageFieldTags = "image.tags"
+	DockerImageFieldID           = "image.id"
+	DockerImageFieldTags         = "image.tags"
+	DockerImageFieldUser         = "image.user"
+	DockerImageFieldExposedPorts = "image.exposedPorts"
+	DockerImageFieldHealthcheck  = "image.healthcheck"
+
+	DockerImageFuncLabel = "image.label"
 
 	DockerContainerFieldID    = "container.id"
 	DockerContainerFieldName  = "container.name"
 	DockerContainerFieldImage = "container.image"
 
-	DockerNetworkFieldID   = "network.id"
-	DockerNetworkFieldName = "network.name"
+	DockerNetworkFieldID         = "network.id"
+	DockerNetworkFieldName       = "network.name"
+	DockerNetworkFieldDriver     = "network.driver"
+	DockerNetworkFieldICC        = "network.icc"
+	DockerNetworkFieldContainers = "network.containers"
 
 	DockerVersionFieldVersion       = "docker.version"
 	DockerVersionFieldAPIVersion    = "docker.apiVersion"
@@ -244,6 +321,11 @@ const (
 	DockerVersionFieldArch          = "docker.arch"
 	DokcerVersionFieldKernelVersion = "docker.kernelVersion"
 
+	DockerDaemonFieldConfigValue  = "daemon.configValue"
+	DockerDaemonFieldRuntimeValue = "daemon.runtimeValue"
+	DockerDaemonFieldValue        = "daemon.value"
+	DockerDaemonFieldSource       = "daemon.source"
+
 	DockerFuncTemplate = "docker.template"
 )
 
@@ -252,8 +334,259 @@ type DockerResource struct {
 	Kind string `yaml:"kind"`
 }
 
+// Fields & functions available for Env
+const (
+	EnvFieldProcess = "env.process"
+	EnvFieldName    = "env.name"
+	EnvFieldValue   = "env.value"
+	EnvFieldSet     = "env.set"
+)
+
+// Env describes an environment variable of a process resource
+type Env struct {
+	Process string `yaml:"process"`
+	Name    string `yaml:"name"`
+}
+
+// Fields & functions available for Service
+const (
+	ServiceFieldName          = "service.name"
+	ServiceFieldActiveState   = "service.activeState"
+	ServiceFieldUnitFileState = "service.unitFileState"
+	ServiceFieldRunning       = "service.running"
+	ServiceFieldEnabled       = "service.enabled"
+)
+
+// Service describes a systemd unit resource
+type Service struct {
+	Name string `yaml:"name"`
+}
+
+// Fields & functions available for Mount
+const (
+	MountFieldPath               = "mount.path"
+	MountFieldMountPoint         = "mount.mountPoint"
+	MountFieldDevice             = "mount.device"
+	MountFieldFSType             = "mount.fsType"
+	MountFieldOptions            = "mount.options"
+	MountFieldRequiredOptions    = "mount.requiredOptions"
+	MountFieldMissingOptions     = "mount.missingOptions"
+	MountFieldHasRequiredOptions = "mount.hasRequiredOptions"
+
+	MountFuncHasOption = "mount.hasOption"
+)
+
+// Mount describes the mount point holding a path
+type Mount struct {
+	Path            string   `yaml:"path"`
+	RequiredOptions []string `yaml:"requiredOptions,omitempty"`
+}
+
+// Fields & functions available for AuthConfig
+const (
+	AuthConfigFieldFound    = "authConfig.found"
+	AuthConfigFieldPath     = "authConfig.path"
+	AuthConfigFieldLine     = "authConfig.line"
+	AuthConfigFieldCaptures = "authConfig.captures"
+)
+
+// AuthConfig describes a directive looked up in the authentication configuration files (NSS, PAM, LDAP)
+type AuthConfig struct {
+	// Paths are the glob patterns of the files to search (defaults to /etc/nsswitch.conf and /etc/pam.d/*)
+	Paths []string `yaml:"paths,omitempty"`
+	// Directive is a regexp matched against every line of the files, comments excluded
+	Directive string `yaml:"directive"`
+}
+
+// Validate validates authConfig resource
+func (a *AuthConfig) Validate() error {
+	if len(a.Directive) == 0 {
+		return errors.New("authConfig resource is missing directive")
+	}
+	if _, err := regexp.Compile(a.Directive); err != nil {
+		return fmt.Errorf("authConfig resource has an invalid directive: %w", err)
+	}
+	return nil
+}
+
+// Fields & functions available for Cron
+const (
+	CronFieldPath        = "cron.path"
+	CronFieldType        = "cron.type"
+	CronFieldExists      = "cron.exists"
+	CronFieldPermissions = "cron.permissions"
+	CronFieldUser        = "cron.user"
+	CronFieldGroup       = "cron.group"
+	CronFieldMatched     = "cron.matched"
+	CronFieldLine        = "cron.line"
+)
+
+// Cron describes the cron and at configuration files: the system crontabs, the cron directories and
+// the allow and deny files restricting the users of cron and at
+type Cron struct {
+	// Entry is an optional regexp matched against every line of the files, comments excluded
+	Entry string `yaml:"entry,omitempty"`
+}
+
+// Validate validates cron resource
+func (c *Cron) Validate() error {
+	if _, err := regexp.Compile(c.Entry); err != nil {
+		return fmt.Errorf("cron resource has an invalid entry: %w", err)
+	}
+	return nil
+}
+
+// Fields & functions available for LoginDefs
+const (
+	LoginDefsFieldPath        = "loginDefs.path"
+	LoginDefsFieldKey         = "loginDefs.key"
+	LoginDefsFieldFound       = "loginDefs.found"
+	LoginDefsFieldValue       = "loginDefs.value"
+	LoginDefsFieldIntValue    = "loginDefs.intValue"
+	LoginDefsFieldExpected    = "loginDefs.expected"
+	LoginDefsFieldIntExpected = "loginDefs.intExpected"
+)
+
+// LoginDefs describes a setting of a password or login policy file made of key/value pairs, such as
+// /etc/login.defs (KEY VALUE) or /etc/security/pwquality.conf (key = value)
+type LoginDefs struct {
+	// Path is the file holding the setting (defaults to /etc/login.defs)
+	Path string `yaml:"path,omitempty"`
+	Key  string `yaml:"key"`
+	// Expected is the value expected by the rule, it is reported along with the actual value
+	Expected string `yaml:"expected,omitempty"`
+}
+
+// Validate validates loginDefs resource
+func (l *LoginDefs) Validate() error {
+	if len(l.Key) == 0 {
+		return errors.New("loginDefs resource is missing key")
+	}
+	if strings.ContainsAny(l.Key, " \t=#") {
+		return fmt.Errorf("loginDefs resource has an invalid key %q", l.Key)
+	}
+	return nil
+}
+
+// Fields & functions available for SSHDConfig
+const (
+	SSHDConfigFieldPath          = "sshdConfig.path"
+	SSHDConfigFieldDirective     = "sshdConfig.directive"
+	SSHDConfigFieldFound         = "sshdConfig.found"
+	SSHDConfigFieldValue         = "sshdConfig.value"
+	SSHDConfigFieldIntValue      = "sshdConfig.intValue"
+	SSHDConfigFieldSource        = "sshdConfig.source"
+	SSHDConfigFieldDefault       = "sshdConfig.default"
+	SSHDConfigFieldMatchOverride = "sshdConfig.matchOverride"
+	SSHDConfigFieldExpected      = "sshdConfig.expected"
+	SSHDConfigFieldIntExpected   = "sshdConfig.intExpected"
+)
+
+// SSHDConfig describes a directive of the OpenSSH server configuration, its effective value being
+// resolved as sshd does
+type SSHDConfig struct {
+	// Path is the configuration file of the server (defaults to /etc/ssh/sshd_config)
+	Path      string `yaml:"path,omitempty"`
+	Directive string `yaml:"directive"`
+	// Expected is the value expected by the rule, it is reported along with the effective value
+	Expected string `yaml:"expected,omitempty"`
+}
+
+// Validate validates sshdConfig resource
+func (s *SSHDConfig) Validate() error {
+	if len(s.Directive) == 0 {
+		return errors.New("sshdConfig resource is missing directive")
+	}
+	if strings.ContainsAny(s.Directive, " \t=#\"") {
+		return fmt.Errorf("sshdConfig resource has an invalid directive %q", s.Directive)
+	}
+	return nil
+}
+
+// Fields & functions available for SecurityContext
+const (
+	SecurityContextFieldPath     = "securityContext.path"
+	SecurityContextFieldProcess  = "securityContext.process"
+	SecurityContextFieldPID      = "securityContext.pid"
+	SecurityContextFieldLSM      = "securityContext.lsm"
+	SecurityContextFieldLabel    = "securityContext.label"
+	SecurityContextFieldType     = "securityContext.type"
+	SecurityContextFieldMode     = "securityContext.mode"
+	SecurityContextFieldExpected = "securityContext.expected"
+	SecurityContextFieldMatches  = "securityContext.matches"
+)
+
+// SecurityContext describes the SELinux context or AppArmor profile of a file or of the processes with a given name
+type SecurityContext struct {
+	Path    string `yaml:"path,omitempty"`
+	Process string `yaml:"process,omitempty"`
+	// Expected is the label expected by the rule, either a full context or the SELinux type or AppArmor profile
+	Expected string `yaml:"expected,omitempty"`
+}
+
+// Validate validates securityContext resource
+func (s *SecurityContext) Validate() error {
+	if len(s.Path) == 0 && len(s.Process) == 0 {
+		return errors.New("securityContext resource is missing path or process")
+	}
+	if len(s.Path) > 0 && len(s.Process) > 0 {
+		return errors.New("securityContext resource can't have both path and process")
+	}
+	return nil
+}
+
+// Fields & functions available for Firewall
+const (
+	FirewallFieldBackend = "firewall.backend"
+	FirewallFieldTable   = "firewall.table"
+	FirewallFieldChain   = "firewall.chain"
+	FirewallFieldPolicy  = "firewall.policy"
+	FirewallFieldRules   = "firewall.rules"
+
+	FirewallFuncHasRule = "firewall.hasRule"
+)
+
+// Firewall describes a chain of the active firewall ruleset
+type Firewall struct {
+	Table string `yaml:"table,omitempty"`
+	Chain string `yaml:"chain"`
+}
+
+// Fields & functions available for WinRegistry
+const (
+	WinRegistryFieldKey      = "registry.key"
+	WinRegistryFieldValue    = "registry.value"
+	WinRegistryFieldExists   = "registry.exists"
+	WinRegistryFieldData     = "registry.data"
+	WinRegistryFieldExpected = "registry.expected"
+)
+
+// WinRegistry describes a value of a Windows registry key
+type WinRegistry struct {
+	Key      string `yaml:"key"`
+	Value    string `yaml:"value"`
+	Expected string `yaml:"expected,omitempty"`
+}
+
 // Custom is a special resource handled by a dedicated function
 type Custom struct {
 	Name      string            `yaml:"name"`
 	Variables map[string]string `yaml:"variables,omitempty"`
 }
+
+// Fields available for the kernelModuleSigning custom check
+const (
+	KernelModuleSigningFieldEnabled         = "moduleSigning.enabled"
+	KernelModuleSigningFieldSource          = "moduleSigning.source"
+	KernelModuleSigningFieldModulesDisabled = "moduleSigning.modulesDisabled"
+)
+
+// Fields available for the auditdRunning custom check
+const (
+	AuditdFieldRunning     = "auditd.running"
+	AuditdFieldPID         = "auditd.pid"
+	AuditdFieldEnabled     = "auditd.enabled"
+	AuditdFieldLocked      = "auditd.locked"
+	AuditdFieldFailureMode = "auditd.failureMode"
+	AuditdFieldReason      = "auditd.reason"
+)
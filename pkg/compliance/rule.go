@@ -6,7 +6,10 @@
 // Package compliance defines common interfaces and types for Compliance Agent
 package compliance
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // Rule defines a rule in a compliance config
 type Rule struct {
@@ -15,6 +18,8 @@ type Rule struct {
 	Scope        RuleScopeList `yaml:"scope,omitempty"`
 	HostSelector string        `yaml:"hostSelector,omitempty"`
 	Resources    []Resource    `yaml:"resources,omitempty"`
+	// Timeout overrides the check timeout configured for the builder
+	Timeout time.Duration `yaml:"timeout,omitempty"`
 }
 
 // RuleScope defines scope for applicability of a rule
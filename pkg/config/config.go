@@ -50,6 +50,13 @@ const (
 
 	// ClusterIDCacheKey is the key name for the orchestrator cluster id in the agent in-mem cache
 	ClusterIDCacheKey = "orchestratorClusterID"
+
+	// MaxHostnameSizeReject rejects the hostnames exceeding metadata_endpoints_max_hostname_size
+	MaxHostnameSizeReject = "reject"
+	// MaxHostnameSizeTruncate truncates the hostnames exceeding metadata_endpoints_max_hostname_size
+	MaxHostnameSizeTruncate = "truncate"
+	// MaxHostnameSizeAllow accepts the hostnames exceeding metadata_endpoints_max_hostname_size
+	MaxHostnameSizeAllow = "allow"
 )
 
 var overrideVars = make(map[string]interface{})
@@ -438,12 +445,23 @@ func InitConfig(config Config) {
 	// Defines the maximum size of hostame gathered from EC2, GCE, Azure and Alibabacloud metadata endpoints.
 	// Used internally to protect against configurations where metadata endpoints return incorrect values with 200 status codes.
 	config.BindEnvAndSetDefault("metadata_endpoints_max_hostname_size", 255)
+	// Defines what happens to a hostname exceeding metadata_endpoints_max_hostname_size: "reject" (default) drops it,
+	// "truncate" keeps its first characters and "allow" accepts it as is. The last two log a warning.
+	config.BindEnvAndSetDefault("metadata_endpoints_max_hostname_size_mode", MaxHostnameSizeReject)
 
 	// EC2
 	config.BindEnvAndSetDefault("ec2_use_windows_prefix_detection", false)
 	config.BindEnvAndSetDefault("ec2_metadata_timeout", 300)          // value in milliseconds
 	config.BindEnvAndSetDefault("ec2_metadata_token_lifetime", 21600) // value in seconds
+	config.BindEnvAndSetDefault("ec2_metadata_max_retries", 3)
+	config.BindEnvAndSetDefault("ec2_metadata_retry_backoff_ms", 200) // value in milliseconds, doubled after each retry
 	config.BindEnvAndSetDefault("ec2_prefer_imdsv2", false)
+	config.BindEnvAndSetDefault("ec2_imdsv2_background_token_refresh", false)
+	config.BindEnvAndSetDefault("ec2_verify_imds", false)
+	config.BindEnvAndSetDefault("ec2_verify_instance_identity", false)
+	config.BindEnvAndSetDefault("ec2_instance_identity_certificate", "")
+	config.BindEnvAndSetDefault("ec2_hostname_source", "instance-id") // either instance-id or private-dns
+	config.BindEnvAndSetDefault("ec2_allow_multiple_subnets", false)
 	config.BindEnvAndSetDefault("collect_ec2_tags", false)
 
 	// ECS
@@ -690,13 +708,18 @@ func InitConfig(config Config) {
 	config.SetKnown("system_probe_config.dest_excludes")
 	config.SetKnown("system_probe_config.closed_channel_size")
 	config.SetKnown("system_probe_config.dns_timeout_in_s")
+	config.SetKnown("system_probe_config.dns_cache_size")
+	config.SetKnown("system_probe_config.dns_cache_ttl_in_s")
 	config.SetKnown("system_probe_config.collect_dns_stats")
 	config.SetKnown("system_probe_config.offset_guess_threshold")
 	config.SetKnown("system_probe_config.enable_tcp_queue_length")
 	config.SetKnown("system_probe_config.enable_oom_kill")
 	config.SetKnown("system_probe_config.enable_tracepoints")
+	config.SetKnown("system_probe_config.resolve_network_namespaces")
+	config.SetKnown("system_probe_config.sort_connections")
 	config.SetKnown("system_probe_config.windows.enable_monotonic_count")
 	config.SetKnown("system_probe_config.windows.driver_buffer_size")
+	config.SetKnown("system_probe_config.windows.resolve_process_names")
 
 	// Network
 	config.BindEnv("network.id") //nolint:errcheck
@@ -757,8 +780,13 @@ func InitConfig(config Config) {
 	// Datadog security agent (compliance)
 	config.BindEnvAndSetDefault("compliance_config.enabled", false)
 	config.BindEnvAndSetDefault("compliance_config.check_interval", 20*time.Minute)
+	config.BindEnvAndSetDefault("compliance_config.check_timeout", time.Duration(0))
 	config.BindEnvAndSetDefault("compliance_config.dir", "/etc/datadog-agent/compliance.d")
 	config.BindEnvAndSetDefault("compliance_config.run_path", defaultRunPath)
+	config.BindEnvAndSetDefault("compliance_config.command_allowlist", []string{})
+	config.SetKnown("compliance_config.allowed_resource_kinds")
+	config.BindEnvAndSetDefault("compliance_config.report_flush_interval", time.Duration(0))
+	config.BindEnvAndSetDefault("compliance_config.report_buffer_size", 100)
 
 	// Datadog security agent (runtime)
 	config.BindEnvAndSetDefault("runtime_security_config.enabled", false)
@@ -767,6 +795,13 @@ func InitConfig(config Config) {
 	config.BindEnvAndSetDefault("runtime_security_config.socket", "/opt/datadog-agent/run/runtime-security.sock")
 	config.BindEnvAndSetDefault("runtime_security_config.enable_kernel_filters", true)
 	config.BindEnvAndSetDefault("runtime_security_config.syscall_monitor.enabled", false)
+	config.BindEnvAndSetDefault("runtime_security_config.exec_args.enabled", false)
+	config.BindEnvAndSetDefault("runtime_security_config.exec_args.max_count", 32)
+	config.BindEnvAndSetDefault("runtime_security_config.exec_args.max_length", 1024)
+	config.BindEnvAndSetDefault("runtime_security_config.ioctl.requests", []string{"TIOCSTI"})
+	config.BindEnvAndSetDefault("runtime_security_config.map_pinning.enabled", false)
+	config.BindEnvAndSetDefault("runtime_security_config.map_pinning.path", "/sys/fs/bpf/datadog-agent/runtime-security")
+	config.BindEnvAndSetDefault("runtime_security_config.map_pinning.reuse", false)
 	config.BindEnvAndSetDefault("runtime_security_config.run_path", defaultRunPath)
 
 	// command line options
@@ -1116,6 +1151,27 @@ func IsCloudProviderEnabled(cloudProviderName string) bool {
 	return false
 }
 
+// EnforceMaxHostnameSize applies the metadata_endpoints_max_hostname_size_mode to a value returned by
+// a metadata endpoint longer than maxLength. An unknown mode rejects the value.
+func EnforceMaxHostnameSize(endpoint, value string, maxLength int) (string, error) {
+	if len(value) <= maxLength {
+		return value, nil
+	}
+
+	switch mode := Datadog.GetString("metadata_endpoints_max_hostname_size_mode"); mode {
+	case MaxHostnameSizeTruncate:
+		log.Warnf("%v gave a response with length > to %v, truncating it", endpoint, maxLength)
+		return value[:maxLength], nil
+	case MaxHostnameSizeAllow:
+		log.Warnf("%v gave a response with length > to %v, accepting it", endpoint, maxLength)
+		return value, nil
+	case MaxHostnameSizeReject:
+	default:
+		log.Warnf("Invalid metadata_endpoints_max_hostname_size_mode %q, rejecting the response", mode)
+	}
+	return "", fmt.Errorf("%v gave a response with length > to %v", endpoint, maxLength)
+}
+
 // IsContainerized returns whether the Agent is running on a Docker container
 func IsContainerized() bool {
 	return os.Getenv("DOCKER_DD_AGENT") != ""
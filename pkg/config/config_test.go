@@ -505,6 +505,38 @@ func TestIsCloudProviderEnabled(t *testing.T) {
 	assert.False(t, IsCloudProviderEnabled("Tencent"))
 }
 
+func TestEnforceMaxHostnameSize(t *testing.T) {
+	holdValue := Datadog.Get("metadata_endpoints_max_hostname_size_mode")
+	defer Datadog.Set("metadata_endpoints_max_hostname_size_mode", holdValue)
+
+	hostname := "ip-10-0-0-1.eu-west-1.compute.internal"
+
+	for _, mode := range []string{MaxHostnameSizeReject, MaxHostnameSizeTruncate, MaxHostnameSizeAllow} {
+		Datadog.Set("metadata_endpoints_max_hostname_size_mode", mode)
+		value, err := EnforceMaxHostnameSize("/hostname", hostname, 255)
+		assert.NoError(t, err, mode)
+		assert.Equal(t, hostname, value, mode)
+	}
+
+	Datadog.Set("metadata_endpoints_max_hostname_size_mode", MaxHostnameSizeReject)
+	_, err := EnforceMaxHostnameSize("/hostname", hostname, 11)
+	assert.Error(t, err)
+
+	Datadog.Set("metadata_endpoints_max_hostname_size_mode", MaxHostnameSizeTruncate)
+	value, err := EnforceMaxHostnameSize("/hostname", hostname, 11)
+	assert.NoError(t, err)
+	assert.Equal(t, "ip-10-0-0-1", value)
+
+	Datadog.Set("metadata_endpoints_max_hostname_size_mode", MaxHostnameSizeAllow)
+	value, err = EnforceMaxHostnameSize("/hostname", hostname, 11)
+	assert.NoError(t, err)
+	assert.Equal(t, hostname, value)
+
+	Datadog.Set("metadata_endpoints_max_hostname_size_mode", "invalid")
+	_, err = EnforceMaxHostnameSize("/hostname", hostname, 11)
+	assert.Error(t, err)
+}
+
 func TestEnvNestedConfig(t *testing.T) {
 	config := setupConf()
 	config.BindEnv("foo.bar.nested")
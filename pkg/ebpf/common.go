@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/DataDog/datadog-agent/pkg/ebpf/bytecode"
+	"github.com/DataDog/datadog-agent/pkg/network"
 	"github.com/pkg/errors"
 )
 
@@ -43,6 +44,23 @@ func snakeToCapInitialCamel(s string) string {
 	return n
 }
 
+// connectionsSummary counts the connections by type, family and direction
+func connectionsSummary(conns []network.ConnectionStats) map[string]int {
+	summary := map[string]int{
+		"total": len(conns),
+	}
+	for _, c := range conns {
+		summary[strings.ToLower(c.Type.String())]++
+		if c.Family == network.AFINET6 {
+			summary["ipv6"]++
+		} else {
+			summary["ipv4"]++
+		}
+		summary[c.Direction.String()]++
+	}
+	return summary
+}
+
 // processHeaders processes the `#include` of embedded headers.
 func processHeaders(bpfDir, fileName string) (*bytes.Buffer, error) {
 	sourceReader, err := bytecode.GetReader(bpfDir, fileName)
@@ -3,6 +3,7 @@ package ebpf
 import (
 	"testing"
 
+	"github.com/DataDog/datadog-agent/pkg/network"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -14,3 +15,21 @@ func TestSnakeToCamel(t *testing.T) {
 		assert.Equal(t, exp, snakeToCapInitialCamel(test))
 	}
 }
+
+func TestConnectionsSummary(t *testing.T) {
+	conns := []network.ConnectionStats{
+		{Type: network.TCP, Family: network.AFINET, Direction: network.OUTGOING},
+		{Type: network.TCP, Family: network.AFINET6, Direction: network.INCOMING},
+		{Type: network.UDP, Family: network.AFINET, Direction: network.OUTGOING},
+	}
+
+	assert.Equal(t, map[string]int{
+		"total":    3,
+		"tcp":      2,
+		"udp":      1,
+		"ipv4":     2,
+		"ipv6":     1,
+		"incoming": 1,
+		"outgoing": 2,
+	}, connectionsSummary(conns))
+}
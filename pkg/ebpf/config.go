@@ -29,6 +29,14 @@ type Config struct {
 	// DNSTimeout determines the length of time to wait before considering a DNS Query to have timed out
 	DNSTimeout time.Duration
 
+	// DNSCacheSize is the maximum number of IPs kept in the reverse DNS cache. When the cache is full the least
+	// recently used entries are evicted.
+	DNSCacheSize int
+
+	// DNSCacheTTL determines how long an entry of the reverse DNS cache is kept without being looked up or refreshed
+	// by a DNS response
+	DNSCacheTTL time.Duration
+
 	// UDPConnTimeout determines the length of traffic inactivity between two (IP, port)-pairs before declaring a UDP
 	// connection as inactive.
 	// Note: As UDP traffic is technically "connection-less", for tracking, we consider a UDP connection to be traffic
@@ -62,6 +70,18 @@ type Config struct {
 	// ClientStateExpiry specifies the max time a client (e.g. process-agent)'s state will be stored in memory before being evicted.
 	ClientStateExpiry time.Duration
 
+	// ClosedConnectionRetention specifies the max time a closed connection is buffered for a client before being dropped
+	// if the client didn't fetch it with GetClosedConnections.
+	ClosedConnectionRetention time.Duration
+
+	// ResolveNetNS sets the network namespace of the connections from their PID when the tracer doesn't report it,
+	// from /proc/<pid>/ns/net on Linux and as the network compartment of the process on Windows
+	ResolveNetNS bool
+
+	// SortConnections returns the connections sorted by source, destination and protocol rather than in the
+	// order of the maps, so that the output can be compared from one request to another
+	SortConnections bool
+
 	// ProcRoot is the root path to the proc filesystem
 	ProcRoot string
 
@@ -104,6 +124,9 @@ type Config struct {
 
 	// DriverBufferSize (Windows only) determines the size (in bytes) of the buffer we pass to the driver when reading flows
 	DriverBufferSize int
+
+	// ResolveProcessNames (Windows only) determines if the PID of the connections is resolved to a process name and executable path
+	ResolveProcessNames bool
 }
 
 // NewDefaultConfig enables traffic collection for all connection types
@@ -128,10 +151,13 @@ func NewDefaultConfig() *Config {
 		MaxConnectionsStateBuffered:  75000,
 		MaxDNSStatsBufferred:         75000,
 		ClientStateExpiry:            2 * time.Minute,
+		ClosedConnectionRetention:    2 * time.Minute,
 		ClosedChannelSize:            500,
 		// DNS Stats related configurations
 		CollectDNSStats:      false,
 		DNSTimeout:           15 * time.Second,
+		DNSCacheSize:         100000,
+		DNSCacheTTL:          3 * time.Minute,
 		OffsetGuessThreshold: 400,
 		EnableMonotonicCount: false,
 	}
@@ -0,0 +1,141 @@
+// +build linux_bpf windows
+
+package ebpf
+
+import (
+	"bytes"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/network"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+// firstSeenKey identifies a connection by its cookie, or by its tuple when it has no cookie
+type firstSeenKey struct {
+	cookie uint64
+	tuple  string
+}
+
+type firstSeenEntry struct {
+	firstSeen    time.Time
+	lastActivity time.Time
+	counters     connCounters
+	cookie       uint64
+}
+
+// connCounters are the monotonic counters of a connection, it is active when one of them changes
+type connCounters struct {
+	sentBytes      uint64
+	recvBytes      uint64
+	retransmits    uint32
+	tcpEstablished uint32
+	tcpClosed      uint32
+}
+
+func countersOf(conn *network.ConnectionStats) connCounters {
+	return connCounters{
+		sentBytes:      conn.MonotonicSentBytes,
+		recvBytes:      conn.MonotonicRecvBytes,
+		retransmits:    conn.MonotonicRetransmits,
+		tcpEstablished: conn.MonotonicTCPEstablished,
+		tcpClosed:      conn.MonotonicTCPClosed,
+	}
+}
+
+// firstSeenCache records when the tracer first observed each connection, and when it last observed
+// its counters change, so that the times are kept across polls and don't depend on the clients. It also gives a cookie to the connections reported without one, as the eBPF tracer
+// can't read SO_COOKIE, and keeps it for the lifetime of the connection.
+// Closed connections are reported from another goroutine on Linux, hence the lock.
+type firstSeenCache struct {
+	sync.Mutex
+	entries map[firstSeenKey]firstSeenEntry
+	buf     *bytes.Buffer
+	rand    *rand.Rand
+}
+
+func newFirstSeenCache() *firstSeenCache {
+	return &firstSeenCache{
+		entries: make(map[firstSeenKey]firstSeenEntry),
+		buf:     &bytes.Buffer{},
+		rand:    rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// annotate sets the first seen and last activity times of the active connections, the connections not
+// observed before being seen now and the ones whose counters changed being active now. The connections
+// no longer active are removed from the cache.
+func (c *firstSeenCache) annotate(conns []network.ConnectionStats, now time.Time) {
+	c.Lock()
+	defer c.Unlock()
+
+	entries := make(map[firstSeenKey]firstSeenEntry, len(conns))
+	for i := range conns {
+		key, ok := c.key(&conns[i])
+		if !ok {
+			continue
+		}
+		entry, ok := c.entries[key]
+		if !ok {
+			entry = c.newEntry(&conns[i], now)
+		} else if counters := countersOf(&conns[i]); counters != entry.counters {
+			entry.lastActivity = now
+			entry.counters = counters
+		}
+		entries[key] = entry
+		c.set(&conns[i], entry)
+	}
+	c.entries = entries
+}
+
+// remove sets the first seen time of a closed connection and removes it from the cache, a connection
+// closed before being observed as active is first seen now. Its closing makes it active now.
+func (c *firstSeenCache) remove(conn *network.ConnectionStats, now time.Time) {
+	c.Lock()
+	defer c.Unlock()
+
+	key, ok := c.key(conn)
+	if !ok {
+		return
+	}
+	if entry, ok := c.entries[key]; ok {
+		entry.lastActivity = now
+		c.set(conn, entry)
+		delete(c.entries, key)
+		return
+	}
+	c.set(conn, c.newEntry(conn, now))
+}
+
+func (c *firstSeenCache) newEntry(conn *network.ConnectionStats, now time.Time) firstSeenEntry {
+	entry := firstSeenEntry{
+		firstSeen:    now,
+		lastActivity: now,
+		counters:     countersOf(conn),
+		cookie:       conn.Cookie,
+	}
+	for entry.cookie == 0 {
+		entry.cookie = c.rand.Uint64()
+	}
+	return entry
+}
+
+func (c *firstSeenCache) set(conn *network.ConnectionStats, entry firstSeenEntry) {
+	conn.FirstSeen = entry.firstSeen
+	conn.LastActivity = entry.lastActivity
+	conn.Cookie = entry.cookie
+}
+
+func (c *firstSeenCache) key(conn *network.ConnectionStats) (firstSeenKey, bool) {
+	if conn.Cookie != 0 {
+		return firstSeenKey{cookie: conn.Cookie}, true
+	}
+
+	tuple, err := conn.ByteKey(c.buf)
+	if err != nil {
+		log.Warnf("failed to create byte key: %s", err)
+		return firstSeenKey{}, false
+	}
+	return firstSeenKey{tuple: string(tuple)}, true
+}
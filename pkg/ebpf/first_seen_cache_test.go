@@ -0,0 +1,72 @@
+// +build linux_bpf windows
+
+package ebpf
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/network"
+	"github.com/DataDog/datadog-agent/pkg/process/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFirstSeenCache(t *testing.T) {
+	cache := newFirstSeenCache()
+	t0 := time.Now()
+	t1 := t0.Add(30 * time.Second)
+	t2 := t1.Add(30 * time.Second)
+
+	conns := []network.ConnectionStats{
+		{Cookie: 1, Pid: 10, SPort: 1000, DPort: 80},
+		{Pid: 10, SPort: 1001, DPort: 80, Source: util.AddressFromString("10.0.0.1"), Dest: util.AddressFromString("10.0.0.2")},
+	}
+	cache.annotate(conns, t0)
+	assert.Equal(t, t0, conns[0].FirstSeen)
+	assert.Equal(t, t0, conns[1].FirstSeen)
+
+	// the connections reported without a cookie are given one
+	assert.Equal(t, uint64(1), conns[0].Cookie)
+	assert.NotZero(t, conns[1].Cookie)
+	cookie := conns[1].Cookie
+
+	// the first seen time is kept across polls, the last activity time changes with the counters
+	conns = []network.ConnectionStats{
+		{Cookie: 1, Pid: 10, SPort: 1000, DPort: 80, MonotonicSentBytes: 10},
+		{Pid: 10, SPort: 1001, DPort: 80, Source: util.AddressFromString("10.0.0.1"), Dest: util.AddressFromString("10.0.0.2")},
+		{Cookie: 2, Pid: 10, SPort: 1000, DPort: 80},
+	}
+	cache.annotate(conns, t1)
+	assert.Equal(t, t0, conns[0].FirstSeen)
+	assert.Equal(t, t0, conns[1].FirstSeen)
+	assert.Equal(t, t1, conns[2].FirstSeen)
+	assert.Equal(t, cookie, conns[1].Cookie)
+	assert.Equal(t, t1, conns[0].LastActivity)
+	assert.Equal(t, t0, conns[1].LastActivity)
+	assert.Equal(t, t1, conns[2].LastActivity)
+
+	// closed connections are removed from the cache
+	closed := network.ConnectionStats{Cookie: 2, Pid: 10, SPort: 1000, DPort: 80}
+	cache.remove(&closed, t2)
+	assert.Equal(t, t1, closed.FirstSeen)
+	assert.Equal(t, t2, closed.LastActivity)
+	assert.NotContains(t, cache.entries, firstSeenKey{cookie: 2})
+
+	closed = network.ConnectionStats{Cookie: 3, Pid: 10, SPort: 1002, DPort: 80}
+	cache.remove(&closed, t2)
+	assert.Equal(t, t2, closed.FirstSeen)
+
+	closed = network.ConnectionStats{Pid: 10, SPort: 1001, DPort: 80, Source: util.AddressFromString("10.0.0.1"), Dest: util.AddressFromString("10.0.0.2")}
+	cache.remove(&closed, t2)
+	assert.Equal(t, t0, closed.FirstSeen)
+	assert.Equal(t, cookie, closed.Cookie)
+
+	// inactive connections are removed from the cache
+	conns = []network.ConnectionStats{
+		{Cookie: 1, Pid: 10, SPort: 1000, DPort: 80, MonotonicSentBytes: 10},
+	}
+	cache.annotate(conns, t2)
+	assert.Equal(t, t0, conns[0].FirstSeen)
+	assert.Equal(t, t1, conns[0].LastActivity)
+	assert.Len(t, cache.entries, 1)
+}
@@ -0,0 +1,84 @@
+// +build linux_bpf
+
+package ebpf
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/network"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+// netNSCache resolves the PID of the connections to the inode of their network namespace
+type netNSCache struct {
+	procRoot string
+	entries  map[uint32]uint32
+}
+
+func newNetNSCache(procRoot string) *netNSCache {
+	return &netNSCache{
+		procRoot: procRoot,
+		entries:  make(map[uint32]uint32),
+	}
+}
+
+// resolve returns the network namespace of the given PID, it is read from /proc/<pid>/ns/net
+// when the PID is not in the cache
+func (c *netNSCache) resolve(pid uint32) (uint32, bool) {
+	if ns, ok := c.entries[pid]; ok {
+		return ns, true
+	}
+
+	ns, err := readNetNS(filepath.Join(c.procRoot, strconv.FormatUint(uint64(pid), 10), "ns", "net"))
+	if err != nil {
+		log.Debugf("could not get network namespace for %v %v", pid, err)
+		return 0, false
+	}
+
+	c.entries[pid] = ns
+	return ns, true
+}
+
+// annotate sets the network namespace of the connections the tracer couldn't get it for. The processes
+// without any connection left are removed from the cache so that a reused PID gets resolved again
+func (c *netNSCache) annotate(conns []network.ConnectionStats) {
+	seen := make(map[uint32]struct{})
+	for i := range conns {
+		pid := conns[i].Pid
+		seen[pid] = struct{}{}
+		if conns[i].NetNS != 0 {
+			continue
+		}
+		if ns, ok := c.resolve(pid); ok {
+			conns[i].NetNS = ns
+		}
+	}
+
+	for pid := range c.entries {
+		if _, ok := seen[pid]; !ok {
+			delete(c.entries, pid)
+		}
+	}
+}
+
+// readNetNS returns the inode of a network namespace from its link, which reads as net:[<inode>]
+func readNetNS(path string) (uint32, error) {
+	link, err := os.Readlink(path)
+	if err != nil {
+		return 0, err
+	}
+
+	if !strings.HasPrefix(link, "net:[") || !strings.HasSuffix(link, "]") {
+		return 0, fmt.Errorf("unexpected network namespace link %s", link)
+	}
+
+	ns, err := strconv.ParseUint(link[len("net:["):len(link)-1], 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected network namespace link %s: %v", link, err)
+	}
+	return uint32(ns), nil
+}
@@ -0,0 +1,74 @@
+// +build linux_bpf
+
+package ebpf
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetNSCacheAnnotate(t *testing.T) {
+	procRoot, err := ioutil.TempDir("", "proc")
+	require.NoError(t, err)
+	defer os.RemoveAll(procRoot)
+
+	setNetNS := func(pid string, link string) {
+		dir := filepath.Join(procRoot, pid, "ns")
+		require.NoError(t, os.MkdirAll(dir, 0755))
+		os.Remove(filepath.Join(dir, "net"))
+		require.NoError(t, os.Symlink(link, filepath.Join(dir, "net")))
+	}
+	setNetNS("10", "net:[4026531992]")
+	setNetNS("20", "net:[4026532290]")
+
+	cache := newNetNSCache(procRoot)
+	conns := []network.ConnectionStats{
+		{Pid: 10},
+		{Pid: 20},
+		{Pid: 20, NetNS: 4026532500},
+		{Pid: 30},
+	}
+	cache.annotate(conns)
+
+	assert.Equal(t, uint32(4026531992), conns[0].NetNS)
+	assert.Equal(t, uint32(4026532290), conns[1].NetNS)
+	assert.Equal(t, uint32(4026532500), conns[2].NetNS)
+	assert.Equal(t, uint32(0), conns[3].NetNS)
+
+	// the cached namespace is used until the PID is gone
+	setNetNS("10", "net:[4026532600]")
+	conns = []network.ConnectionStats{{Pid: 10}}
+	cache.annotate(conns)
+	assert.Equal(t, uint32(4026531992), conns[0].NetNS)
+	assert.NotContains(t, cache.entries, uint32(20))
+
+	cache.annotate(nil)
+	conns = []network.ConnectionStats{{Pid: 10}}
+	cache.annotate(conns)
+	assert.Equal(t, uint32(4026532600), conns[0].NetNS)
+}
+
+func TestReadNetNS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "netns")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	require.NoError(t, os.Symlink("net:[4026531992]", filepath.Join(dir, "valid")))
+	require.NoError(t, os.Symlink("mnt:[4026531840]", filepath.Join(dir, "invalid")))
+
+	ns, err := readNetNS(filepath.Join(dir, "valid"))
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(4026531992), ns)
+
+	_, err = readNetNS(filepath.Join(dir, "invalid"))
+	assert.Error(t, err)
+
+	_, err = readNetNS(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,73 @@
+// +build windows
+
+package ebpf
+
+import (
+	"unsafe"
+
+	"github.com/DataDog/datadog-agent/pkg/network"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+	"golang.org/x/sys/windows"
+)
+
+var (
+	modkernel32                 = windows.NewLazySystemDLL("kernel32.dll")
+	modiphlpapi                 = windows.NewLazySystemDLL("iphlpapi.dll")
+	procProcessIdToSessionId    = modkernel32.NewProc("ProcessIdToSessionId")
+	procGetSessionCompartmentId = modiphlpapi.NewProc("GetSessionCompartmentId")
+)
+
+// netNSCache resolves the PID of the connections to the network compartment of their process,
+// the Windows counterpart of the network namespaces
+type netNSCache struct {
+	entries map[uint32]uint32
+}
+
+func newNetNSCache() *netNSCache {
+	return &netNSCache{
+		entries: make(map[uint32]uint32),
+	}
+}
+
+// resolve returns the network compartment of the given PID, it is looked up from the session
+// of the process when the PID is not in the cache
+func (c *netNSCache) resolve(pid uint32) (uint32, bool) {
+	if compartment, ok := c.entries[pid]; ok {
+		return compartment, true
+	}
+
+	var session uint32
+	if r, _, err := procProcessIdToSessionId.Call(uintptr(pid), uintptr(unsafe.Pointer(&session))); r == 0 {
+		log.Debugf("could not get session for %v %v", pid, err)
+		return 0, false
+	}
+
+	// GetSessionCompartmentId returns NET_IF_COMPARTMENT_ID_UNSPECIFIED (0) on failure
+	compartment, _, _ := procGetSessionCompartmentId.Call(uintptr(session))
+	if compartment == 0 {
+		log.Debugf("could not get network compartment for %v in session %v", pid, session)
+		return 0, false
+	}
+
+	c.entries[pid] = uint32(compartment)
+	return uint32(compartment), true
+}
+
+// annotate sets the network compartment of the connections. The processes without any connection
+// left are removed from the cache so that a reused PID gets resolved again
+func (c *netNSCache) annotate(conns []network.ConnectionStats) {
+	seen := make(map[uint32]struct{})
+	for i := range conns {
+		pid := conns[i].Pid
+		seen[pid] = struct{}{}
+		if compartment, ok := c.resolve(pid); ok {
+			conns[i].NetNS = compartment
+		}
+	}
+
+	for pid := range c.entries {
+		if _, ok := seen[pid]; !ok {
+			delete(c.entries, pid)
+		}
+	}
+}
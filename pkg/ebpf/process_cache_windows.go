@@ -0,0 +1,94 @@
+// +build windows
+
+package ebpf
+
+import (
+	"path/filepath"
+
+	"github.com/DataDog/datadog-agent/pkg/network"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+	"github.com/DataDog/datadog-agent/pkg/util/winutil"
+	"golang.org/x/sys/windows"
+)
+
+// processQueryLimitedInformation is PROCESS_QUERY_LIMITED_INFORMATION, which isn't defined in x/sys/windows
+const processQueryLimitedInformation = 0x1000
+
+// processInfo holds the name and executable path of a process, the creation time tells apart the
+// successive processes using the same PID
+type processInfo struct {
+	name       string
+	path       string
+	createTime int64
+}
+
+// processCache resolves the PID of the connections to the name and executable path of their process
+type processCache struct {
+	entries map[uint32]processInfo
+}
+
+func newProcessCache() *processCache {
+	return &processCache{
+		entries: make(map[uint32]processInfo),
+	}
+}
+
+// resolve returns the process running with the given PID, the cached entry is dropped when the PID
+// was reused by a process created at another time
+func (c *processCache) resolve(pid uint32) (processInfo, bool) {
+	h, err := windows.OpenProcess(processQueryLimitedInformation, false, pid)
+	if err != nil {
+		delete(c.entries, pid)
+		return processInfo{}, false
+	}
+	defer windows.CloseHandle(h)
+
+	var times windows.Rusage
+	if err := windows.GetProcessTimes(h, &times.CreationTime, &times.ExitTime, &times.KernelTime, &times.UserTime); err != nil {
+		log.Debugf("could not get process times for %v %v", pid, err)
+		delete(c.entries, pid)
+		return processInfo{}, false
+	}
+	createTime := times.CreationTime.Nanoseconds()
+
+	if info, ok := c.entries[pid]; ok && info.createTime == createTime {
+		return info, true
+	}
+
+	path, err := winutil.GetImagePathForProcess(h)
+	if err != nil {
+		log.Debugf("could not get image path for %v %v", pid, err)
+		delete(c.entries, pid)
+		return processInfo{}, false
+	}
+
+	info := processInfo{
+		name:       filepath.Base(path),
+		path:       path,
+		createTime: createTime,
+	}
+	c.entries[pid] = info
+	return info, true
+}
+
+// annotate sets the process name and executable path of the connections. Each PID is resolved once,
+// and the processes without any connection left are removed from the cache
+func (c *processCache) annotate(conns []network.ConnectionStats) {
+	resolved := make(map[uint32]processInfo)
+	for i := range conns {
+		pid := conns[i].Pid
+		info, ok := resolved[pid]
+		if !ok {
+			info, _ = c.resolve(pid)
+			resolved[pid] = info
+		}
+		conns[i].ProcessName = info.name
+		conns[i].ProcessPath = info.path
+	}
+
+	for pid := range c.entries {
+		if _, ok := resolved[pid]; !ok {
+			delete(c.entries, pid)
+		}
+	}
+}
@@ -47,6 +47,11 @@ type Tracer struct {
 
 	reverseDNS network.ReverseDNS
 
+	// netNSCache is set when the network namespace of the connections is resolved from their PID
+	netNSCache *netNSCache
+
+	firstSeen *firstSeenCache
+
 	perfMap      *manager.PerfMap
 	perfHandler  *bytecode.PerfHandler
 	batchManager *PerfBatchManager
@@ -70,6 +75,8 @@ type Tracer struct {
 	// to determine whether a connection is truly closed or not
 	expiredTCPConns int64
 	closedConns     int64
+	// Will track the count of connections dropped by the source and destination excludes
+	excludedConns int64
 
 	buffer     []network.ConnectionStats
 	bufferLock sync.Mutex
@@ -190,6 +197,8 @@ func NewTracer(config *Config) (*Tracer, error) {
 			config.CollectDNSStats,
 			config.CollectLocalDNS,
 			config.DNSTimeout,
+			config.DNSCacheSize,
+			config.DNSCacheTTL,
 		); err == nil {
 			reverseDNS = snooper
 		} else {
@@ -218,6 +227,7 @@ func NewTracer(config *Config) (*Tracer, error) {
 
 	state := network.NewState(
 		config.ClientStateExpiry,
+		config.ClosedConnectionRetention,
 		config.MaxClosedConnectionsBuffered,
 		config.MaxConnectionsStateBuffered,
 		config.MaxDNSStatsBufferred,
@@ -236,6 +246,11 @@ func NewTracer(config *Config) (*Tracer, error) {
 		sourceExcludes: network.ParseConnectionFilters(config.ExcludedSourceConnections),
 		destExcludes:   network.ParseConnectionFilters(config.ExcludedDestinationConnections),
 		perfHandler:    perfHandler,
+		firstSeen:      newFirstSeenCache(),
+	}
+
+	if config.ResolveNetNS {
+		tr.netNSCache = newNetNSCache(config.ProcRoot)
 	}
 
 	tr.perfMap, tr.batchManager, err = tr.initPerfPolling(perfHandler)
@@ -373,9 +388,10 @@ func (t *Tracer) initPerfPolling(perf *bytecode.PerfHandler) (*manager.PerfMap,
 				recv := atomic.SwapInt64(&t.perfReceived, 0)
 				lost := atomic.SwapInt64(&t.perfLost, 0)
 				skip := atomic.SwapInt64(&t.skippedConns, 0)
+				excluded := atomic.SwapInt64(&t.excludedConns, 0)
 				tcpExpired := atomic.SwapInt64(&t.expiredTCPConns, 0)
 				if lost > 0 {
-					log.Warnf("closed connection polling: %d received, %d lost, %d skipped, %d expired TCP", recv, lost, skip, tcpExpired)
+					log.Warnf("closed connection polling: %d received, %d lost, %d skipped, %d excluded, %d expired TCP", recv, lost, skip, excluded, tcpExpired)
 				}
 			}
 		}
@@ -390,13 +406,25 @@ func (t *Tracer) shouldSkipConnection(conn *network.ConnectionStats) bool {
 	isDNSConnection := conn.DPort == 53 || conn.SPort == 53
 	if !t.config.CollectLocalDNS && isDNSConnection && conn.Dest.IsLoopback() {
 		return true
-	} else if network.IsExcludedConnection(t.sourceExcludes, t.destExcludes, conn) {
+	}
+	return false
+}
+
+// isExcludedConnection returns whether a connection matches the source or destination excludes. It is checked
+// as soon as a connection is read, so that excluded connections are neither looked up in conntrack nor buffered.
+func (t *Tracer) isExcludedConnection(conn *network.ConnectionStats) bool {
+	if network.IsExcludedConnection(t.sourceExcludes, t.destExcludes, conn) {
+		atomic.AddInt64(&t.excludedConns, 1)
 		return true
 	}
 	return false
 }
 
 func (t *Tracer) storeClosedConn(cs network.ConnectionStats) {
+	if t.isExcludedConnection(&cs) {
+		return
+	}
+
 	cs.Direction = t.determineConnectionDirection(&cs)
 	if t.shouldSkipConnection(&cs) {
 		atomic.AddInt64(&t.skippedConns, 1)
@@ -404,6 +432,7 @@ func (t *Tracer) storeClosedConn(cs network.ConnectionStats) {
 	}
 
 	atomic.AddInt64(&t.closedConns, 1)
+	t.firstSeen.remove(&cs, time.Now())
 	cs.IPTranslation = t.conntracker.GetTranslationForConn(cs)
 	t.state.StoreClosedConnection(cs)
 	if cs.IPTranslation != nil {
@@ -419,7 +448,9 @@ func (t *Tracer) Stop() {
 	t.conntracker.Close()
 }
 
-func (t *Tracer) GetActiveConnections(clientID string) (*network.Connections, error) {
+// GetActiveConnections returns the active connections and the ones closed since the last call for the given client,
+// only the connections in one of the given states are returned if any is given
+func (t *Tracer) GetActiveConnections(clientID string, states ...network.ConnectionState) (*network.Connections, error) {
 	t.bufferLock.Lock()
 	defer t.bufferLock.Unlock()
 
@@ -435,13 +466,34 @@ func (t *Tracer) GetActiveConnections(clientID string) (*network.Connections, er
 		t.buffer = make([]network.ConnectionStats, 0, cap(t.buffer)/2)
 	}
 
+	t.firstSeen.annotate(latestConns, time.Now())
 	conns := t.state.Connections(clientID, latestTime, latestConns, t.reverseDNS.GetDNSStats())
+	// the state of the client is updated with all the connections before they are filtered
+	conns = network.FilterConnectionsByState(conns, states...)
+	if t.netNSCache != nil {
+		t.netNSCache.annotate(conns)
+	}
+	if t.config.SortConnections {
+		network.SortConnections(conns)
+	}
 	names := t.reverseDNS.Resolve(conns)
 	tm := t.getConnTelemetry(len(latestConns))
 
 	return &network.Connections{Conns: conns, DNS: names, Telemetry: tm}, nil
 }
 
+// GetClosedConnections returns the connections closed, or expired after being idle, since the last call
+// for the given client, with their final stats
+func (t *Tracer) GetClosedConnections(clientID string) (*network.Connections, error) {
+	conns := t.state.ClosedConnections(clientID)
+	if t.config.SortConnections {
+		network.SortConnections(conns)
+	}
+	names := t.reverseDNS.Resolve(conns)
+
+	return &network.Connections{Conns: conns, DNS: names}, nil
+}
+
 func (t *Tracer) getConnTelemetry(mapSize int) *network.ConnectionsTelemetry {
 	kprobeStats := getProbeTotals()
 	tm := &network.ConnectionsTelemetry{
@@ -524,18 +576,34 @@ func (t *Tracer) getConnections(active []network.ConnectionStats) ([]network.Con
 	key, stats := &ConnTuple{}, &ConnStatsWithTimestamp{}
 	seen := make(map[ConnTuple]struct{})
 	var expired []*ConnTuple
+	// final stats of the expired connections
+	expiredConns := make(map[ConnTuple]network.ConnectionStats)
 	entries := mp.IterateFrom(unsafe.Pointer(&ConnTuple{}))
 	for entries.Next(unsafe.Pointer(key), unsafe.Pointer(stats)) {
 		if stats.isExpired(latestTime, t.timeoutForConn(key)) {
 			expired = append(expired, key.copy())
+
+			conn := connStats(key, stats, t.getTCPStats(tcpMp, key, seen))
+			// excluded connections are still removed from the eBPF maps, but never stored as expired
+			if !t.isExcludedConnection(&conn) {
+				conn.Direction = t.determineConnectionDirection(&conn)
+				if !t.shouldSkipConnection(&conn) {
+					conn.IPTranslation = t.conntracker.GetTranslationForConn(conn)
+					expiredConns[*key] = conn
+				}
+			}
+
 			if key.isTCP() {
 				atomic.AddInt64(&t.expiredTCPConns, 1)
 			}
 			atomic.AddInt64(&t.closedConns, 1)
 		} else {
 			conn := connStats(key, stats, t.getTCPStats(tcpMp, key, seen))
-			conn.Direction = t.determineConnectionDirection(&conn)
+			if t.isExcludedConnection(&conn) {
+				continue
+			}
 
+			conn.Direction = t.determineConnectionDirection(&conn)
 			if t.shouldSkipConnection(&conn) {
 				atomic.AddInt64(&t.skippedConns, 1)
 			} else {
@@ -551,7 +619,7 @@ func (t *Tracer) getConnections(active []network.ConnectionStats) ([]network.Con
 	}
 
 	// Remove expired entries
-	t.removeEntries(mp, tcpMp, expired)
+	t.removeEntries(mp, tcpMp, expired, expiredConns)
 
 	// check for expired clients in the state
 	t.state.RemoveExpiredClients(time.Now())
@@ -575,7 +643,9 @@ func (t *Tracer) getConnections(active []network.ConnectionStats) ([]network.Con
 	return active, latestTime, nil
 }
 
-func (t *Tracer) removeEntries(mp, tcpMp *ebpf.Map, entries []*ConnTuple) {
+// removeEntries removes the given expired connections from the eBPF maps and the state, the removed
+// connections found in expiredConns are stored as expired for the clients fetching closed connections
+func (t *Tracer) removeEntries(mp, tcpMp *ebpf.Map, entries []*ConnTuple, expiredConns map[ConnTuple]network.ConnectionStats) {
 	now := time.Now()
 	// Byte keys of the connections to remove
 	keys := make([]string, 0, len(entries))
@@ -594,6 +664,11 @@ func (t *Tracer) removeEntries(mp, tcpMp *ebpf.Map, entries []*ConnTuple) {
 			continue
 		}
 
+		if conn, ok := expiredConns[*entries[i]]; ok {
+			t.firstSeen.remove(&conn, now)
+			t.state.StoreExpiredConnection(conn)
+		}
+
 		// Delete conntrack entry for this connection
 		connStats := connStats(entries[i], statsWithTs, tcpStats)
 		t.conntracker.DeleteTranslation(connStats)
@@ -713,6 +788,7 @@ func (t *Tracer) GetStats() (map[string]interface{}, error) {
 	lost := atomic.LoadInt64(&t.perfLost)
 	received := atomic.LoadInt64(&t.perfReceived)
 	skipped := atomic.LoadInt64(&t.skippedConns)
+	excluded := atomic.LoadInt64(&t.excludedConns)
 	expiredTCP := atomic.LoadInt64(&t.expiredTCPConns)
 	pidCollisions := atomic.LoadInt64(&t.pidCollisions)
 
@@ -725,7 +801,8 @@ func (t *Tracer) GetStats() (map[string]interface{}, error) {
 		"tracer": map[string]int64{
 			"closed_conn_polling_lost":     lost,
 			"closed_conn_polling_received": received,
-			"conn_valid_skipped":           skipped, // Skipped connections (e.g. Local DNS requests)
+			"conn_valid_skipped":           skipped,  // Skipped connections (e.g. Local DNS requests)
+			"conn_excluded":                excluded, // Connections dropped by the source and destination excludes
 			"expired_tcp_conns":            expiredTCP,
 			"pid_collisions":               pidCollisions,
 		},
@@ -735,6 +812,82 @@ func (t *Tracer) GetStats() (map[string]interface{}, error) {
 	}, nil
 }
 
+// Info returns everything the tracer knows, for troubleshooting: its stats, a summary of the connections
+// in the eBPF maps, the status of the probes and the configuration in effect.
+// It only reads the eBPF maps, so it doesn't change what the next collection of the connections returns.
+func (t *Tracer) Info() map[string]interface{} {
+	info := map[string]interface{}{
+		"config": t.config,
+		"probes": t.getProbesStatus(),
+	}
+
+	if stats, err := t.GetStats(); err != nil {
+		info["stats"] = map[string]string{"error": err.Error()}
+	} else {
+		info["stats"] = stats
+	}
+
+	if summary, err := t.summarizeConnections(); err != nil {
+		info["connections"] = map[string]string{"error": err.Error()}
+	} else {
+		info["connections"] = summary
+	}
+
+	return info
+}
+
+// summarizeConnections returns a summary of the connections in the eBPF map. Unlike getConnections, it only walks
+// the map: the expired connections are counted but neither removed nor stored, and no stat of the tracer is updated.
+func (t *Tracer) summarizeConnections() (map[string]int, error) {
+	mp, err := t.getMap(bytecode.ConnMap)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving the bpf %s map: %s", bytecode.ConnMap, err)
+	}
+
+	latestTime, _, err := t.getLatestTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving latest timestamp: %s", err)
+	}
+
+	var conns []network.ConnectionStats
+	expired := 0
+	key, stats := &ConnTuple{}, &ConnStatsWithTimestamp{}
+	entries := mp.IterateFrom(unsafe.Pointer(&ConnTuple{}))
+	for entries.Next(unsafe.Pointer(key), unsafe.Pointer(stats)) {
+		if stats.isExpired(latestTime, t.timeoutForConn(key)) {
+			expired++
+			continue
+		}
+
+		conn := connStats(key, stats, new(TCPStats))
+		if network.IsExcludedConnection(t.sourceExcludes, t.destExcludes, &conn) {
+			continue
+		}
+		conn.Direction = t.determineConnectionDirection(&conn)
+		conns = append(conns, conn)
+	}
+
+	if err := entries.Err(); err != nil {
+		return nil, fmt.Errorf("unable to iterate connection map: %s", err)
+	}
+
+	summary := connectionsSummary(conns)
+	summary["expired"] = expired
+	return summary, nil
+}
+
+// getProbesStatus returns whether each probe is enabled and has its program loaded
+func (t *Tracer) getProbesStatus() map[string]map[string]bool {
+	status := make(map[string]map[string]bool, len(t.m.Probes))
+	for _, p := range t.m.Probes {
+		status[p.Section] = map[string]bool{
+			"enabled": p.Enabled,
+			"loaded":  p.Program() != nil,
+		}
+	}
+	return status
+}
+
 // DebugNetworkState returns a map with the current tracer's internal state, for debugging
 func (t *Tracer) DebugNetworkState(clientID string) (map[string]interface{}, error) {
 	if t.state == nil {
@@ -745,10 +898,15 @@ func (t *Tracer) DebugNetworkState(clientID string) (map[string]interface{}, err
 
 // DebugNetworkMaps returns all connections stored in the BPF maps without modifications from network state
 func (t *Tracer) DebugNetworkMaps() (*network.Connections, error) {
+	t.bufferLock.Lock()
 	latestConns, _, err := t.getConnections(make([]network.ConnectionStats, 0))
+	t.bufferLock.Unlock()
 	if err != nil {
 		return nil, fmt.Errorf("error retrieving connections: %s", err)
 	}
+	if t.config.SortConnections {
+		network.SortConnections(latestConns)
+	}
 	return &network.Connections{Conns: latestConns}, nil
 }
 
@@ -210,6 +210,57 @@ func TestTCPSendAndReceive(t *testing.T) {
 	doneChan <- struct{}{}
 }
 
+func TestConnectionCookie(t *testing.T) {
+	tr, err := NewTracer(NewDefaultConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tr.Stop()
+
+	server := NewTCPServer(func(c net.Conn) {
+		r := bufio.NewReader(c)
+		for {
+			if _, err := r.ReadBytes(byte('\n')); err != nil {
+				break
+			}
+		}
+		c.Close()
+	})
+	doneChan := make(chan struct{})
+	server.Run(doneChan)
+	defer close(doneChan)
+
+	var conns []net.Conn
+	for i := 0; i < 2; i++ {
+		c, err := net.DialTimeout("tcp", server.address, 50*time.Millisecond)
+		require.NoError(t, err)
+		defer c.Close()
+
+		_, err = c.Write(genPayload(clientMessageSize))
+		require.NoError(t, err)
+		conns = append(conns, c)
+	}
+
+	connections := getConnections(t, tr)
+	first, ok := findConnection(conns[0].LocalAddr(), conns[0].RemoteAddr(), connections)
+	require.True(t, ok)
+	second, ok := findConnection(conns[1].LocalAddr(), conns[1].RemoteAddr(), connections)
+	require.True(t, ok)
+
+	assert.NotZero(t, first.Cookie)
+	assert.NotZero(t, second.Cookie)
+	assert.NotEqual(t, first.Cookie, second.Cookie)
+
+	// the cookie identifies the connection from one poll to the next
+	cookie := first.Cookie
+	_, err = conns[0].Write(genPayload(clientMessageSize))
+	require.NoError(t, err)
+
+	first, ok = findConnection(conns[0].LocalAddr(), conns[0].RemoteAddr(), getConnections(t, tr))
+	require.True(t, ok)
+	assert.Equal(t, cookie, first.Cookie)
+}
+
 func TestPreexistingConnectionDirection(t *testing.T) {
 	// Start the client and server before we enable the system probe to test that the tracer picks
 	// up the pre-existing connection
@@ -940,6 +991,11 @@ func TestShouldSkipExcludedConnection(t *testing.T) {
 		assert.False(t, c.Source.String() == "127.0.0.1" && c.SPort == 80)
 		assert.True(t, c.Dest.String() == "127.0.0.1" && c.DPort == 80)
 	}
+
+	// The connection is dropped when read from the eBPF map
+	stats, err := tr.GetStats()
+	require.NoError(t, err)
+	assert.NotZero(t, stats["tracer"].(map[string]int64)["conn_excluded"])
 }
 
 func TestTooSmallBPFMap(t *testing.T) {
@@ -1200,7 +1256,7 @@ func removeConnection(t *testing.T, tr *Tracer, c *network.ConnectionStats) {
 		},
 	}
 
-	tr.removeEntries(mp, tcpMp, tuple)
+	tr.removeEntries(mp, tcpMp, tuple, nil)
 }
 
 func byAddress(l, r net.Addr) func(c network.ConnectionStats) bool {
@@ -16,7 +16,12 @@ func NewTracer(_ *Config) (*Tracer, error) {
 func (t *Tracer) Stop() {}
 
 // GetActiveConnections is not implemented on this OS for Tracer
-func (t *Tracer) GetActiveConnections(_ string) (*network.Connections, error) {
+func (t *Tracer) GetActiveConnections(_ string, _ ...network.ConnectionState) (*network.Connections, error) {
+	return nil, ErrNotImplemented
+}
+
+// GetClosedConnections is not implemented on this OS for Tracer
+func (t *Tracer) GetClosedConnections(_ string) (*network.Connections, error) {
 	return nil, ErrNotImplemented
 }
 
@@ -25,6 +30,11 @@ func (t *Tracer) GetStats() (map[string]interface{}, error) {
 	return nil, ErrNotImplemented
 }
 
+// Info is not implemented on this OS for Tracer
+func (t *Tracer) Info() map[string]interface{} {
+	return nil
+}
+
 // DebugNetworkState is not implemented on this OS for Tracer
 func (t *Tracer) DebugNetworkState(clientID string) (map[string]interface{}, error) {
 	return nil, ErrNotImplemented
@@ -34,6 +34,9 @@ type Tracer struct {
 	stopChan        chan struct{}
 	state           network.State
 	reverseDNS      network.ReverseDNS
+	processCache    *processCache
+	netNSCache      *netNSCache
+	firstSeen       *firstSeenCache
 
 	timerInterval int
 
@@ -51,17 +54,28 @@ func NewTracer(config *Config) (*Tracer, error) {
 
 	state := network.NewState(
 		config.ClientStateExpiry,
+		config.ClosedConnectionRetention,
 		config.MaxClosedConnectionsBuffered,
 		config.MaxConnectionsStateBuffered,
 		config.MaxDNSStatsBufferred,
 	)
 
 	tr := &Tracer{
+		config:          config,
 		driverInterface: di,
 		stopChan:        make(chan struct{}),
 		timerInterval:   defaultPollInterval,
 		state:           state,
 		reverseDNS:      network.NewNullReverseDNS(),
+		firstSeen:       newFirstSeenCache(),
+	}
+
+	if config.ResolveProcessNames {
+		tr.processCache = newProcessCache()
+	}
+
+	if config.ResolveNetNS {
+		tr.netNSCache = newNetNSCache()
 	}
 
 	go tr.expvarStats(tr.stopChan)
@@ -113,21 +127,46 @@ func printStats(stats []network.ConnectionStats) {
 	}
 }
 
-// GetActiveConnections returns all active connections
-func (t *Tracer) GetActiveConnections(clientID string) (*network.Connections, error) {
+// GetActiveConnections returns all active connections, only the connections in one of the given states are
+// returned if any is given
+func (t *Tracer) GetActiveConnections(clientID string, states ...network.ConnectionState) (*network.Connections, error) {
 	connStatsActive, connStatsClosed, err := t.driverInterface.GetConnectionStats()
 	if err != nil {
 		log.Errorf("failed to get connnections")
 		return nil, err
 	}
 
+	now := time.Now()
 	for _, connStat := range connStatsClosed {
+		t.firstSeen.remove(&connStat, now)
 		t.state.StoreClosedConnection(connStat)
 	}
+	t.firstSeen.annotate(connStatsActive, now)
 
 	// check for expired clients in the state
 	t.state.RemoveExpiredClients(time.Now())
 	conns := t.state.Connections(clientID, uint64(time.Now().Nanosecond()), connStatsActive, t.reverseDNS.GetDNSStats())
+	// the state of the client is updated with all the connections before they are filtered
+	conns = network.FilterConnectionsByState(conns, states...)
+	if t.processCache != nil {
+		t.processCache.annotate(conns)
+	}
+	if t.netNSCache != nil {
+		t.netNSCache.annotate(conns)
+	}
+	if t.config.SortConnections {
+		network.SortConnections(conns)
+	}
+	return &network.Connections{Conns: conns}, nil
+}
+
+// GetClosedConnections returns the connections closed since the last call for the given client, with their final stats.
+// The closed connections are read from the driver by GetActiveConnections.
+func (t *Tracer) GetClosedConnections(clientID string) (*network.Connections, error) {
+	conns := t.state.ClosedConnections(clientID)
+	if t.config.SortConnections {
+		network.SortConnections(conns)
+	}
 	return &network.Connections{Conns: conns}, nil
 }
 
@@ -157,6 +196,22 @@ func (t *Tracer) GetStats() (map[string]interface{}, error) {
 	}, nil
 }
 
+// Info returns everything the tracer knows, for troubleshooting: its stats and the configuration in effect.
+// The connections can't be summarized as reading them from the driver flushes the closed ones.
+func (t *Tracer) Info() map[string]interface{} {
+	info := map[string]interface{}{
+		"config": t.config,
+	}
+
+	if stats, err := t.GetStats(); err != nil {
+		info["stats"] = map[string]string{"error": err.Error()}
+	} else {
+		info["stats"] = stats
+	}
+
+	return info
+}
+
 // DebugNetworkState returns a map with the current tracer's internal state, for debugging
 func (t *Tracer) DebugNetworkState(clientID string) (map[string]interface{}, error) {
 	return nil, ErrNotImplemented
@@ -205,6 +205,11 @@ func createArchive(confSearchPaths SearchPaths, local bool, zipFilePath, logFile
 		if err != nil {
 			log.Errorf("Could not zip system probe exp var stats: %s", err)
 		}
+
+		err = zipSystemProbeTracerInfo(tempDir, hostname)
+		if err != nil {
+			log.Errorf("Could not zip system probe tracer info: %s", err)
+		}
 	}
 
 	err = zipDiagnose(tempDir, hostname)
@@ -487,6 +492,28 @@ func zipSystemProbeStats(tempDir, hostname string) error {
 	return err
 }
 
+func zipSystemProbeTracerInfo(tempDir, hostname string) error {
+	info := status.GetSystemProbeTracerInfo(config.Datadog.GetString("system_probe_config.sysprobe_socket"))
+	f := filepath.Join(tempDir, hostname, "system-probe-tracer-info.yaml")
+	err := ensureParentDirsExist(f)
+	if err != nil {
+		return err
+	}
+
+	w, err := newRedactingWriter(f, os.ModePerm, true)
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+
+	buf, err := yaml.Marshal(info)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(buf)
+	return err
+}
+
 func zipConfigFiles(tempDir, hostname string, confSearchPaths SearchPaths, permsInfos permissionsInfos) error {
 	c, err := yaml.Marshal(config.Datadog.AllSettings())
 	if err != nil {
@@ -58,14 +58,17 @@ func getHostTags() *tags {
 		hostTags = appendToHostTags(hostTags, []string{"env:" + env})
 	}
 
+	ec2TagsCollected := false
 	if config.Datadog.GetBool("collect_ec2_tags") {
 		ec2Tags, err := ec2.GetTags()
 		if err != nil {
 			log.Debugf("No EC2 host tags %v", err)
 		} else {
 			hostTags = appendToHostTags(hostTags, ec2Tags)
+			ec2TagsCollected = true
 		}
 	}
+	hostTags = appendToHostTags(hostTags, getEC2HostInfoTags(ec2TagsCollected))
 
 	clusterName := clustername.GetClusterName()
 	if len(clusterName) != 0 {
@@ -106,3 +109,28 @@ func getHostTags() *tags {
 		GoogleCloudPlatform: gceTags,
 	}
 }
+
+// getEC2HostInfoTags returns the architecture and hypervisor tags of EC2 hosts. They are detected
+// locally, so they don't depend on the collection of the EC2 tags: the host is known to run on EC2
+// from its sysfs signature, or from the EC2 tags when they were collected.
+func getEC2HostInfoTags(ec2TagsCollected bool) []string {
+	hypervisor, err := ec2.GetHypervisor()
+	if err != nil {
+		log.Debugf("No EC2 hypervisor host tag %v", err)
+	}
+
+	if !ec2TagsCollected && hypervisor != ec2.HypervisorNitro && !ec2.IsRunningOnViaUUID() {
+		return nil
+	}
+
+	var tags []string
+	if arch, err := ec2.GetArchitecture(); err != nil {
+		log.Debugf("No EC2 architecture host tag %v", err)
+	} else {
+		tags = append(tags, "architecture:"+arch)
+	}
+	if hypervisor != "" {
+		tags = append(tags, "hypervisor:"+hypervisor)
+	}
+	return tags
+}
@@ -0,0 +1,69 @@
+package network
+
+import (
+	"sort"
+
+	"github.com/DataDog/datadog-agent/pkg/process/util"
+)
+
+// DestinationStats aggregates the connections going to the same destination. The destination is the
+// domain the destination IP resolves to or, when it isn't resolved, the destination IP itself.
+type DestinationStats struct {
+	// Domain is empty when the destination IP isn't resolved
+	Domain string
+	// Dest is only set when the destination IP isn't resolved
+	Dest util.Address
+
+	Count uint64
+
+	MonotonicSentBytes uint64
+	LastSentBytes      uint64
+	MonotonicRecvBytes uint64
+	LastRecvBytes      uint64
+}
+
+type destinationKey struct {
+	domain string
+	dest   util.Address
+}
+
+// AggregateByDestination groups the connections by destination domain, or by destination IP when no
+// domain is known, summing their counts and bytes. An IP resolving to several domains is attributed to
+// the first of them in alphabetical order. The result is sorted by domain, the unresolved
+// destinations coming first sorted by IP.
+func (c *Connections) AggregateByDestination() []DestinationStats {
+	byDest := make(map[destinationKey]*DestinationStats)
+	for _, conn := range c.Conns {
+		key := destinationKey{dest: conn.Dest}
+		if names := c.DNS[conn.Dest]; len(names) > 0 {
+			key = destinationKey{domain: names[0]}
+		}
+
+		stats, ok := byDest[key]
+		if !ok {
+			stats = &DestinationStats{Domain: key.domain, Dest: key.dest}
+			byDest[key] = stats
+		}
+
+		stats.Count++
+		stats.MonotonicSentBytes += conn.MonotonicSentBytes
+		stats.LastSentBytes += conn.LastSentBytes
+		stats.MonotonicRecvBytes += conn.MonotonicRecvBytes
+		stats.LastRecvBytes += conn.LastRecvBytes
+	}
+
+	aggregated := make([]DestinationStats, 0, len(byDest))
+	for _, stats := range byDest {
+		aggregated = append(aggregated, *stats)
+	}
+
+	sort.Slice(aggregated, func(i, j int) bool {
+		if aggregated[i].Domain != aggregated[j].Domain {
+			return aggregated[i].Domain < aggregated[j].Domain
+		}
+		// only unresolved destinations share the same (empty) domain
+		return aggregated[i].Dest.String() < aggregated[j].Dest.String()
+	})
+
+	return aggregated
+}
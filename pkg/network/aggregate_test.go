@@ -0,0 +1,49 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/process/util"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregateByDestination(t *testing.T) {
+	resolved1 := util.AddressFromString("10.0.0.1")
+	resolved2 := util.AddressFromString("10.0.0.2")
+	unresolved := util.AddressFromString("10.0.0.3")
+
+	conns := &Connections{
+		DNS: map[util.Address][]string{
+			resolved1: {"api.example.com", "www.example.com"},
+			resolved2: {"api.example.com"},
+		},
+		Conns: []ConnectionStats{
+			{Dest: resolved1, MonotonicSentBytes: 10, LastSentBytes: 1, MonotonicRecvBytes: 100, LastRecvBytes: 10},
+			{Dest: resolved2, MonotonicSentBytes: 20, LastSentBytes: 2, MonotonicRecvBytes: 200, LastRecvBytes: 20},
+			{Dest: unresolved, MonotonicSentBytes: 30, LastSentBytes: 3, MonotonicRecvBytes: 300, LastRecvBytes: 30},
+			{Dest: unresolved, MonotonicSentBytes: 40, LastSentBytes: 4, MonotonicRecvBytes: 400, LastRecvBytes: 40},
+		},
+	}
+
+	assert.Equal(t, []DestinationStats{
+		{
+			Dest:               unresolved,
+			Count:              2,
+			MonotonicSentBytes: 70,
+			LastSentBytes:      7,
+			MonotonicRecvBytes: 700,
+			LastRecvBytes:      70,
+		},
+		{
+			Domain:             "api.example.com",
+			Count:              2,
+			MonotonicSentBytes: 30,
+			LastSentBytes:      3,
+			MonotonicRecvBytes: 300,
+			LastRecvBytes:      30,
+		},
+	}, conns.AggregateByDestination())
+
+	assert.Empty(t, (&Connections{}).AggregateByDestination())
+}
@@ -1,6 +1,7 @@
 package network
 
 import (
+	"container/list"
 	"sort"
 	"strings"
 	"sync"
@@ -22,10 +23,13 @@ type reverseDNSCache struct {
 	resolved  int64
 	added     int64
 	expired   int64
+	evicted   int64
 	oversized int64
 
 	mux  sync.Mutex
 	data map[util.Address]*dnsCacheVal
+	// lru holds the addresses of the cache from the most to the least recently used one
+	lru  *list.List
 	exit chan struct{}
 	ttl  time.Duration
 	size int
@@ -38,6 +42,7 @@ type reverseDNSCache struct {
 func newReverseDNSCache(size int, ttl, expirationPeriod time.Duration) *reverseDNSCache {
 	cache := &reverseDNSCache{
 		data:              make(map[util.Address]*dnsCacheVal),
+		lru:               list.New(),
 		exit:              make(chan struct{}),
 		ttl:               ttl,
 		size:              size,
@@ -67,21 +72,27 @@ func (c *reverseDNSCache) Add(translation *translation, now time.Time) bool {
 
 	c.mux.Lock()
 	defer c.mux.Unlock()
-	if len(c.data) >= c.size {
-		return false
-	}
 
 	exp := now.Add(c.ttl).UnixNano()
 	for _, addr := range translation.ips {
 		val, ok := c.data[addr]
 		if ok {
 			val.expiration = exp
+			c.lru.MoveToFront(val.element)
 			if rejected := val.merge(translation.dns, c.maxDomainsPerIP); rejected && c.oversizedLogLimit.ShouldLog() {
 				log.Warnf("%s mapped to too many domains, DNS information will be dropped (this will be logged the first 10 times, and then at most every 10 minutes)", addr)
 			}
 		} else {
+			if len(c.data) >= c.size {
+				c.evictOldest()
+			}
+
 			atomic.AddInt64(&c.added, 1)
-			c.data[addr] = &dnsCacheVal{names: []string{translation.dns}, expiration: exp}
+			c.data[addr] = &dnsCacheVal{
+				names:      []string{translation.dns},
+				expiration: exp,
+				element:    c.lru.PushFront(addr),
+			}
 		}
 	}
 
@@ -151,6 +162,7 @@ func (c *reverseDNSCache) Stats() map[string]int64 {
 		resolved  = atomic.LoadInt64(&c.resolved)
 		added     = atomic.LoadInt64(&c.added)
 		expired   = atomic.LoadInt64(&c.expired)
+		evicted   = atomic.LoadInt64(&c.evicted)
 		oversized = atomic.LoadInt64(&c.oversized)
 		ips       = int64(c.Len())
 	)
@@ -160,6 +172,7 @@ func (c *reverseDNSCache) Stats() map[string]int64 {
 		"resolved":  resolved,
 		"added":     added,
 		"expired":   expired,
+		"evicted":   evicted,
 		"oversized": oversized,
 		"ips":       ips,
 	}
@@ -179,6 +192,7 @@ func (c *reverseDNSCache) Expire(now time.Time) {
 		}
 
 		expired++
+		c.lru.Remove(val.element)
 		delete(c.data, addr)
 	}
 	total := len(c.data)
@@ -199,13 +213,26 @@ func (c *reverseDNSCache) getNamesForIP(ip util.Address, updatedTTL int64) []str
 	}
 
 	val.expiration = updatedTTL
+	c.lru.MoveToFront(val.element)
 	return val.copy()
 }
 
+// evictOldest removes the least recently used entry of the cache
+func (c *reverseDNSCache) evictOldest() {
+	oldest := c.lru.Back()
+	if oldest == nil {
+		return
+	}
+
+	atomic.AddInt64(&c.evicted, 1)
+	delete(c.data, c.lru.Remove(oldest).(util.Address))
+}
+
 type dnsCacheVal struct {
 	// opting for a []string instead of map[string]struct{} since common case is len(names) == 1
 	names      []string
 	expiration int64
+	element    *list.Element
 }
 
 func (v *dnsCacheVal) merge(name string, maxSize int) (rejected bool) {
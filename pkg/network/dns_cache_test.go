@@ -233,6 +233,32 @@ func TestDNSCacheMerge_MixedCaseNames(t *testing.T) {
 	assert.Equal(t, []string{"host.name.com"}, res[util.AddressFromString("192.168.0.1")])
 }
 
+func TestDNSCacheLRUEviction(t *testing.T) {
+	cache := newReverseDNSCache(2, time.Minute, disableAutomaticExpiration)
+	now := time.Now()
+
+	addrs := []util.Address{
+		util.AddressFromString("192.168.0.1"),
+		util.AddressFromString("192.168.0.2"),
+		util.AddressFromString("192.168.0.3"),
+	}
+
+	cache.Add(&translation{dns: "1.host.com", ips: addrs[0:1]}, now)
+	cache.Add(&translation{dns: "2.host.com", ips: addrs[1:2]}, now)
+
+	// look up the first address so that the second one becomes the least recently used
+	cache.Get([]ConnectionStats{{Dest: addrs[0]}}, now)
+
+	assert.True(t, cache.Add(&translation{dns: "3.host.com", ips: addrs[2:3]}, now))
+	assert.Equal(t, 2, cache.Len())
+
+	result := cache.Get([]ConnectionStats{{Dest: addrs[0]}, {Dest: addrs[1]}, {Dest: addrs[2]}}, now)
+	assert.Equal(t, []string{"1.host.com"}, result[addrs[0]])
+	assert.NotContains(t, result, addrs[1])
+	assert.Equal(t, []string{"3.host.com"}, result[addrs[2]])
+	assert.Equal(t, int64(1), cache.Stats()["evicted"])
+}
+
 func TestGetOversizedDNS(t *testing.T) {
 	cache := newReverseDNSCache(1000, time.Hour, time.Minute)
 	cache.maxDomainsPerIP = 10
@@ -58,6 +58,8 @@ func NewSocketFilterSnooper(
 	collectDNSStats bool,
 	collectLocalDNS bool,
 	dnsTimeout time.Duration,
+	cacheSize int,
+	cacheTTL time.Duration,
 ) (*SocketFilterSnooper, error) {
 
 	var (
@@ -76,7 +78,18 @@ func NewSocketFilterSnooper(
 		return nil, srcErr
 	}
 
-	cache := newReverseDNSCache(dnsCacheSize, dnsCacheTTL, dnsCacheExpirationPeriod)
+	if cacheSize <= 0 {
+		cacheSize = dnsCacheSize
+	}
+	if cacheTTL <= 0 {
+		cacheTTL = dnsCacheTTL
+	}
+	expirationPeriod := dnsCacheExpirationPeriod
+	if cacheTTL < expirationPeriod {
+		expirationPeriod = cacheTTL
+	}
+
+	cache := newReverseDNSCache(cacheSize, cacheTTL, expirationPeriod)
 	var statKeeper *dnsStatKeeper
 	if collectDNSStats {
 		statKeeper = newDNSStatkeeper(dnsTimeout)
@@ -66,6 +66,8 @@ func getSnooper(
 		collectStats,
 		collectLocalDNS,
 		dnsTimeout,
+		dnsCacheSize,
+		dnsCacheTTL,
 	)
 	require.NoError(t, err)
 	return mgr, reverseDNS
@@ -14,6 +14,10 @@ var (
 		marshaller: jsonpb.Marshaler{
 			EmitDefaults: true,
 		},
+		// the connection details added by addConnectionDetails are ignored
+		unmarshaller: jsonpb.Unmarshaler{
+			AllowUnknownFields: true,
+		},
 	}
 )
 
@@ -3,6 +3,7 @@ package encoding
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	model "github.com/DataDog/agent-payload/process"
 	"github.com/DataDog/datadog-agent/pkg/network"
@@ -157,9 +158,101 @@ func TestSerialization(t *testing.T) {
 		// Check that it contains fields even if they are zeroed
 		for _, field := range []string{
 			"type", "lastBytesSent", "lastBytesReceived", "lastRetransmits",
-			"netNS", "family", "direction", "pid",
+			"netNS", "family", "direction", "pid", "cookie", "firstSeen", "lastActivity", "processName", "processPath",
 		} {
 			assert.Contains(res.Conns[0], field)
 		}
 	})
 }
+
+func TestConnectionDetailsSerialization(t *testing.T) {
+	in := &network.Connections{
+		Conns: []network.ConnectionStats{
+			{
+				Source:       util.AddressFromString("10.1.1.1"),
+				Dest:         util.AddressFromString("10.2.2.2"),
+				SPort:        1000,
+				DPort:        9000,
+				Pid:          6000,
+				Cookie:       1 << 63,
+				FirstSeen:    time.Date(2020, 10, 1, 12, 30, 0, 500, time.UTC),
+				LastActivity: time.Date(2020, 10, 1, 12, 45, 0, 0, time.UTC),
+				ProcessName:  "curl.exe",
+				ProcessPath:  `C:\Windows\System32\curl.exe`,
+			},
+		},
+	}
+
+	marshaler := GetMarshaler("application/json")
+	blob, err := marshaler.Marshal(in)
+	require.NoError(t, err)
+
+	res := struct {
+		Conns []struct {
+			Pid          int32  `json:"pid"`
+			Cookie       uint64 `json:"cookie,string"`
+			FirstSeen    string `json:"firstSeen"`
+			LastActivity string `json:"lastActivity"`
+			ProcessName  string `json:"processName"`
+			ProcessPath  string `json:"processPath"`
+		} `json:"conns"`
+	}{}
+	require.NoError(t, json.Unmarshal(blob, &res))
+	require.Len(t, res.Conns, 1)
+	assert.Equal(t, int32(6000), res.Conns[0].Pid)
+	assert.Equal(t, uint64(1<<63), res.Conns[0].Cookie)
+	assert.Equal(t, "2020-10-01T12:30:00.0000005Z", res.Conns[0].FirstSeen)
+	assert.Equal(t, "2020-10-01T12:45:00Z", res.Conns[0].LastActivity)
+	assert.Equal(t, "curl.exe", res.Conns[0].ProcessName)
+	assert.Equal(t, `C:\Windows\System32\curl.exe`, res.Conns[0].ProcessPath)
+
+	// the details are ignored when the payload is decoded
+	result, err := GetUnmarshaler("application/json").Unmarshal(blob)
+	require.NoError(t, err)
+	require.Len(t, result.Conns, 1)
+	assert.Equal(t, int32(6000), result.Conns[0].Pid)
+	assert.Equal(t, &model.Addr{Ip: "10.1.1.1", Port: 1000}, result.Conns[0].Laddr)
+}
+
+func TestCounterDeltasSerialization(t *testing.T) {
+	state := network.NewState(2*time.Minute, 2*time.Minute, 50000, 75000, 75000)
+	conn := network.ConnectionStats{
+		Pid:                123,
+		Type:               network.TCP,
+		Family:             network.AFINET,
+		Source:             util.AddressFromString("127.0.0.1"),
+		Dest:               util.AddressFromString("127.0.0.1"),
+		SPort:              31890,
+		DPort:              80,
+		MonotonicSentBytes: 36,
+		MonotonicRecvBytes: 24,
+	}
+
+	encode := func(conns []network.ConnectionStats) *model.Connection {
+		marshaler := GetMarshaler("application/protobuf")
+		blob, err := marshaler.Marshal(&network.Connections{Conns: conns})
+		require.NoError(t, err)
+
+		result, err := GetUnmarshaler("application/protobuf").Unmarshal(blob)
+		require.NoError(t, err)
+		require.Len(t, result.Conns, 1)
+		return result.Conns[0]
+	}
+
+	// Register the client
+	state.Connections("1", uint64(time.Now().UnixNano()), nil, nil)
+
+	// The payload holds what changed since the previous poll of the client, not the totals
+	encoded := encode(state.Connections("1", uint64(time.Now().UnixNano()), []network.ConnectionStats{conn}, nil))
+	assert.Equal(t, uint64(36), encoded.LastBytesSent)
+	assert.Equal(t, uint64(24), encoded.LastBytesReceived)
+
+	encoded = encode(state.Connections("1", uint64(time.Now().UnixNano()), []network.ConnectionStats{conn}, nil))
+	assert.Equal(t, uint64(0), encoded.LastBytesSent)
+	assert.Equal(t, uint64(0), encoded.LastBytesReceived)
+
+	conn.MonotonicSentBytes += 42
+	encoded = encode(state.Connections("1", uint64(time.Now().UnixNano()), []network.ConnectionStats{conn}, nil))
+	assert.Equal(t, uint64(42), encoded.LastBytesSent)
+	assert.Equal(t, uint64(0), encoded.LastBytesReceived)
+}
@@ -1,12 +1,15 @@
 package encoding
 
 import (
+	"time"
+
 	model "github.com/DataDog/agent-payload/process"
 	"github.com/DataDog/datadog-agent/pkg/network"
 	"github.com/DataDog/datadog-agent/pkg/process/util"
 )
 
 // FormatConnection converts a ConnectionStats into an model.Connection
+// The fields formatted by formatConnectionDetails have no counterpart in the payload and are left out.
 func FormatConnection(conn network.ConnectionStats) *model.Connection {
 	return &model.Connection{
 		Pid:                    int32(conn.Pid),
@@ -34,6 +37,36 @@ func FormatConnection(conn network.ConnectionStats) *model.Connection {
 	}
 }
 
+// connectionDetails holds the fields of a ConnectionStats that have no counterpart in model.Connection,
+// they are added to the connections of the JSON payload
+type connectionDetails struct {
+	// Cookie is a string, as are the 64 bits integers of the JSON mapping of protobuf
+	Cookie       uint64 `json:"cookie,string"`
+	FirstSeen    string `json:"firstSeen"`
+	LastActivity string `json:"lastActivity"`
+	ProcessName  string `json:"processName"`
+	ProcessPath  string `json:"processPath"`
+}
+
+func formatConnectionDetails(conn network.ConnectionStats) connectionDetails {
+	return connectionDetails{
+		Cookie:       conn.Cookie,
+		FirstSeen:    formatTime(conn.FirstSeen),
+		LastActivity: formatTime(conn.LastActivity),
+		ProcessName:  conn.ProcessName,
+		ProcessPath:  conn.ProcessPath,
+	}
+}
+
+// formatTime formats a time as an RFC 3339 timestamp, like the since parameter of the connections endpoint,
+// it is empty when the time isn't set
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
 // FormatDNS converts a map[util.Address][]string to a map using IPs string representation
 func FormatDNS(dns map[util.Address][]string) map[string]*model.DNSEntry {
 	if dns == nil {
@@ -2,6 +2,7 @@ package encoding
 
 import (
 	"bytes"
+	"encoding/json"
 
 	model "github.com/DataDog/agent-payload/process"
 	"github.com/DataDog/datadog-agent/pkg/network"
@@ -12,7 +13,8 @@ import (
 const ContentTypeJSON = "application/json"
 
 type jsonSerializer struct {
-	marshaller jsonpb.Marshaler
+	marshaller   jsonpb.Marshaler
+	unmarshaller jsonpb.Unmarshaler
 }
 
 func (j jsonSerializer) Marshal(conns *network.Connections) ([]byte, error) {
@@ -22,14 +24,47 @@ func (j jsonSerializer) Marshal(conns *network.Connections) ([]byte, error) {
 	}
 	payload := &model.Connections{Conns: agentConns, Dns: FormatDNS(conns.DNS), Telemetry: FormatTelemetry(conns.Telemetry)}
 	writer := new(bytes.Buffer)
-	err := j.marshaller.Marshal(writer, payload)
-	return writer.Bytes(), err
+	if err := j.marshaller.Marshal(writer, payload); err != nil {
+		return nil, err
+	}
+	return addConnectionDetails(writer.Bytes(), conns.Conns)
+}
+
+// addConnectionDetails adds the fields of the connections that have no counterpart in model.Connection
+// to the connections of a JSON payload
+func addConnectionDetails(blob []byte, conns []network.ConnectionStats) ([]byte, error) {
+	var payload map[string]json.RawMessage
+	if err := json.Unmarshal(blob, &payload); err != nil {
+		return nil, err
+	}
+
+	var agentConns []map[string]json.RawMessage
+	if err := json.Unmarshal(payload["conns"], &agentConns); err != nil {
+		return nil, err
+	}
+
+	for i := range agentConns {
+		details, err := json.Marshal(formatConnectionDetails(conns[i]))
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(details, &agentConns[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	rawConns, err := json.Marshal(agentConns)
+	if err != nil {
+		return nil, err
+	}
+	payload["conns"] = rawConns
+	return json.Marshal(payload)
 }
 
-func (jsonSerializer) Unmarshal(blob []byte) (*model.Connections, error) {
+func (j jsonSerializer) Unmarshal(blob []byte) (*model.Connections, error) {
 	conns := new(model.Connections)
 	reader := bytes.NewReader(blob)
-	if err := jsonpb.Unmarshal(reader, conns); err != nil {
+	if err := j.unmarshaller.Unmarshal(reader, conns); err != nil {
 		return nil, err
 	}
 	return conns, nil
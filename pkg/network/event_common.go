@@ -70,6 +70,42 @@ func (d ConnectionDirection) String() string {
 	}
 }
 
+// ConnectionState is a state the connections can be filtered on
+//
+// Only the ESTABLISHED and CLOSED states are representable, on both Linux and Windows: the tracers observe
+// connections, from the eBPF maps and the Windows driver flows, so the listening sockets are never reported
+// and the other states of the TCP state machine aren't tracked. The filter is applied to the connections of
+// a poll once they are retrieved, on both platforms.
+type ConnectionState uint8
+
+const (
+	// ESTABLISHED represents the connections open at the end of the poll. The UDP connections, which have no
+	// TCP state, are established until they expire.
+	ESTABLISHED ConnectionState = 1
+
+	// CLOSED represents the TCP connections closed since the previous poll of the client
+	CLOSED ConnectionState = 2
+)
+
+func (s ConnectionState) String() string {
+	if s == CLOSED {
+		return "closed"
+	}
+	return "established"
+}
+
+// ParseConnectionState returns the connection state of the given name, either established or closed
+func ParseConnectionState(name string) (ConnectionState, error) {
+	switch strings.ToLower(name) {
+	case "established":
+		return ESTABLISHED, nil
+	case "closed":
+		return CLOSED, nil
+	default:
+		return 0, fmt.Errorf("unsupported connection state %s, only established and closed are tracked", name)
+	}
+}
+
 // Connections wraps a collection of ConnectionStats
 type Connections struct {
 	DNS       map[util.Address][]string
@@ -105,6 +141,16 @@ type ConnectionStats struct {
 	// Last time the stats for this connection were updated
 	LastUpdateEpoch uint64
 
+	// FirstSeen is when the tracer first observed the connection, it is kept across polls so that the age
+	// of the connection can be computed. Connections opened before system-probe started are first seen by
+	// its first poll. model.Connection has no field for it, it is added to the connections of the JSON payload.
+	FirstSeen time.Time
+
+	// LastActivity is when the tracer last observed the connection send or receive data, retransmit, or be
+	// established or closed, it is the first seen time until then. It doesn't depend on the polls of a client.
+	// model.Connection has no field for it, it is added to the connections of the JSON payload.
+	LastActivity time.Time
+
 	MonotonicRetransmits uint32
 	LastRetransmits      uint32
 
@@ -126,6 +172,18 @@ type ConnectionStats struct {
 	Pid   uint32
 	NetNS uint32
 
+	// Cookie identifies the connection for its whole lifetime, a connection reusing the tuple of a previous one
+	// gets another cookie. It is a random identifier given by the tracer when it first observes the connection
+	// on Linux, as SO_COOKIE can't be read from the kprobes, and the handle of the flow in the Windows driver.
+	// model.Connection has no field for it, it is added to the connections of the JSON payload.
+	Cookie uint64
+
+	// ProcessName and ProcessPath are the name and executable path of the process owning the connection,
+	// only set on Windows when the resolution of process names is enabled. model.Connection has no field for
+	// them, they are added to the connections of the JSON payload.
+	ProcessName string
+	ProcessPath string
+
 	SPort                  uint16
 	DPort                  uint16
 	Type                   ConnectionType
@@ -153,6 +211,48 @@ func (c ConnectionStats) String() string {
 	return ConnectionSummary(c, nil)
 }
 
+// FilterConnectionsSince keeps, in place, the connections first seen or last active after since. Long-lived
+// connections left unchanged are dropped, which keeps the payloads of the collectors polling frequently small
+// without them having to track the connections already reported.
+func FilterConnectionsSince(conns []ConnectionStats, since time.Time) []ConnectionStats {
+	filtered := conns[:0]
+	for _, c := range conns {
+		if c.FirstSeen.After(since) || c.LastActivity.After(since) {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
+
+// FilterConnectionsByState keeps, in place, the connections in one of the given states. The connections are
+// returned unchanged when no state is given.
+func FilterConnectionsByState(conns []ConnectionStats, states ...ConnectionState) []ConnectionStats {
+	if len(states) == 0 {
+		return conns
+	}
+
+	wanted := make(map[ConnectionState]bool, len(states))
+	for _, state := range states {
+		wanted[state] = true
+	}
+
+	filtered := conns[:0]
+	for _, c := range conns {
+		if wanted[c.state()] {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
+
+// state returns the state of a connection, it is closed if it was closed since the previous poll of the client
+func (c ConnectionStats) state() ConnectionState {
+	if c.LastTCPClosed > 0 {
+		return CLOSED
+	}
+	return ESTABLISHED
+}
+
 // ByteKey returns a unique key for this connection represented as a byte array
 // It's as following:
 //
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"fmt"
 	"net"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/DataDog/datadog-agent/pkg/process/util"
 
@@ -117,3 +119,60 @@ func TestConnStatsByteKey(t *testing.T) {
 		assert.NotEqual(t, keyA, keyB)
 	}
 }
+
+func TestFilterConnectionsSince(t *testing.T) {
+	since := time.Now()
+
+	conns := []ConnectionStats{
+		{Pid: 1, FirstSeen: since.Add(-time.Minute), LastActivity: since.Add(-time.Minute)},
+		{Pid: 2, FirstSeen: since.Add(time.Second), LastActivity: since.Add(time.Second)},
+		{Pid: 3, FirstSeen: since.Add(-time.Minute), LastActivity: since.Add(time.Second)},
+		{Pid: 4, FirstSeen: since.Add(-time.Minute), LastActivity: since},
+		// the activity counted since the previous poll of a client doesn't matter
+		{Pid: 5, FirstSeen: since.Add(-time.Minute), LastActivity: since.Add(-time.Second), LastSentBytes: 10},
+	}
+
+	var pids []uint32
+	for _, c := range FilterConnectionsSince(conns, since) {
+		pids = append(pids, c.Pid)
+	}
+	assert.Equal(t, []uint32{2, 3}, pids)
+}
+
+func TestFilterConnectionsByState(t *testing.T) {
+	conns := []ConnectionStats{
+		{Pid: 1, Type: TCP},
+		{Pid: 2, Type: TCP, LastTCPClosed: 1},
+		{Pid: 3, Type: UDP},
+	}
+
+	pids := func(conns []ConnectionStats) []uint32 {
+		var pids []uint32
+		for _, c := range conns {
+			pids = append(pids, c.Pid)
+		}
+		return pids
+	}
+
+	assert.Equal(t, []uint32{1, 2, 3}, pids(FilterConnectionsByState(append([]ConnectionStats{}, conns...))))
+	// UDP connections have no TCP state and are established
+	assert.Equal(t, []uint32{1, 3}, pids(FilterConnectionsByState(append([]ConnectionStats{}, conns...), ESTABLISHED)))
+	assert.Equal(t, []uint32{2}, pids(FilterConnectionsByState(append([]ConnectionStats{}, conns...), CLOSED)))
+	assert.Equal(t, []uint32{1, 2, 3}, pids(FilterConnectionsByState(append([]ConnectionStats{}, conns...), ESTABLISHED, CLOSED)))
+}
+
+func TestParseConnectionState(t *testing.T) {
+	for name, expected := range map[string]ConnectionState{
+		"established": ESTABLISHED,
+		"ESTABLISHED": ESTABLISHED,
+		"closed":      CLOSED,
+	} {
+		state, err := ParseConnectionState(name)
+		require.NoError(t, err)
+		assert.Equal(t, expected, state)
+		assert.Equal(t, strings.ToLower(name), state.String())
+	}
+
+	_, err := ParseConnectionState("listen")
+	assert.Error(t, err)
+}
@@ -133,12 +133,18 @@ func FlowToConnStat(flow *C.struct__perFlowData, enableMonotonicCounts bool) Con
 		Type:               connectionType,
 		Family:             family,
 		Direction:          connDirection(flow.flags),
+		Cookie:             uint64(flow.flowHandle),
 	}
 
 	if connectionType == TCP {
 		cs.MonotonicRetransmits = uint32(C.getTcp_retransmitCount(flow))
 		cs.RTT = uint32(C.getTcp_sRTT(flow))
 		cs.RTTVar = uint32(C.getTcp_rttVariance(flow))
+		// the closing of the connection is reported like the Linux tracer does, it tells apart the closed
+		// connections once they are merged with the active ones
+		if isFlowClosed(flow.flags) {
+			cs.MonotonicTCPClosed = 1
+		}
 	}
 	return cs
 }
@@ -0,0 +1,62 @@
+package network
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/DataDog/datadog-agent/pkg/process/util"
+)
+
+// SortConnections sorts the connections by source IP, source port, destination IP, destination port and
+// protocol so that the same set of connections always comes back in the same order. The family, direction,
+// PID and network namespace break the remaining ties.
+func SortConnections(conns []ConnectionStats) {
+	sort.Slice(conns, func(i, j int) bool {
+		a, b := &conns[i], &conns[j]
+		if c := compareAddresses(a.Source, b.Source); c != 0 {
+			return c < 0
+		}
+		if a.SPort != b.SPort {
+			return a.SPort < b.SPort
+		}
+		if c := compareAddresses(a.Dest, b.Dest); c != 0 {
+			return c < 0
+		}
+		if a.DPort != b.DPort {
+			return a.DPort < b.DPort
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.Family != b.Family {
+			return a.Family < b.Family
+		}
+		if a.Direction != b.Direction {
+			return a.Direction < b.Direction
+		}
+		if a.Pid != b.Pid {
+			return a.Pid < b.Pid
+		}
+		return a.NetNS < b.NetNS
+	})
+}
+
+// compareAddresses orders the IPv4 addresses before the IPv6 ones, and a missing address first
+func compareAddresses(a, b util.Address) int {
+	if a == nil || b == nil {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return -1
+		default:
+			return 1
+		}
+	}
+
+	ab, bb := a.Bytes(), b.Bytes()
+	if len(ab) != len(bb) {
+		return len(ab) - len(bb)
+	}
+	return bytes.Compare(ab, bb)
+}
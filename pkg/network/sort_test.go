@@ -0,0 +1,33 @@
+package network
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/process/util"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortConnections(t *testing.T) {
+	expected := []ConnectionStats{
+		{Source: util.AddressFromString("10.0.0.1"), SPort: 80, Dest: util.AddressFromString("10.0.0.2"), DPort: 5000, Type: TCP},
+		{Source: util.AddressFromString("10.0.0.1"), SPort: 80, Dest: util.AddressFromString("10.0.0.2"), DPort: 5000, Type: UDP},
+		{Source: util.AddressFromString("10.0.0.1"), SPort: 80, Dest: util.AddressFromString("10.0.0.2"), DPort: 6000, Type: TCP},
+		{Source: util.AddressFromString("10.0.0.1"), SPort: 80, Dest: util.AddressFromString("10.0.0.10"), DPort: 443, Type: TCP},
+		{Source: util.AddressFromString("10.0.0.1"), SPort: 8080, Dest: util.AddressFromString("10.0.0.2"), DPort: 443, Type: TCP, Pid: 1},
+		{Source: util.AddressFromString("10.0.0.1"), SPort: 8080, Dest: util.AddressFromString("10.0.0.2"), DPort: 443, Type: TCP, Pid: 2},
+		{Source: util.AddressFromString("10.0.0.3"), SPort: 22, Dest: util.AddressFromString("10.0.0.1"), DPort: 2222, Type: TCP},
+		{Source: util.AddressFromString("::1"), SPort: 22, Dest: util.AddressFromString("::1"), DPort: 2222, Type: TCP, Family: AFINET6},
+		{Source: util.AddressFromString("fe80::1"), SPort: 22, Dest: util.AddressFromString("::1"), DPort: 2222, Type: TCP, Family: AFINET6},
+	}
+
+	for i := 0; i < 10; i++ {
+		conns := make([]ConnectionStats, len(expected))
+		copy(conns, expected)
+		rand.Shuffle(len(conns), func(i, j int) { conns[i], conns[j] = conns[j], conns[i] })
+
+		SortConnections(conns)
+		assert.Equal(t, expected, conns)
+	}
+}
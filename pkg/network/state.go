@@ -36,6 +36,13 @@ type State interface {
 	// StoreClosedConnection stores a new closed connection
 	StoreClosedConnection(conn ConnectionStats)
 
+	// StoreExpiredConnection stores a connection removed after being idle for too long, it is only
+	// returned by ClosedConnections as its stats were already accounted by Connections
+	StoreExpiredConnection(conn ConnectionStats)
+
+	// ClosedConnections returns the connections closed since the last call for the given client, with their final stats
+	ClosedConnections(clientID string) []ConnectionStats
+
 	// RemoveClient stops tracking stateful data for a given client
 	RemoveClient(clientID string)
 
@@ -53,13 +60,15 @@ type State interface {
 }
 
 type telemetry struct {
-	unorderedConns     int64
-	closedConnDropped  int64
-	connDropped        int64
-	statsResets        int64
-	timeSyncCollisions int64
-	dnsStatsDropped    int64
-	dnsPidCollisions   int64
+	unorderedConns        int64
+	closedConnDropped     int64
+	closedConnExpired     int64
+	recentlyClosedDropped int64
+	connDropped           int64
+	statsResets           int64
+	timeSyncCollisions    int64
+	dnsStatsDropped       int64
+	dnsPidCollisions      int64
 }
 
 type stats struct {
@@ -70,10 +79,21 @@ type stats struct {
 	totalTCPClosed      uint32
 }
 
+// closedConnection is a connection closed since the last call to ClosedConnections
+type closedConnection struct {
+	conn     ConnectionStats
+	closedAt time.Time
+}
+
 type client struct {
 	lastFetch time.Time
 
+	// fetchesClosed is set once the client called ClosedConnections, the recently closed connections
+	// are only buffered for such clients
+	fetchesClosed bool
+
 	closedConnections map[string]ConnectionStats
+	recentlyClosed    []closedConnection
 	stats             map[string]*stats
 	dnsStats          map[dnsKey]dnsStats
 }
@@ -88,22 +108,25 @@ type networkState struct {
 	latestTimeEpoch uint64
 
 	// Network state configuration
-	clientExpiry   time.Duration
-	maxClosedConns int
-	maxClientStats int
-	maxDNSStats    int
+	clientExpiry        time.Duration
+	closedConnRetention time.Duration
+	maxClosedConns      int
+	maxClientStats      int
+	maxDNSStats         int
 }
 
-// NewState creates a new network state
-func NewState(clientExpiry time.Duration, maxClosedConns, maxClientStats int, maxDNSStats int) State {
+// NewState creates a new network state, the closed connections not fetched by a client through
+// ClosedConnections within closedConnRetention are dropped.
+func NewState(clientExpiry, closedConnRetention time.Duration, maxClosedConns, maxClientStats int, maxDNSStats int) State {
 	return &networkState{
-		clients:        map[string]*client{},
-		telemetry:      telemetry{},
-		clientExpiry:   clientExpiry,
-		maxClosedConns: maxClosedConns,
-		maxClientStats: maxClientStats,
-		maxDNSStats:    maxDNSStats,
-		buf:            &bytes.Buffer{},
+		clients:             map[string]*client{},
+		telemetry:           telemetry{},
+		clientExpiry:        clientExpiry,
+		closedConnRetention: closedConnRetention,
+		maxClosedConns:      maxClosedConns,
+		maxClientStats:      maxClientStats,
+		maxDNSStats:         maxDNSStats,
+		buf:                 &bytes.Buffer{},
 	}
 }
 
@@ -247,7 +270,10 @@ func (ns *networkState) StoreClosedConnection(conn ConnectionStats) {
 		return
 	}
 
+	now := time.Now()
 	for _, client := range ns.clients {
+		ns.storeRecentlyClosed(client, conn, now)
+
 		// If we've seen this closed connection already, lets combine the two
 		if prev, ok := client.closedConnections[string(key)]; ok {
 			// We received either the connections either out of order, or it's the same one we've already seen.
@@ -274,6 +300,52 @@ func (ns *networkState) StoreClosedConnection(conn ConnectionStats) {
 	}
 }
 
+// StoreExpiredConnection stores the given expired connection for every client
+func (ns *networkState) StoreExpiredConnection(conn ConnectionStats) {
+	ns.Lock()
+	defer ns.Unlock()
+
+	now := time.Now()
+	for _, client := range ns.clients {
+		ns.storeRecentlyClosed(client, conn, now)
+	}
+}
+
+func (ns *networkState) storeRecentlyClosed(client *client, conn ConnectionStats, now time.Time) {
+	if !client.fetchesClosed {
+		return
+	}
+	if len(client.recentlyClosed) >= ns.maxClosedConns {
+		ns.telemetry.recentlyClosedDropped++
+		return
+	}
+	client.recentlyClosed = append(client.recentlyClosed, closedConnection{conn: conn, closedAt: now})
+}
+
+// ClosedConnections returns the connections closed since the last call for the given client
+// If the client is not registered yet or never called ClosedConnections, we start buffering the closed
+// connections for it and return no connection
+func (ns *networkState) ClosedConnections(id string) []ConnectionStats {
+	ns.Lock()
+	defer ns.Unlock()
+
+	client, _ := ns.newClient(id)
+	client.lastFetch = time.Now()
+	if !client.fetchesClosed {
+		client.fetchesClosed = true
+		return nil
+	}
+
+	conns := make([]ConnectionStats, 0, len(client.recentlyClosed))
+	for _, c := range client.recentlyClosed {
+		conns = append(conns, c.conn)
+	}
+	client.recentlyClosed = nil
+
+	ns.determineConnectionIntraHost(conns)
+	return conns
+}
+
 // storeDNSStats stores latest DNS stats for all clients
 func (ns *networkState) storeDNSStats(stats map[dnsKey]dnsStats) {
 	for key, dns := range stats {
@@ -462,6 +534,8 @@ func (ns *networkState) RemoveClient(clientID string) {
 	delete(ns.clients, clientID)
 }
 
+// RemoveExpiredClients removes the expired clients, and the closed connections the remaining clients
+// didn't fetch within the retention period
 func (ns *networkState) RemoveExpiredClients(now time.Time) {
 	ns.Lock()
 	defer ns.Unlock()
@@ -470,6 +544,17 @@ func (ns *networkState) RemoveExpiredClients(now time.Time) {
 		if c.lastFetch.Add(ns.clientExpiry).Before(now) {
 			log.Debugf("expiring client: %s, had %d stats and %d closed connections", id, len(c.stats), len(c.closedConnections))
 			delete(ns.clients, id)
+			continue
+		}
+
+		// recently closed connections are ordered by closing time
+		i := 0
+		for i < len(c.recentlyClosed) && c.recentlyClosed[i].closedAt.Add(ns.closedConnRetention).Before(now) {
+			i++
+		}
+		if i > 0 {
+			ns.telemetry.closedConnExpired += int64(i)
+			c.recentlyClosed = c.recentlyClosed[i:]
 		}
 	}
 }
@@ -485,12 +570,14 @@ func (ns *networkState) RemoveConnections(keys []string) {
 	}
 
 	// Flush log line if any metric is non zero
-	if ns.telemetry.unorderedConns > 0 || ns.telemetry.statsResets > 0 || ns.telemetry.closedConnDropped > 0 || ns.telemetry.connDropped > 0 || ns.telemetry.timeSyncCollisions > 0 {
+	if ns.telemetry.unorderedConns > 0 || ns.telemetry.statsResets > 0 || ns.telemetry.closedConnDropped > 0 || ns.telemetry.closedConnExpired > 0 || ns.telemetry.recentlyClosedDropped > 0 || ns.telemetry.connDropped > 0 || ns.telemetry.timeSyncCollisions > 0 {
 		s := "state telemetry: "
 		s += " [%d unordered conns]"
 		s += " [%d stats stats_resets]"
 		s += " [%d connections dropped due to stats]"
 		s += " [%d closed connections dropped]"
+		s += " [%d closed connections expired]"
+		s += " [%d recently closed connections dropped]"
 		s += " [%d dns stats dropped]"
 		s += " [%d DNS pid collisions]"
 		s += " [%d time sync collisions]"
@@ -499,6 +586,8 @@ func (ns *networkState) RemoveConnections(keys []string) {
 			ns.telemetry.statsResets,
 			ns.telemetry.connDropped,
 			ns.telemetry.closedConnDropped,
+			ns.telemetry.closedConnExpired,
+			ns.telemetry.recentlyClosedDropped,
 			ns.telemetry.dnsStatsDropped,
 			ns.telemetry.dnsPidCollisions,
 			ns.telemetry.timeSyncCollisions)
@@ -517,6 +606,7 @@ func (ns *networkState) GetStats() map[string]interface{} {
 		clientInfo[id] = map[string]int{
 			"stats":              len(c.stats),
 			"closed_connections": len(c.closedConnections),
+			"recently_closed":    len(c.recentlyClosed),
 			"last_fetch":         int(c.lastFetch.Unix()),
 		}
 	}
@@ -524,13 +614,15 @@ func (ns *networkState) GetStats() map[string]interface{} {
 	return map[string]interface{}{
 		"clients": clientInfo,
 		"telemetry": map[string]int64{
-			"stats_resets":         ns.telemetry.statsResets,
-			"unordered_conns":      ns.telemetry.unorderedConns,
-			"closed_conn_dropped":  ns.telemetry.closedConnDropped,
-			"conn_dropped":         ns.telemetry.connDropped,
-			"time_sync_collisions": ns.telemetry.timeSyncCollisions,
-			"dns_stats_dropped":    ns.telemetry.dnsStatsDropped,
-			"dns_pid_collisions":   ns.telemetry.dnsPidCollisions,
+			"stats_resets":            ns.telemetry.statsResets,
+			"unordered_conns":         ns.telemetry.unorderedConns,
+			"closed_conn_dropped":     ns.telemetry.closedConnDropped,
+			"closed_conn_expired":     ns.telemetry.closedConnExpired,
+			"recently_closed_dropped": ns.telemetry.recentlyClosedDropped,
+			"conn_dropped":            ns.telemetry.connDropped,
+			"time_sync_collisions":    ns.telemetry.timeSyncCollisions,
+			"dns_stats_dropped":       ns.telemetry.dnsStatsDropped,
+			"dns_pid_collisions":      ns.telemetry.dnsPidCollisions,
 		},
 		"current_time":       time.Now().Unix(),
 		"latest_bpf_time_ns": ns.latestTimeEpoch,
@@ -216,10 +216,90 @@ func TestRetrieveClosedConnection(t *testing.T) {
 	})
 }
 
+func TestClosedConnections(t *testing.T) {
+	conn := ConnectionStats{
+		Pid:                123,
+		Type:               TCP,
+		Family:             AFINET,
+		Source:             util.AddressFromString("127.0.0.1"),
+		Dest:               util.AddressFromString("127.0.0.1"),
+		SPort:              31890,
+		DPort:              80,
+		MonotonicSentBytes: 12345,
+		MonotonicRecvBytes: 6789,
+		IntraHost:          true,
+	}
+
+	expired := conn
+	expired.Type = UDP
+	expired.DPort = 53
+
+	clientID := "1"
+
+	t.Run("without prior registration", func(t *testing.T) {
+		state := newDefaultState()
+		state.StoreClosedConnection(conn)
+
+		assert.Empty(t, state.ClosedConnections(clientID))
+	})
+
+	t.Run("with registration", func(t *testing.T) {
+		state := newDefaultState()
+		assert.Empty(t, state.ClosedConnections(clientID))
+
+		state.StoreClosedConnection(conn)
+		state.StoreExpiredConnection(expired)
+
+		assert.Equal(t, []ConnectionStats{conn, expired}, state.ClosedConnections(clientID))
+
+		// They are flushed by the call
+		assert.Empty(t, state.ClosedConnections(clientID))
+	})
+
+	t.Run("expired connections are not reported as active", func(t *testing.T) {
+		state := newDefaultState()
+		state.Connections(clientID, latestEpochTime(), nil, nil)
+		state.ClosedConnections(clientID)
+
+		state.StoreExpiredConnection(expired)
+
+		assert.Empty(t, state.Connections(clientID, latestEpochTime(), nil, nil))
+		assert.Equal(t, []ConnectionStats{expired}, state.ClosedConnections(clientID))
+	})
+
+	t.Run("retention", func(t *testing.T) {
+		state := NewState(time.Minute, 100*time.Millisecond, 50000, 75000, 75000)
+		state.ClosedConnections(clientID)
+
+		state.StoreClosedConnection(conn)
+		state.RemoveExpiredClients(time.Now().Add(150 * time.Millisecond))
+
+		assert.Empty(t, state.ClosedConnections(clientID))
+		assert.Equal(t, int64(1), state.(*networkState).telemetry.closedConnExpired)
+	})
+
+	t.Run("only buffered for the clients fetching them", func(t *testing.T) {
+		state := NewState(time.Minute, time.Minute, 1, 75000, 75000)
+		state.Connections(clientID, latestEpochTime(), nil, nil)
+
+		state.StoreClosedConnection(conn)
+		state.StoreExpiredConnection(expired)
+		assert.Empty(t, state.(*networkState).clients[clientID].recentlyClosed)
+		assert.Empty(t, state.ClosedConnections(clientID))
+
+		// the buffer is full after the first connection
+		state.StoreClosedConnection(conn)
+		state.StoreExpiredConnection(expired)
+		assert.Equal(t, []ConnectionStats{conn}, state.ClosedConnections(clientID))
+		assert.Equal(t, int64(1), state.(*networkState).telemetry.recentlyClosedDropped)
+		assert.Equal(t, int64(0), state.(*networkState).telemetry.closedConnDropped)
+	})
+}
+
 func TestCleanupClient(t *testing.T) {
 	clientID := "1"
 
-	state := NewState(100*time.Millisecond, 50000, 75000, 75000)
+	state := NewState(100*time.Millisecond, 2*time.Minute, 50000, 75000, 75000)
 	clients := state.(*networkState).getClients()
 	assert.Equal(t, 0, len(clients))
 
@@ -1246,5 +1326,5 @@ func latestEpochTime() uint64 {
 
 func newDefaultState() State {
 	// Using values from ebpf.NewDefaultConfig()
-	return NewState(2*time.Minute, 50000, 75000, 75000)
+	return NewState(2*time.Minute, 2*time.Minute, 50000, 75000, 75000)
 }
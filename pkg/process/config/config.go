@@ -50,6 +50,9 @@ type WindowsConfig struct {
 
 	// DriverBufferSize (bytes) determines the size of the buffer we pass to the driver when reading flows
 	DriverBufferSize int
+
+	// ResolveProcessNames determines if the PID of the connections is resolved to a process name and executable path
+	ResolveProcessNames bool
 }
 
 // AgentConfig is the global config for the process-agent. This information
@@ -102,11 +105,17 @@ type AgentConfig struct {
 	MaxConnectionsStateBuffered    int
 	OffsetGuessThreshold           uint64
 	EnableTracepoints              bool
+	ResolveNetNS                   bool
+	SortConnections                bool
 
 	// DNS stats configuration
 	CollectDNSStats bool
 	DNSTimeout      time.Duration
 
+	// Reverse DNS cache configuration
+	DNSCacheSize int
+	DNSCacheTTL  time.Duration
+
 	// Orchestrator collection configuration
 	OrchestrationCollectionEnabled bool
 	KubeClusterName                string
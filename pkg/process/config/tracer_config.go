@@ -52,6 +52,14 @@ func SysProbeConfigFromConfig(cfg *AgentConfig) *ebpf.Config {
 		tracerConfig.DNSTimeout = cfg.DNSTimeout
 	}
 
+	if size := cfg.DNSCacheSize; size > 0 {
+		tracerConfig.DNSCacheSize = size
+	}
+
+	if ttl := cfg.DNSCacheTTL; ttl > 0 {
+		tracerConfig.DNSCacheTTL = ttl
+	}
+
 	tracerConfig.MaxTrackedConnections = cfg.MaxTrackedConnections
 	tracerConfig.ProcRoot = util.GetProcRoot()
 	tracerConfig.BPFDebug = cfg.SysProbeBPFDebug
@@ -80,8 +88,12 @@ func SysProbeConfigFromConfig(cfg *AgentConfig) *ebpf.Config {
 		tracerConfig.EnableTracepoints = true
 	}
 
+	tracerConfig.ResolveNetNS = cfg.ResolveNetNS
+	tracerConfig.SortConnections = cfg.SortConnections
+
 	tracerConfig.EnableMonotonicCount = cfg.Windows.EnableMonotonicCount
 	tracerConfig.DriverBufferSize = cfg.Windows.DriverBufferSize
+	tracerConfig.ResolveProcessNames = cfg.Windows.ResolveProcessNames
 
 	return tracerConfig
 }
@@ -50,6 +50,12 @@ func (a *AgentConfig) loadSysProbeYamlConfig(path string) error {
 	if config.Datadog.IsSet(key(spNS, "dns_timeout_in_s")) {
 		a.DNSTimeout = config.Datadog.GetDuration(key(spNS, "dns_timeout_in_s")) * time.Second
 	}
+	if config.Datadog.IsSet(key(spNS, "dns_cache_size")) {
+		a.DNSCacheSize = config.Datadog.GetInt(key(spNS, "dns_cache_size"))
+	}
+	if config.Datadog.IsSet(key(spNS, "dns_cache_ttl_in_s")) {
+		a.DNSCacheTTL = config.Datadog.GetDuration(key(spNS, "dns_cache_ttl_in_s")) * time.Second
+	}
 
 	if config.Datadog.GetBool(key(spNS, "enabled")) {
 		a.EnabledChecks = append(a.EnabledChecks, "connections")
@@ -161,12 +167,17 @@ func (a *AgentConfig) loadSysProbeYamlConfig(path string) error {
 		a.EnableTracepoints = config.Datadog.GetBool(key(spNS, "enable_tracepoints"))
 	}
 
+	a.ResolveNetNS = config.Datadog.GetBool(key(spNS, "resolve_network_namespaces"))
+	a.SortConnections = config.Datadog.GetBool(key(spNS, "sort_connections"))
+
 	a.Windows.EnableMonotonicCount = config.Datadog.GetBool(key(spNS, "windows", "enable_monotonic_count"))
 
 	if driverBufferSize := config.Datadog.GetInt(key(spNS, "windows", "driver_buffer_size")); driverBufferSize > 0 {
 		a.Windows.DriverBufferSize = driverBufferSize
 	}
 
+	a.Windows.ResolveProcessNames = config.Datadog.GetBool(key(spNS, "windows", "resolve_process_names"))
+
 	return nil
 }
 
@@ -9,6 +9,7 @@ import (
 	"io/ioutil"
 	"net"
 	"net/http"
+	"net/url"
 	"sync"
 	"time"
 
@@ -81,7 +82,18 @@ func GetRemoteSystemProbeUtil() (*RemoteSysProbeUtil, error) {
 
 // GetConnections returns a set of active network connections, retrieved from the system probe service
 func (r *RemoteSysProbeUtil) GetConnections(clientID string) (*model.Connections, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s?client_id=%s", connectionsURL, clientID), nil)
+	return r.GetConnectionsSince(clientID, time.Time{})
+}
+
+// GetConnectionsSince returns the active network connections first seen or last active after since, retrieved
+// from the system probe service. All the connections are returned if since is zero.
+func (r *RemoteSysProbeUtil) GetConnectionsSince(clientID string, since time.Time) (*model.Connections, error) {
+	query := url.Values{"client_id": {clientID}}
+	if !since.IsZero() {
+		query.Set("since", since.Format(time.RFC3339Nano))
+	}
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s?%s", connectionsURL, query.Encode()), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +122,17 @@ func (r *RemoteSysProbeUtil) GetConnections(clientID string) (*model.Connections
 
 // GetStats returns the expvar stats of the system probe
 func (r *RemoteSysProbeUtil) GetStats() (map[string]interface{}, error) {
-	req, err := http.NewRequest("GET", statsURL, nil)
+	return r.getJSONMap(statsURL)
+}
+
+// GetTracerInfo returns everything the network tracer of the system probe knows, for troubleshooting
+func (r *RemoteSysProbeUtil) GetTracerInfo() (map[string]interface{}, error) {
+	return r.getJSONMap(tracerInfoURL)
+}
+
+// getJSONMap queries an endpoint of the system probe returning a JSON object
+func (r *RemoteSysProbeUtil) getJSONMap(url string) (map[string]interface{}, error) {
+	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -119,7 +141,7 @@ func (r *RemoteSysProbeUtil) GetStats() (map[string]interface{}, error) {
 	if err != nil {
 		return nil, err
 	} else if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("conn request failed: Path %s, url: %s, status code: %d", r.path, statsURL, resp.StatusCode)
+		return nil, fmt.Errorf("conn request failed: Path %s, url: %s, status code: %d", r.path, url, resp.StatusCode)
 	}
 
 	body, err := ioutil.ReadAll(resp.Body)
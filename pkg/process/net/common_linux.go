@@ -11,6 +11,7 @@ const (
 	statusURL      = "http://unix/status"
 	connectionsURL = "http://unix/connections"
 	statsURL       = "http://unix/debug/stats"
+	tracerInfoURL  = "http://unix/debug/net_info"
 	netType        = "unix"
 )
 
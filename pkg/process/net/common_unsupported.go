@@ -3,6 +3,8 @@
 package net
 
 import (
+	"time"
+
 	model "github.com/DataDog/agent-payload/process"
 	"github.com/DataDog/datadog-agent/pkg/ebpf"
 )
@@ -30,7 +32,17 @@ func (r *RemoteSysProbeUtil) GetConnections(clientID string) (*model.Connections
 	return nil, ebpf.ErrNotImplemented
 }
 
+// GetConnectionsSince is not supported
+func (r *RemoteSysProbeUtil) GetConnectionsSince(clientID string, since time.Time) (*model.Connections, error) {
+	return nil, ebpf.ErrNotImplemented
+}
+
 // GetStats is not supported
 func (r *RemoteSysProbeUtil) GetStats() (map[string]interface{}, error) {
 	return nil, ebpf.ErrNotImplemented
 }
+
+// GetTracerInfo is not supported
+func (r *RemoteSysProbeUtil) GetTracerInfo() (map[string]interface{}, error) {
+	return nil, ebpf.ErrNotImplemented
+}
@@ -8,6 +8,7 @@ const (
 	statusURL      = "http://localhost:3333/status"
 	connectionsURL = "http://localhost:3333/connections"
 	statsURL       = "http://localhost:3333/debug/stats"
+	tracerInfoURL  = "http://localhost:3333/debug/net_info"
 	netType        = "tcp"
 )
 
@@ -28,6 +28,14 @@ type Config struct {
 	EnableDiscarders    bool
 	SocketPath          string
 	SyscallMonitor      bool
+	ExecArgsEnabled     bool
+	ExecArgsMaxCount    int
+	ExecArgsMaxLength   int
+	IoctlRequests       []string
+	MapPinning          bool
+	MapPinningPath      string
+	MapPinningReuse     bool
+	RunPath             string
 }
 
 // NewConfig returns a new Config object
@@ -41,6 +49,14 @@ func NewConfig(cfg *config.AgentConfig) (*Config, error) {
 		SocketPath:          aconfig.Datadog.GetString("runtime_security_config.socket"),
 		SyscallMonitor:      aconfig.Datadog.GetBool("runtime_security_config.syscall_monitor.enabled"),
 		PoliciesDir:         aconfig.Datadog.GetString("runtime_security_config.policies.dir"),
+		ExecArgsEnabled:     aconfig.Datadog.GetBool("runtime_security_config.exec_args.enabled"),
+		ExecArgsMaxCount:    aconfig.Datadog.GetInt("runtime_security_config.exec_args.max_count"),
+		ExecArgsMaxLength:   aconfig.Datadog.GetInt("runtime_security_config.exec_args.max_length"),
+		IoctlRequests:       aconfig.Datadog.GetStringSlice("runtime_security_config.ioctl.requests"),
+		MapPinning:          aconfig.Datadog.GetBool("runtime_security_config.map_pinning.enabled"),
+		MapPinningPath:      aconfig.Datadog.GetString("runtime_security_config.map_pinning.path"),
+		MapPinningReuse:     aconfig.Datadog.GetBool("runtime_security_config.map_pinning.reuse"),
+		RunPath:             aconfig.Datadog.GetString("runtime_security_config.run_path"),
 	}
 
 	if cfg != nil {
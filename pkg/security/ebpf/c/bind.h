@@ -0,0 +1,84 @@
+#ifndef _BIND_H_
+#define _BIND_H_
+
+#include <linux/in.h>
+#include <linux/in6.h>
+#include <linux/socket.h>
+
+#include "syscalls.h"
+
+struct bind_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    u64 addr[2];
+    u16 family;
+    u16 port;
+    u32 padding;
+};
+
+SYSCALL_KPROBE(bind) {
+    struct syscall_cache_t syscall = {
+        .type = EVENT_BIND,
+    };
+
+    cache_syscall(&syscall);
+    return 0;
+}
+
+/*
+  the address is read once copied from user space, the port and the address are kept in network byte order
+*/
+SEC("kprobe/security_socket_bind")
+int kprobe__security_socket_bind(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = peek_syscall();
+    if (!syscall || syscall->type != EVENT_BIND)
+        return 0;
+
+    struct sockaddr *address = (struct sockaddr *)PT_REGS_PARM2(ctx);
+    bpf_probe_read(&syscall->bind.family, sizeof(syscall->bind.family), &address->sa_family);
+
+    if (syscall->bind.family == AF_INET) {
+        struct sockaddr_in *addr_in = (struct sockaddr_in *)address;
+        bpf_probe_read(&syscall->bind.port, sizeof(syscall->bind.port), &addr_in->sin_port);
+        bpf_probe_read(&syscall->bind.addr, sizeof(addr_in->sin_addr), &addr_in->sin_addr);
+    } else if (syscall->bind.family == AF_INET6) {
+        struct sockaddr_in6 *addr_in6 = (struct sockaddr_in6 *)address;
+        bpf_probe_read(&syscall->bind.port, sizeof(syscall->bind.port), &addr_in6->sin6_port);
+        bpf_probe_read(&syscall->bind.addr, sizeof(addr_in6->sin6_addr), &addr_in6->sin6_addr);
+    }
+
+    return 0;
+}
+
+SYSCALL_KRETPROBE(bind) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    struct bind_event_t event = {
+        .event.type = EVENT_BIND,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .addr[0] = syscall->bind.addr[0],
+        .addr[1] = syscall->bind.addr[1],
+        .family = syscall->bind.family,
+        .port = syscall->bind.port,
+    };
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+#endif
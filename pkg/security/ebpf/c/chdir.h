@@ -0,0 +1,88 @@
+#ifndef _CHDIR_H_
+#define _CHDIR_H_
+
+#include "syscalls.h"
+
+struct chdir_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    struct file_t file;
+};
+
+int __attribute__((always_inline)) trace__sys_chdir() {
+    struct syscall_cache_t syscall = {
+        .type = EVENT_CHDIR,
+    };
+
+    cache_syscall(&syscall);
+    return 0;
+}
+
+SYSCALL_KPROBE(chdir) {
+    return trace__sys_chdir();
+}
+
+SYSCALL_KPROBE(fchdir) {
+    return trace__sys_chdir();
+}
+
+SEC("kprobe/set_fs_pwd")
+int kprobe__set_fs_pwd(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = peek_syscall();
+    if (!syscall || syscall->type != EVENT_CHDIR)
+        return 0;
+
+    struct path *path = (struct path *)PT_REGS_PARM2(ctx);
+    syscall->chdir.dentry = get_path_dentry(path);
+    syscall->chdir.path_key = get_key(syscall->chdir.dentry, path);
+
+    return 0;
+}
+
+int __attribute__((always_inline)) trace__sys_chdir_ret(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    // the working directory wasn't changed, nothing to resolve
+    if (!syscall->chdir.dentry)
+        return 0;
+
+    struct chdir_event_t event = {
+        .event.type = EVENT_CHDIR,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .file = {
+            .mount_id = syscall->chdir.path_key.mount_id,
+            .inode = syscall->chdir.path_key.ino,
+            .overlay_numlower = get_overlay_numlower(syscall->chdir.dentry),
+        },
+    };
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    resolve_dentry(syscall->chdir.dentry, syscall->chdir.path_key, NULL);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+SYSCALL_KRETPROBE(chdir) {
+    return trace__sys_chdir_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(fchdir) {
+    return trace__sys_chdir_ret(ctx);
+}
+
+#endif
@@ -0,0 +1,89 @@
+#ifndef _CLONE_H_
+#define _CLONE_H_
+
+#include "syscalls.h"
+
+struct clone_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    u64 flags;
+};
+
+int __attribute__((always_inline)) trace__sys_clone(u64 flags) {
+    struct syscall_cache_t syscall = {
+        .type = EVENT_CLONE,
+        .clone = {
+            .flags = flags,
+        }
+    };
+
+    cache_syscall(&syscall);
+    return 0;
+}
+
+SYSCALL_KPROBE(clone) {
+    unsigned long flags;
+#if USE_SYSCALL_WRAPPER
+    ctx = (struct pt_regs *) PT_REGS_PARM1(ctx);
+    bpf_probe_read(&flags, sizeof(flags), &PT_REGS_PARM1(ctx));
+#else
+    flags = (unsigned long) PT_REGS_PARM1(ctx);
+#endif
+    return trace__sys_clone(flags);
+}
+
+SYSCALL_KPROBE(clone3) {
+    void *uargs;
+#if USE_SYSCALL_WRAPPER
+    ctx = (struct pt_regs *) PT_REGS_PARM1(ctx);
+    bpf_probe_read(&uargs, sizeof(uargs), &PT_REGS_PARM1(ctx));
+#else
+    uargs = (void *) PT_REGS_PARM1(ctx);
+#endif
+
+    // the flags are the first field of struct clone_args
+    u64 flags = 0;
+    bpf_probe_read(&flags, sizeof(flags), uargs);
+
+    return trace__sys_clone(flags);
+}
+
+// the child task starts its execution in ret_from_fork, only the parent returns from the syscall and
+// reports the pid of the new task
+int __attribute__((always_inline)) trace__sys_clone_ret(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    struct clone_event_t event = {
+        .event.type = EVENT_CLONE,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .flags = syscall->clone.flags,
+    };
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+SYSCALL_KRETPROBE(clone) {
+    return trace__sys_clone_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(clone3) {
+    return trace__sys_clone_ret(ctx);
+}
+
+#endif
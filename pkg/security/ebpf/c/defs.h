@@ -39,7 +39,28 @@ enum event_type
     EVENT_UTIME,
     EVENT_MOUNT,
     EVENT_UMOUNT,
+    EVENT_GETXATTR,
+    EVENT_CHDIR,
+    EVENT_MEMFD,
+    EVENT_FALLOCATE,
+    EVENT_DUP,
+    EVENT_SETRLIMIT,
+    EVENT_SETNS,
+    EVENT_QUOTACTL,
+    EVENT_PIPE,
+    EVENT_IOCTL,
+    EVENT_UMASK,
+    EVENT_STAT,
+    EVENT_WRITE,
+    EVENT_CLONE,
+    EVENT_SENDFILE,
+    EVENT_KEYCTL,
+    EVENT_PRCTL,
+    EVENT_LOAD_MODULE,
+    EVENT_BIND,
     EVENT_EXEC,
+    EVENT_FORK,
+    EVENT_EXIT,
 };
 
 struct event_t {
@@ -72,6 +93,7 @@ struct process_context_t {
     u32 uid;
     u32 gid;
     struct file_t executable;
+    u64 exec_timestamp;
 };
 
 struct container_context_t {
@@ -81,6 +103,7 @@ struct container_context_t {
 struct proc_cache_t {
     struct file_t executable;
     char container_id[CONTAINER_ID_LEN];
+    u64 exec_timestamp;
 };
 
 struct bpf_map_def SEC("maps/events") events = {
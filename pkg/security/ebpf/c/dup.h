@@ -0,0 +1,104 @@
+#ifndef _DUP_H_
+#define _DUP_H_
+
+#include "syscalls.h"
+
+struct dup_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    s32 old_fd;
+    s32 new_fd;
+};
+
+int __attribute__((always_inline)) trace__sys_dup(int old_fd, int new_fd) {
+    struct syscall_cache_t syscall = {
+        .type = EVENT_DUP,
+        .dup = {
+            .old_fd = old_fd,
+            .new_fd = new_fd,
+        }
+    };
+
+    cache_syscall(&syscall);
+    return 0;
+}
+
+SYSCALL_KPROBE(dup) {
+    int old_fd;
+#if USE_SYSCALL_WRAPPER
+    ctx = (struct pt_regs *) PT_REGS_PARM1(ctx);
+    bpf_probe_read(&old_fd, sizeof(old_fd), &PT_REGS_PARM1(ctx));
+#else
+    old_fd = (int) PT_REGS_PARM1(ctx);
+#endif
+    // the new file descriptor is the return value of the syscall
+    return trace__sys_dup(old_fd, -1);
+}
+
+#if USE_SYSCALL_WRAPPER
+#define DUP_KPROBE(syscall)                                             \
+    SYSCALL_KPROBE(syscall) {                                           \
+        int old_fd, new_fd;                                             \
+        ctx = (struct pt_regs *) PT_REGS_PARM1(ctx);                    \
+        bpf_probe_read(&old_fd, sizeof(old_fd), &PT_REGS_PARM1(ctx));   \
+        bpf_probe_read(&new_fd, sizeof(new_fd), &PT_REGS_PARM2(ctx));   \
+        return trace__sys_dup(old_fd, new_fd);                          \
+    }
+#else
+#define DUP_KPROBE(syscall)                                             \
+    SYSCALL_KPROBE(syscall) {                                           \
+        return trace__sys_dup((int) PT_REGS_PARM1(ctx),                 \
+                              (int) PT_REGS_PARM2(ctx));                \
+    }
+#endif
+
+DUP_KPROBE(dup2)
+DUP_KPROBE(dup3)
+
+int __attribute__((always_inline)) trace__sys_dup_ret(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    struct dup_event_t event = {
+        .event.type = EVENT_DUP,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .old_fd = syscall->dup.old_fd,
+        .new_fd = syscall->dup.new_fd,
+    };
+
+    // dup returns the lowest-numbered file descriptor available
+    if (event.new_fd < 0 && retval >= 0) {
+        event.new_fd = retval;
+    }
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+SYSCALL_KRETPROBE(dup) {
+    return trace__sys_dup_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(dup2) {
+    return trace__sys_dup_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(dup3) {
+    return trace__sys_dup_ret(ctx);
+}
+
+#endif
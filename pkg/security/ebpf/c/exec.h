@@ -18,8 +18,26 @@ struct _tracepoint_sched_process_fork
     pid_t child_pid;
 };
 
+// process_event_t notifies user space of the execution and the exit of a process so that its process cache
+// entry, keyed on the pid and the exec timestamp, is resolved and dropped
+struct process_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+};
+
+// fork_event_t notifies user space of the creation of a process, its process cache entry is inherited from the parent
+struct fork_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    u32 child_pid;
+    u32 padding;
+};
+
 void __attribute__((always_inline)) copy_proc_cache(struct proc_cache_t *dst, struct proc_cache_t *src) {
     dst->executable = src->executable;
+    dst->exec_timestamp = src->exec_timestamp;
     copy_container_id(dst->container_id, src->container_id);
     return;
 }
@@ -72,6 +90,35 @@ struct proc_cache_t * __attribute__((always_inline)) get_pid_cache(u32 tgid) {
     return entry;
 }
 
+// the process context of the exec, fork and exit events is filled from the process cache
+#include "process.h"
+
+int __attribute__((always_inline)) trace__sys_execveat_ret(struct pt_regs *ctx) {
+    // the process only runs the new executable once the syscall succeeded
+    int retval = PT_REGS_RC(ctx);
+    if (retval < 0)
+        return 0;
+
+    struct process_event_t event = {
+        .event.type = EVENT_EXEC,
+    };
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+SYSCALL_KRETPROBE(execve) {
+    return trace__sys_execveat_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(execveat) {
+    return trace__sys_execveat_ret(ctx);
+}
+
 int __attribute__((always_inline)) vfs_handle_exec_event(struct pt_regs *ctx, struct syscall_cache_t *syscall) {
     struct path *path = (struct path *)PT_REGS_PARM1(ctx);
 
@@ -83,6 +130,7 @@ int __attribute__((always_inline)) vfs_handle_exec_event(struct pt_regs *ctx, st
             .mount_id = get_path_mount_id(path),
         },
         .container_id = {},
+        .exec_timestamp = bpf_ktime_get_ns(),
     };
 
     // select parent cache entry
@@ -125,14 +173,50 @@ int sched_process_fork(struct _tracepoint_sched_process_fork *args)
     return 0;
 }
 
+SEC("kprobe/wake_up_new_task")
+int kprobe_wake_up_new_task(struct pt_regs *ctx) {
+    struct task_struct *task = (struct task_struct *)PT_REGS_PARM1(ctx);
+
+    u32 pid = 0;
+    u32 tgid = 0;
+    bpf_probe_read(&pid, sizeof(pid), &task->pid);
+    bpf_probe_read(&tgid, sizeof(tgid), &task->tgid);
+
+    // threads share the process cache entry of their process
+    if (pid != tgid)
+        return 0;
+
+    // the new task is woken up by its parent
+    struct fork_event_t event = {
+        .event.type = EVENT_FORK,
+        .child_pid = tgid,
+    };
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
 SEC("kprobe/do_exit")
 int kprobe_do_exit(struct pt_regs *ctx) {
     u64 pid_tgid = bpf_get_current_pid_tgid();
     u32 tgid = pid_tgid >> 32;
     u32 pid = pid_tgid;
 
-    // Delete pid <-> cookie mapping
     if (tgid == pid) {
+        struct process_event_t event = {
+            .event.type = EVENT_EXIT,
+        };
+
+        struct proc_cache_t *entry = fill_process_data(&event.process);
+        fill_container_data(entry, &event.container);
+
+        send_event(ctx, event);
+
+        // Delete pid <-> cookie mapping
         bpf_map_delete_elem(&pid_cookie, &tgid);
     }
     // (do not delete cookie <-> proc_cache entry since it can be used by a parent process)
@@ -0,0 +1,89 @@
+#ifndef _FALLOCATE_H_
+#define _FALLOCATE_H_
+
+#include "syscalls.h"
+
+struct fallocate_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    struct file_t file;
+    u32 mode;
+    u32 padding;
+    u64 length;
+};
+
+SYSCALL_KPROBE(fallocate) {
+    struct syscall_cache_t syscall = {
+        .type = EVENT_FALLOCATE,
+    };
+
+    cache_syscall(&syscall);
+    return 0;
+}
+
+SEC("kprobe/vfs_fallocate")
+int kprobe__vfs_fallocate(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = peek_syscall();
+    if (!syscall || syscall->type != EVENT_FALLOCATE)
+        return 0;
+
+    if (syscall->fallocate.dentry)
+        return 0;
+
+    struct file *file = (struct file *)PT_REGS_PARM1(ctx);
+    syscall->fallocate.dentry = get_file_dentry(file);
+    syscall->fallocate.path_key.ino = get_dentry_ino(syscall->fallocate.dentry);
+    syscall->fallocate.mode = (int)PT_REGS_PARM2(ctx);
+    syscall->fallocate.length = (u64)PT_REGS_PARM4(ctx);
+
+    // the mount id is usually resolved by kprobe/mnt_want_write, fall back to the mount of the file otherwise
+    if (!syscall->fallocate.path_key.mount_id) {
+        struct vfsmount *mnt;
+        bpf_probe_read(&mnt, sizeof(mnt), &file->f_path.mnt);
+        syscall->fallocate.path_key.mount_id = get_vfsmount_mount_id(mnt);
+    }
+
+    return 0;
+}
+
+SYSCALL_KRETPROBE(fallocate) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    // the fd couldn't be resolved to a file
+    if (!syscall->fallocate.dentry)
+        return 0;
+
+    struct fallocate_event_t event = {
+        .event.type = EVENT_FALLOCATE,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .file = {
+            .mount_id = syscall->fallocate.path_key.mount_id,
+            .inode = syscall->fallocate.path_key.ino,
+            .overlay_numlower = get_overlay_numlower(syscall->fallocate.dentry),
+        },
+        .mode = syscall->fallocate.mode,
+        .length = syscall->fallocate.length,
+    };
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    resolve_dentry(syscall->fallocate.dentry, syscall->fallocate.path_key, NULL);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+#endif
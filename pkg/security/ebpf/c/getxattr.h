@@ -0,0 +1,150 @@
+#ifndef _GETXATTR_H_
+#define _GETXATTR_H_
+
+#include "syscalls.h"
+
+#define XATTR_NAME_LEN 64
+
+struct getxattr_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    struct file_t file;
+    char name[XATTR_NAME_LEN];
+};
+
+/*
+  the listxattr syscalls don't target a specific attribute, a NULL name is cached for them
+*/
+
+int __attribute__((always_inline)) trace__sys_getxattr(const char *name) {
+    struct syscall_cache_t syscall = {
+        .type = EVENT_GETXATTR,
+        .xattr = {
+            .name = name,
+        }
+    };
+
+    cache_syscall(&syscall);
+    return 0;
+}
+
+#if USE_SYSCALL_WRAPPER
+#define GETXATTR_KPROBE(syscall)                                        \
+    SYSCALL_KPROBE(syscall) {                                           \
+        const char *name;                                               \
+        ctx = (struct pt_regs *) PT_REGS_PARM1(ctx);                    \
+        bpf_probe_read(&name, sizeof(name), &PT_REGS_PARM2(ctx));       \
+        return trace__sys_getxattr(name);                               \
+    }
+#else
+#define GETXATTR_KPROBE(syscall)                                        \
+    SYSCALL_KPROBE(syscall) {                                           \
+        return trace__sys_getxattr((const char *) PT_REGS_PARM2(ctx));  \
+    }
+#endif
+
+GETXATTR_KPROBE(getxattr)
+GETXATTR_KPROBE(lgetxattr)
+GETXATTR_KPROBE(fgetxattr)
+
+SYSCALL_KPROBE(listxattr) {
+    return trace__sys_getxattr(NULL);
+}
+
+SYSCALL_KPROBE(llistxattr) {
+    return trace__sys_getxattr(NULL);
+}
+
+SYSCALL_KPROBE(flistxattr) {
+    return trace__sys_getxattr(NULL);
+}
+
+int __attribute__((always_inline)) trace__vfs_xattr(struct dentry *dentry) {
+    struct syscall_cache_t *syscall = peek_syscall();
+    if (!syscall || syscall->type != EVENT_GETXATTR)
+        return 0;
+
+    if (syscall->xattr.dentry)
+        return 0;
+
+    syscall->xattr.dentry = dentry;
+    syscall->xattr.path_key.ino = get_dentry_ino(dentry);
+    // the vfs functions only provide a dentry, the mount id is resolved from its super block
+    syscall->xattr.path_key.mount_id = get_inode_mount_id(get_dentry_inode(dentry));
+
+    return 0;
+}
+
+SEC("kprobe/vfs_getxattr")
+int kprobe__vfs_getxattr(struct pt_regs *ctx) {
+    return trace__vfs_xattr((struct dentry *)PT_REGS_PARM1(ctx));
+}
+
+SEC("kprobe/vfs_listxattr")
+int kprobe__vfs_listxattr(struct pt_regs *ctx) {
+    return trace__vfs_xattr((struct dentry *)PT_REGS_PARM1(ctx));
+}
+
+int __attribute__((always_inline)) trace__sys_getxattr_ret(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    struct getxattr_event_t event = {
+        .event.type = EVENT_GETXATTR,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .file = {
+            .mount_id = syscall->xattr.path_key.mount_id,
+            .inode = syscall->xattr.path_key.ino,
+            .overlay_numlower = get_overlay_numlower(syscall->xattr.dentry),
+        },
+    };
+
+    if (syscall->xattr.name != NULL) {
+        bpf_probe_read_str(&event.name, XATTR_NAME_LEN, (void *)syscall->xattr.name);
+    }
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    resolve_dentry(syscall->xattr.dentry, syscall->xattr.path_key, NULL);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+SYSCALL_KRETPROBE(getxattr) {
+    return trace__sys_getxattr_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(lgetxattr) {
+    return trace__sys_getxattr_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(fgetxattr) {
+    return trace__sys_getxattr_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(listxattr) {
+    return trace__sys_getxattr_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(llistxattr) {
+    return trace__sys_getxattr_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(flistxattr) {
+    return trace__sys_getxattr_ret(ctx);
+}
+
+#endif
@@ -0,0 +1,80 @@
+#ifndef _IOCTL_H_
+#define _IOCTL_H_
+
+#include "syscalls.h"
+
+// ioctl_requests holds the request codes to report, ioctl is far too frequent to report every call
+struct bpf_map_def SEC("maps/ioctl_requests") ioctl_requests = {
+    .type = BPF_MAP_TYPE_HASH,
+    .key_size = sizeof(u32),
+    .value_size = sizeof(u8),
+    .max_entries = 64,
+    .pinning = 0,
+    .namespace = "",
+};
+
+struct ioctl_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    s32 fd;
+    u32 request;
+};
+
+SYSCALL_KPROBE(ioctl) {
+    int fd;
+    u32 request;
+#if USE_SYSCALL_WRAPPER
+    ctx = (struct pt_regs *) PT_REGS_PARM1(ctx);
+    bpf_probe_read(&fd, sizeof(fd), &PT_REGS_PARM1(ctx));
+    bpf_probe_read(&request, sizeof(request), &PT_REGS_PARM2(ctx));
+#else
+    fd = (int) PT_REGS_PARM1(ctx);
+    request = (u32) PT_REGS_PARM2(ctx);
+#endif
+
+    // filter in kernel the request codes that aren't configured
+    if (bpf_map_lookup_elem(&ioctl_requests, &request) == NULL)
+        return 0;
+
+    struct syscall_cache_t syscall = {
+        .type = EVENT_IOCTL,
+        .ioctl = {
+            .fd = fd,
+            .request = request,
+        }
+    };
+
+    cache_syscall(&syscall);
+    return 0;
+}
+
+SYSCALL_KRETPROBE(ioctl) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    struct ioctl_event_t event = {
+        .event.type = EVENT_IOCTL,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .fd = syscall->ioctl.fd,
+        .request = syscall->ioctl.request,
+    };
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+#endif
@@ -0,0 +1,138 @@
+#ifndef _KEYCTL_H_
+#define _KEYCTL_H_
+
+#include "syscalls.h"
+
+#define KEY_TYPE_LEN 32
+#define KEY_DESCRIPTION_LEN 64
+
+// add_key isn't a keyctl operation, it is reported with an operation of its own
+#define KEYCTL_OP_ADD_KEY -1
+
+#define KEYCTL_JOIN_SESSION_KEYRING 1
+#define KEYCTL_SEARCH 10
+
+struct keyctl_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    s32 operation;
+    s32 key_id;
+    char type[KEY_TYPE_LEN];
+    char description[KEY_DESCRIPTION_LEN];
+};
+
+SYSCALL_KPROBE(keyctl) {
+    int operation;
+    unsigned long arg2;
+    unsigned long arg3;
+    unsigned long arg4;
+#if USE_SYSCALL_WRAPPER
+    ctx = (struct pt_regs *) PT_REGS_PARM1(ctx);
+    bpf_probe_read(&operation, sizeof(operation), &PT_REGS_PARM1(ctx));
+    bpf_probe_read(&arg2, sizeof(arg2), &PT_REGS_PARM2(ctx));
+    bpf_probe_read(&arg3, sizeof(arg3), &PT_REGS_PARM3(ctx));
+    bpf_probe_read(&arg4, sizeof(arg4), &PT_REGS_PARM4(ctx));
+#else
+    operation = (int) PT_REGS_PARM1(ctx);
+    arg2 = (unsigned long) PT_REGS_PARM2(ctx);
+    arg3 = (unsigned long) PT_REGS_PARM3(ctx);
+    arg4 = (unsigned long) PT_REGS_PARM4(ctx);
+#endif
+
+    struct syscall_cache_t syscall = {
+        .type = EVENT_KEYCTL,
+        .keyctl = {
+            .operation = operation,
+        }
+    };
+
+    // the key type and description are only passed to a few operations, the others target a key by its serial
+    switch (operation) {
+    case KEYCTL_JOIN_SESSION_KEYRING:
+        syscall.keyctl.description = (const char *) arg2;
+        break;
+    case KEYCTL_SEARCH:
+        syscall.keyctl.key_id = (s32) arg2;
+        syscall.keyctl.type = (const char *) arg3;
+        syscall.keyctl.description = (const char *) arg4;
+        break;
+    default:
+        syscall.keyctl.key_id = (s32) arg2;
+    }
+
+    cache_syscall(&syscall);
+    return 0;
+}
+
+SYSCALL_KPROBE(add_key) {
+    const char *type;
+    const char *description;
+    s32 keyring;
+#if USE_SYSCALL_WRAPPER
+    ctx = (struct pt_regs *) PT_REGS_PARM1(ctx);
+    bpf_probe_read(&type, sizeof(type), &PT_REGS_PARM1(ctx));
+    bpf_probe_read(&description, sizeof(description), &PT_REGS_PARM2(ctx));
+    bpf_probe_read(&keyring, sizeof(keyring), &PT_REGS_PARM5(ctx));
+#else
+    type = (const char *) PT_REGS_PARM1(ctx);
+    description = (const char *) PT_REGS_PARM2(ctx);
+    keyring = (s32) PT_REGS_PARM5(ctx);
+#endif
+
+    struct syscall_cache_t syscall = {
+        .type = EVENT_KEYCTL,
+        .keyctl = {
+            .operation = KEYCTL_OP_ADD_KEY,
+            .key_id = keyring,
+            .type = type,
+            .description = description,
+        }
+    };
+
+    cache_syscall(&syscall);
+    return 0;
+}
+
+int __attribute__((always_inline)) trace__sys_keyctl_ret(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    struct keyctl_event_t event = {
+        .event.type = EVENT_KEYCTL,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .operation = syscall->keyctl.operation,
+        .key_id = syscall->keyctl.key_id,
+    };
+
+    if (syscall->keyctl.type != NULL)
+        bpf_probe_read_str(&event.type, KEY_TYPE_LEN, (void *)syscall->keyctl.type);
+    if (syscall->keyctl.description != NULL)
+        bpf_probe_read_str(&event.description, KEY_DESCRIPTION_LEN, (void *)syscall->keyctl.description);
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+SYSCALL_KRETPROBE(keyctl) {
+    return trace__sys_keyctl_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(add_key) {
+    return trace__sys_keyctl_ret(ctx);
+}
+
+#endif
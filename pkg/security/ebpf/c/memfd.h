@@ -0,0 +1,70 @@
+#ifndef _MEMFD_H_
+#define _MEMFD_H_
+
+#include "syscalls.h"
+
+#define MEMFD_NAME_LEN 64
+
+struct memfd_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    u32 flags;
+    u32 padding;
+    char name[MEMFD_NAME_LEN];
+};
+
+SYSCALL_KPROBE(memfd_create) {
+    const char *name;
+    unsigned int flags;
+#if USE_SYSCALL_WRAPPER
+    ctx = (struct pt_regs *) PT_REGS_PARM1(ctx);
+    bpf_probe_read(&name, sizeof(name), &PT_REGS_PARM1(ctx));
+    bpf_probe_read(&flags, sizeof(flags), &PT_REGS_PARM2(ctx));
+#else
+    name = (const char *) PT_REGS_PARM1(ctx);
+    flags = (unsigned int) PT_REGS_PARM2(ctx);
+#endif
+
+    struct syscall_cache_t syscall = {
+        .type = EVENT_MEMFD,
+        .memfd = {
+            .name = name,
+            .flags = flags,
+        }
+    };
+
+    cache_syscall(&syscall);
+    return 0;
+}
+
+SYSCALL_KRETPROBE(memfd_create) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    struct memfd_event_t event = {
+        .event.type = EVENT_MEMFD,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .flags = syscall->memfd.flags,
+    };
+
+    bpf_probe_read_str(&event.name, MEMFD_NAME_LEN, (void *)syscall->memfd.name);
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+#endif
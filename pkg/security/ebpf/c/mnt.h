@@ -43,6 +43,11 @@ int kprobe__mnt_want_write(struct pt_regs *ctx) {
             return 0;
         syscall->unlink.path_key.mount_id = get_vfsmount_mount_id(mnt);
         break;
+    case EVENT_FALLOCATE:
+        if (syscall->fallocate.path_key.mount_id > 0)
+            return 0;
+        syscall->fallocate.path_key.mount_id = get_vfsmount_mount_id(mnt);
+        break;
     }
     return 0;
 }
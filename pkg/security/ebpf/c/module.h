@@ -0,0 +1,100 @@
+#ifndef _MODULE_H_
+#define _MODULE_H_
+
+#include "syscalls.h"
+
+struct load_module_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    struct file_t file;
+    u32 loaded_from_memory;
+    u32 padding;
+};
+
+int __attribute__((always_inline)) trace__sys_init_module(u32 loaded_from_memory) {
+    struct syscall_cache_t syscall = {
+        .type = EVENT_LOAD_MODULE,
+        .init_module = {
+            .loaded_from_memory = loaded_from_memory,
+        },
+    };
+
+    cache_syscall(&syscall);
+    return 0;
+}
+
+SYSCALL_KPROBE(init_module) {
+    return trace__sys_init_module(1);
+}
+
+SYSCALL_KPROBE(finit_module) {
+    return trace__sys_init_module(0);
+}
+
+/*
+  finit_module reads the module from the file its descriptor points to, the file is resolved when the kernel
+  checks that it may be read
+*/
+SEC("kprobe/security_kernel_read_file")
+int kprobe__security_kernel_read_file(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = peek_syscall();
+    if (!syscall || syscall->type != EVENT_LOAD_MODULE)
+        return 0;
+
+    if (syscall->init_module.loaded_from_memory || syscall->init_module.dentry)
+        return 0;
+
+    struct file *file = (struct file *)PT_REGS_PARM1(ctx);
+    syscall->init_module.dentry = get_file_dentry(file);
+    syscall->init_module.path_key = get_key(syscall->init_module.dentry, &file->f_path);
+
+    return 0;
+}
+
+int __attribute__((always_inline)) trace__sys_init_module_ret(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    // failed loads are reported as well, an attempt to load a module from an unexpected location matters
+    // even when the module is rejected
+    int retval = PT_REGS_RC(ctx);
+
+    struct load_module_event_t event = {
+        .event.type = EVENT_LOAD_MODULE,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .loaded_from_memory = syscall->init_module.loaded_from_memory,
+    };
+
+    // the file of finit_module is left empty when it couldn't be resolved
+    if (syscall->init_module.dentry) {
+        event.file.mount_id = syscall->init_module.path_key.mount_id;
+        event.file.inode = syscall->init_module.path_key.ino;
+        event.file.overlay_numlower = get_overlay_numlower(syscall->init_module.dentry);
+    }
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    if (syscall->init_module.dentry)
+        resolve_dentry(syscall->init_module.dentry, syscall->init_module.path_key, NULL);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+SYSCALL_KRETPROBE(init_module) {
+    return trace__sys_init_module_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(finit_module) {
+    return trace__sys_init_module_ret(ctx);
+}
+
+#endif
@@ -5,10 +5,15 @@
 
 #define FSTYPE_LEN 16
 
+#ifndef MS_REMOUNT
+#define MS_REMOUNT 32
+#endif
+
 struct mount_event_t {
-    struct event_t event;
+    struct kevent_t event;
     struct process_context_t process;
     struct container_context_t container;
+    struct syscall_t syscall;
     int new_mount_id;
     int new_group_id;
     dev_t new_device;
@@ -18,20 +23,43 @@ struct mount_event_t {
     int root_mount_id;
     u32 padding;
     char fstype[FSTYPE_LEN];
+    unsigned long flags;
 };
 
 SYSCALL_KPROBE(mount) {
-    struct syscall_cache_t syscall = {};
+    struct syscall_cache_t syscall = {
+        .type = EVENT_MOUNT,
+    };
 #if USE_SYSCALL_WRAPPER
     ctx = (struct pt_regs *) PT_REGS_PARM1(ctx);
     bpf_probe_read(&syscall.mount.fstype, sizeof(void *), &PT_REGS_PARM3(ctx));
+    bpf_probe_read(&syscall.mount.flags, sizeof(unsigned long), &PT_REGS_PARM4(ctx));
 #else
     syscall.mount.fstype = (void *)PT_REGS_PARM3(ctx);
+    syscall.mount.flags = (unsigned long)PT_REGS_PARM4(ctx);
 #endif
     cache_syscall(&syscall);
     return 0;
 }
 
+/*
+  a remount doesn't attach any mount, the mount being remounted is captured from the path
+  given to the security hook
+*/
+SEC("kprobe/security_sb_mount")
+int kprobe__security_sb_mount(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = peek_syscall();
+    if (!syscall || syscall->type != EVENT_MOUNT)
+        return 0;
+
+    if (syscall->mount.flags & MS_REMOUNT) {
+        struct path *path = (struct path *)PT_REGS_PARM2(ctx);
+        syscall->mount.remount_id = get_path_mount_id(path);
+    }
+
+    return 0;
+}
+
 SEC("kprobe/attach_recursive_mnt")
 int kprobe__attach_recursive_mnt(struct pt_regs *ctx) {
     struct syscall_cache_t *syscall = peek_syscall();
@@ -75,24 +103,44 @@ SYSCALL_KRETPROBE(mount) {
     if (!syscall)
         return 0;
 
+    struct mount_event_t event = {
+        .event.type = EVENT_MOUNT,
+        .syscall = {
+            .retval = PT_REGS_RC(ctx),
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .flags = syscall->mount.flags,
+    };
+
+    if (syscall->mount.flags & MS_REMOUNT) {
+        if (!syscall->mount.remount_id)
+            return 0;
+
+        // the mount point of a remount is already known, only its mount ID is reported
+        event.new_mount_id = syscall->mount.remount_id;
+        bpf_probe_read_str(&event.fstype, FSTYPE_LEN, syscall->mount.fstype);
+
+        struct proc_cache_t *entry = fill_process_data(&event.process);
+        fill_container_data(entry, &event.container);
+
+        send_mountpoints_events(ctx, event);
+
+        return 0;
+    }
+
     struct dentry *dentry = get_mountpoint_dentry(syscall->mount.dest_mountpoint);
     struct path_key_t path_key = {
         .mount_id = get_mount_mount_id(syscall->mount.dest_mnt),
         .ino = get_dentry_ino(dentry),
     };
 
-    struct mount_event_t event = {
-        .event.retval = PT_REGS_RC(ctx),
-        .event.type = EVENT_MOUNT,
-        .event.timestamp = bpf_ktime_get_ns(),
-        .new_mount_id = get_mount_mount_id(syscall->mount.src_mnt),
-        .new_group_id = get_mount_peer_group_id(syscall->mount.src_mnt),
-        .new_device = get_mount_dev(syscall->mount.src_mnt),
-        .parent_mount_id = path_key.mount_id,
-        .parent_ino = path_key.ino,
-        .root_ino = syscall->mount.root_key.ino,
-        .root_mount_id = syscall->mount.root_key.mount_id,
-    };
+    event.new_mount_id = get_mount_mount_id(syscall->mount.src_mnt);
+    event.new_group_id = get_mount_peer_group_id(syscall->mount.src_mnt);
+    event.new_device = get_mount_dev(syscall->mount.src_mnt);
+    event.parent_mount_id = path_key.mount_id;
+    event.parent_ino = path_key.ino;
+    event.root_ino = syscall->mount.root_key.ino;
+    event.root_mount_id = syscall->mount.root_key.mount_id;
     bpf_probe_read_str(&event.fstype, FSTYPE_LEN, syscall->mount.fstype);
 
     if (event.new_mount_id == 0 && event.new_device == 0) {
@@ -0,0 +1,97 @@
+#ifndef _PIPE_H_
+#define _PIPE_H_
+
+#include "syscalls.h"
+
+struct pipe_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    s32 read_fd;
+    s32 write_fd;
+    u32 flags;
+    u32 padding;
+};
+
+int __attribute__((always_inline)) trace__sys_pipe(int *fds, int flags) {
+    struct syscall_cache_t syscall = {
+        .type = EVENT_PIPE,
+        .pipe = {
+            .fds = fds,
+            .flags = flags,
+        }
+    };
+
+    cache_syscall(&syscall);
+    return 0;
+}
+
+SYSCALL_KPROBE(pipe) {
+    int *fds;
+#if USE_SYSCALL_WRAPPER
+    ctx = (struct pt_regs *) PT_REGS_PARM1(ctx);
+    bpf_probe_read(&fds, sizeof(fds), &PT_REGS_PARM1(ctx));
+#else
+    fds = (int *) PT_REGS_PARM1(ctx);
+#endif
+    return trace__sys_pipe(fds, 0);
+}
+
+SYSCALL_KPROBE(pipe2) {
+    int *fds;
+    int flags;
+#if USE_SYSCALL_WRAPPER
+    ctx = (struct pt_regs *) PT_REGS_PARM1(ctx);
+    bpf_probe_read(&fds, sizeof(fds), &PT_REGS_PARM1(ctx));
+    bpf_probe_read(&flags, sizeof(flags), &PT_REGS_PARM2(ctx));
+#else
+    fds = (int *) PT_REGS_PARM1(ctx);
+    flags = (int) PT_REGS_PARM2(ctx);
+#endif
+    return trace__sys_pipe(fds, flags);
+}
+
+int __attribute__((always_inline)) trace__sys_pipe_ret(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    struct pipe_event_t event = {
+        .event.type = EVENT_PIPE,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .read_fd = -1,
+        .write_fd = -1,
+        .flags = syscall->pipe.flags,
+    };
+
+    // the fd pair is only written to user space once the syscall succeeded
+    if (retval >= 0) {
+        bpf_probe_read(&event.read_fd, sizeof(event.read_fd), syscall->pipe.fds);
+        bpf_probe_read(&event.write_fd, sizeof(event.write_fd), syscall->pipe.fds + 1);
+    }
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+SYSCALL_KRETPROBE(pipe) {
+    return trace__sys_pipe_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(pipe2) {
+    return trace__sys_pipe_ret(ctx);
+}
+
+#endif
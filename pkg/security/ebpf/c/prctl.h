@@ -0,0 +1,92 @@
+#ifndef _PRCTL_H_
+#define _PRCTL_H_
+
+#include "syscalls.h"
+
+#define PR_SET_NAME 15
+
+struct prctl_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    s32 option;
+    u32 padding;
+    u64 arg2;
+    u64 arg3;
+    u64 arg4;
+    u64 arg5;
+    char name[TASK_COMM_LEN];
+};
+
+SYSCALL_KPROBE(prctl) {
+    int option;
+    unsigned long arg2;
+    unsigned long arg3;
+    unsigned long arg4;
+    unsigned long arg5;
+#if USE_SYSCALL_WRAPPER
+    ctx = (struct pt_regs *) PT_REGS_PARM1(ctx);
+    bpf_probe_read(&option, sizeof(option), &PT_REGS_PARM1(ctx));
+    bpf_probe_read(&arg2, sizeof(arg2), &PT_REGS_PARM2(ctx));
+    bpf_probe_read(&arg3, sizeof(arg3), &PT_REGS_PARM3(ctx));
+    bpf_probe_read(&arg4, sizeof(arg4), &PT_REGS_PARM4(ctx));
+    bpf_probe_read(&arg5, sizeof(arg5), &PT_REGS_PARM5(ctx));
+#else
+    option = (int) PT_REGS_PARM1(ctx);
+    arg2 = (unsigned long) PT_REGS_PARM2(ctx);
+    arg3 = (unsigned long) PT_REGS_PARM3(ctx);
+    arg4 = (unsigned long) PT_REGS_PARM4(ctx);
+    arg5 = (unsigned long) PT_REGS_PARM5(ctx);
+#endif
+
+    struct syscall_cache_t syscall = {
+        .type = EVENT_PRCTL,
+        .prctl = {
+            .option = option,
+            .arg2 = arg2,
+            .arg3 = arg3,
+            .arg4 = arg4,
+            .arg5 = arg5,
+        }
+    };
+
+    cache_syscall(&syscall);
+    return 0;
+}
+
+SYSCALL_KRETPROBE(prctl) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    struct prctl_event_t event = {
+        .event.type = EVENT_PRCTL,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .option = syscall->prctl.option,
+        .arg2 = syscall->prctl.arg2,
+        .arg3 = syscall->prctl.arg3,
+        .arg4 = syscall->prctl.arg4,
+        .arg5 = syscall->prctl.arg5,
+    };
+
+    // the second argument of PR_SET_NAME points to the new name of the calling thread
+    if (event.option == PR_SET_NAME)
+        bpf_probe_read_str(&event.name, TASK_COMM_LEN, (void *)event.arg2);
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+#endif
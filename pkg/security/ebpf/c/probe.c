@@ -27,6 +27,25 @@
 #include "link.h"
 #include "raw_syscalls.h"
 #include "getattr.h"
+#include "getxattr.h"
+#include "chdir.h"
+#include "memfd.h"
+#include "fallocate.h"
+#include "dup.h"
+#include "rlimit.h"
+#include "setns.h"
+#include "quotactl.h"
+#include "pipe.h"
+#include "ioctl.h"
+#include "umask.h"
+#include "stat.h"
+#include "write.h"
+#include "clone.h"
+#include "sendfile.h"
+#include "keyctl.h"
+#include "prctl.h"
+#include "module.h"
+#include "bind.h"
 
 __u32 _version SEC("version") = 0xFFFFFFFE;
 
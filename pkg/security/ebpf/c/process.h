@@ -41,6 +41,7 @@ static struct proc_cache_t * __attribute__((always_inline)) fill_process_data(st
     struct proc_cache_t *entry = get_pid_cache(tgid);
     if (entry) {
         data->executable = entry->executable;
+        data->exec_timestamp = entry->exec_timestamp;
     }
 
     return entry;
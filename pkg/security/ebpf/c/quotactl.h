@@ -0,0 +1,77 @@
+#ifndef _QUOTACTL_H_
+#define _QUOTACTL_H_
+
+#include "syscalls.h"
+
+#define QUOTACTL_SPECIAL_LEN 64
+
+struct quotactl_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    u32 cmd;
+    u32 id;
+    char special[QUOTACTL_SPECIAL_LEN];
+};
+
+SYSCALL_KPROBE(quotactl) {
+    unsigned int cmd;
+    const char *special;
+    u32 id;
+#if USE_SYSCALL_WRAPPER
+    ctx = (struct pt_regs *) PT_REGS_PARM1(ctx);
+    bpf_probe_read(&cmd, sizeof(cmd), &PT_REGS_PARM1(ctx));
+    bpf_probe_read(&special, sizeof(special), &PT_REGS_PARM2(ctx));
+    bpf_probe_read(&id, sizeof(id), &PT_REGS_PARM3(ctx));
+#else
+    cmd = (unsigned int) PT_REGS_PARM1(ctx);
+    special = (const char *) PT_REGS_PARM2(ctx);
+    id = (u32) PT_REGS_PARM3(ctx);
+#endif
+
+    struct syscall_cache_t syscall = {
+        .type = EVENT_QUOTACTL,
+        .quotactl = {
+            .cmd = cmd,
+            .id = id,
+            .special = special,
+        }
+    };
+
+    cache_syscall(&syscall);
+    return 0;
+}
+
+SYSCALL_KRETPROBE(quotactl) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    struct quotactl_event_t event = {
+        .event.type = EVENT_QUOTACTL,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .cmd = syscall->quotactl.cmd,
+        .id = syscall->quotactl.id,
+    };
+
+    // special is the path to the block device of the filesystem, it may be NULL for Q_SYNC
+    if (syscall->quotactl.special != NULL)
+        bpf_probe_read_str(&event.special, QUOTACTL_SPECIAL_LEN, (void *)syscall->quotactl.special);
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+#endif
@@ -0,0 +1,110 @@
+#ifndef _RLIMIT_H_
+#define _RLIMIT_H_
+
+#include "syscalls.h"
+
+struct rlimit_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    u32 resource;
+    u32 pid;
+    u64 soft;
+    u64 hard;
+};
+
+int __attribute__((always_inline)) trace__sys_setrlimit(u32 pid, unsigned int resource, void *rlim) {
+    // prlimit64 can be used to only read the limits, nothing to report then
+    if (rlim == NULL)
+        return 0;
+
+    // a zero pid targets the calling process
+    if (pid == 0)
+        pid = bpf_get_current_pid_tgid() >> 32;
+
+    struct syscall_cache_t syscall = {
+        .type = EVENT_SETRLIMIT,
+        .setrlimit = {
+            .resource = resource,
+            .pid = pid,
+        }
+    };
+
+    // both struct rlimit and struct rlimit64 start with the soft limit followed by the hard limit
+    bpf_probe_read(&syscall.setrlimit.soft, sizeof(syscall.setrlimit.soft), rlim);
+    bpf_probe_read(&syscall.setrlimit.hard, sizeof(syscall.setrlimit.hard), (char *)rlim + sizeof(u64));
+
+    cache_syscall(&syscall);
+    return 0;
+}
+
+SYSCALL_KPROBE(setrlimit) {
+    unsigned int resource;
+    void *rlim;
+#if USE_SYSCALL_WRAPPER
+    ctx = (struct pt_regs *) PT_REGS_PARM1(ctx);
+    bpf_probe_read(&resource, sizeof(resource), &PT_REGS_PARM1(ctx));
+    bpf_probe_read(&rlim, sizeof(rlim), &PT_REGS_PARM2(ctx));
+#else
+    resource = (unsigned int) PT_REGS_PARM1(ctx);
+    rlim = (void *) PT_REGS_PARM2(ctx);
+#endif
+    return trace__sys_setrlimit(0, resource, rlim);
+}
+
+SYSCALL_KPROBE(prlimit64) {
+    pid_t pid;
+    unsigned int resource;
+    void *new_rlim;
+#if USE_SYSCALL_WRAPPER
+    ctx = (struct pt_regs *) PT_REGS_PARM1(ctx);
+    bpf_probe_read(&pid, sizeof(pid), &PT_REGS_PARM1(ctx));
+    bpf_probe_read(&resource, sizeof(resource), &PT_REGS_PARM2(ctx));
+    bpf_probe_read(&new_rlim, sizeof(new_rlim), &PT_REGS_PARM3(ctx));
+#else
+    pid = (pid_t) PT_REGS_PARM1(ctx);
+    resource = (unsigned int) PT_REGS_PARM2(ctx);
+    new_rlim = (void *) PT_REGS_PARM3(ctx);
+#endif
+    return trace__sys_setrlimit(pid, resource, new_rlim);
+}
+
+int __attribute__((always_inline)) trace__sys_setrlimit_ret(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    struct rlimit_event_t event = {
+        .event.type = EVENT_SETRLIMIT,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .resource = syscall->setrlimit.resource,
+        .pid = syscall->setrlimit.pid,
+        .soft = syscall->setrlimit.soft,
+        .hard = syscall->setrlimit.hard,
+    };
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+SYSCALL_KRETPROBE(setrlimit) {
+    return trace__sys_setrlimit_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(prlimit64) {
+    return trace__sys_setrlimit_ret(ctx);
+}
+
+#endif
@@ -0,0 +1,112 @@
+#ifndef _SENDFILE_H_
+#define _SENDFILE_H_
+
+#include "syscalls.h"
+
+struct sendfile_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    s32 in_fd;
+    s32 out_fd;
+    u64 count;
+};
+
+int __attribute__((always_inline)) trace__sys_sendfile(int in_fd, int out_fd, u64 count) {
+    struct syscall_cache_t syscall = {
+        .type = EVENT_SENDFILE,
+        .sendfile = {
+            .in_fd = in_fd,
+            .out_fd = out_fd,
+            .count = count,
+        }
+    };
+
+    cache_syscall(&syscall);
+    return 0;
+}
+
+int __attribute__((always_inline)) trace__sys_sendfile_args(struct pt_regs *ctx) {
+    int out_fd;
+    int in_fd;
+    size_t count;
+#if USE_SYSCALL_WRAPPER
+    ctx = (struct pt_regs *) PT_REGS_PARM1(ctx);
+    bpf_probe_read(&out_fd, sizeof(out_fd), &PT_REGS_PARM1(ctx));
+    bpf_probe_read(&in_fd, sizeof(in_fd), &PT_REGS_PARM2(ctx));
+    bpf_probe_read(&count, sizeof(count), &PT_REGS_PARM4(ctx));
+#else
+    out_fd = (int) PT_REGS_PARM1(ctx);
+    in_fd = (int) PT_REGS_PARM2(ctx);
+    count = (size_t) PT_REGS_PARM4(ctx);
+#endif
+    return trace__sys_sendfile(in_fd, out_fd, count);
+}
+
+SYSCALL_KPROBE(sendfile) {
+    return trace__sys_sendfile_args(ctx);
+}
+
+SYSCALL_KPROBE(sendfile64) {
+    return trace__sys_sendfile_args(ctx);
+}
+
+SYSCALL_KPROBE(splice) {
+    int fd_in;
+    int fd_out;
+    size_t len;
+#if USE_SYSCALL_WRAPPER
+    ctx = (struct pt_regs *) PT_REGS_PARM1(ctx);
+    bpf_probe_read(&fd_in, sizeof(fd_in), &PT_REGS_PARM1(ctx));
+    bpf_probe_read(&fd_out, sizeof(fd_out), &PT_REGS_PARM3(ctx));
+    bpf_probe_read(&len, sizeof(len), &PT_REGS_PARM5(ctx));
+#else
+    fd_in = (int) PT_REGS_PARM1(ctx);
+    fd_out = (int) PT_REGS_PARM3(ctx);
+    len = (size_t) PT_REGS_PARM5(ctx);
+#endif
+    return trace__sys_sendfile(fd_in, fd_out, len);
+}
+
+int __attribute__((always_inline)) trace__sys_sendfile_ret(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    struct sendfile_event_t event = {
+        .event.type = EVENT_SENDFILE,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .in_fd = syscall->sendfile.in_fd,
+        .out_fd = syscall->sendfile.out_fd,
+        .count = syscall->sendfile.count,
+    };
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+SYSCALL_KRETPROBE(sendfile) {
+    return trace__sys_sendfile_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(sendfile64) {
+    return trace__sys_sendfile_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(splice) {
+    return trace__sys_sendfile_ret(ctx);
+}
+
+#endif
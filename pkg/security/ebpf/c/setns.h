@@ -0,0 +1,66 @@
+#ifndef _SETNS_H_
+#define _SETNS_H_
+
+#include "syscalls.h"
+
+struct setns_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    s32 fd;
+    u32 nstype;
+};
+
+SYSCALL_KPROBE(setns) {
+    int fd;
+    int nstype;
+#if USE_SYSCALL_WRAPPER
+    ctx = (struct pt_regs *) PT_REGS_PARM1(ctx);
+    bpf_probe_read(&fd, sizeof(fd), &PT_REGS_PARM1(ctx));
+    bpf_probe_read(&nstype, sizeof(nstype), &PT_REGS_PARM2(ctx));
+#else
+    fd = (int) PT_REGS_PARM1(ctx);
+    nstype = (int) PT_REGS_PARM2(ctx);
+#endif
+
+    struct syscall_cache_t syscall = {
+        .type = EVENT_SETNS,
+        .setns = {
+            .fd = fd,
+            .nstype = nstype,
+        }
+    };
+
+    cache_syscall(&syscall);
+    return 0;
+}
+
+SYSCALL_KRETPROBE(setns) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    struct setns_event_t event = {
+        .event.type = EVENT_SETNS,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .fd = syscall->setns.fd,
+        .nstype = syscall->setns.nstype,
+    };
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+#endif
@@ -0,0 +1,116 @@
+#ifndef _STAT_H_
+#define _STAT_H_
+
+#include "syscalls.h"
+
+struct stat_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    struct file_t file;
+};
+
+int __attribute__((always_inline)) trace__sys_stat() {
+    struct syscall_cache_t syscall = {
+        .type = EVENT_STAT,
+    };
+
+    cache_syscall(&syscall);
+    return 0;
+}
+
+SYSCALL_KPROBE(stat) {
+    return trace__sys_stat();
+}
+
+SYSCALL_KPROBE(lstat) {
+    return trace__sys_stat();
+}
+
+SYSCALL_KPROBE(newstat) {
+    return trace__sys_stat();
+}
+
+SYSCALL_KPROBE(newlstat) {
+    return trace__sys_stat();
+}
+
+SYSCALL_KPROBE(newfstatat) {
+    return trace__sys_stat();
+}
+
+SEC("kprobe/security_inode_getattr")
+int kprobe__security_inode_getattr(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = peek_syscall();
+    if (!syscall || syscall->type != EVENT_STAT)
+        return 0;
+
+    // only the path looked up by the syscall is relevant
+    if (syscall->stat.dentry)
+        return 0;
+
+    struct path *path = (struct path *)PT_REGS_PARM1(ctx);
+    syscall->stat.dentry = get_path_dentry(path);
+    syscall->stat.path_key = get_key(syscall->stat.dentry, path);
+
+    return 0;
+}
+
+int __attribute__((always_inline)) trace__sys_stat_ret(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    // the path couldn't be looked up, nothing to resolve
+    if (!syscall->stat.dentry)
+        return 0;
+
+    struct stat_event_t event = {
+        .event.type = EVENT_STAT,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .file = {
+            .mount_id = syscall->stat.path_key.mount_id,
+            .inode = syscall->stat.path_key.ino,
+            .overlay_numlower = get_overlay_numlower(syscall->stat.dentry),
+        },
+    };
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    resolve_dentry(syscall->stat.dentry, syscall->stat.path_key, NULL);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+SYSCALL_KRETPROBE(stat) {
+    return trace__sys_stat_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(lstat) {
+    return trace__sys_stat_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(newstat) {
+    return trace__sys_stat_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(newlstat) {
+    return trace__sys_stat_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(newfstatat) {
+    return trace__sys_stat_ret(ctx);
+}
+
+#endif
@@ -71,10 +71,13 @@ struct syscall_cache_t {
             struct mountpoint *dest_mountpoint;
             struct path_key_t root_key;
             void *fstype;
+            unsigned long flags;
+            int remount_id;
         } mount;
 
         struct {
             struct vfsmount *vfs;
+            int flags;
         } umount;
 
         struct {
@@ -84,6 +87,114 @@ struct syscall_cache_t {
             struct path_key_t target_key;
             int src_overlay_numlower;
         } link;
+
+        struct {
+            struct dentry *dentry;
+            struct path_key_t path_key;
+            const char *name;
+        } xattr;
+
+        struct {
+            struct dentry *dentry;
+            struct path_key_t path_key;
+        } chdir;
+
+        struct {
+            const char *name;
+            unsigned int flags;
+        } memfd;
+
+        struct {
+            struct dentry *dentry;
+            struct path_key_t path_key;
+            int mode;
+            u64 length;
+        } fallocate;
+
+        struct {
+            int old_fd;
+            int new_fd;
+        } dup;
+
+        struct {
+            u32 resource;
+            u32 pid;
+            u64 soft;
+            u64 hard;
+        } setrlimit;
+
+        struct {
+            int fd;
+            int nstype;
+        } setns;
+
+        struct {
+            u32 cmd;
+            u32 id;
+            const char *special;
+        } quotactl;
+
+        struct {
+            int *fds;
+            int flags;
+        } pipe;
+
+        struct {
+            int fd;
+            u32 request;
+        } ioctl;
+
+        struct {
+            u32 mask;
+        } umask;
+
+        struct {
+            struct dentry *dentry;
+            struct path_key_t path_key;
+        } stat;
+
+        struct {
+            struct dentry *dentry;
+            struct path_key_t path_key;
+            u64 count;
+        } write;
+
+        struct {
+            u64 flags;
+        } clone;
+
+        struct {
+            s32 in_fd;
+            s32 out_fd;
+            u64 count;
+        } sendfile;
+
+        struct {
+            s32 operation;
+            s32 key_id;
+            const char *type;
+            const char *description;
+        } keyctl;
+
+        struct {
+            s32 option;
+            u64 arg2;
+            u64 arg3;
+            u64 arg4;
+            u64 arg5;
+        } prctl;
+
+        struct {
+            struct dentry *dentry;
+            struct path_key_t path_key;
+            u32 loaded_from_memory;
+        } init_module;
+
+        struct {
+            u64 addr[2];
+            u16 family;
+            u16 port;
+        } bind;
     };
 };
 
@@ -0,0 +1,61 @@
+#ifndef _UMASK_H_
+#define _UMASK_H_
+
+#include "syscalls.h"
+
+struct umask_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    u32 mask;
+    u32 padding;
+};
+
+SYSCALL_KPROBE(umask) {
+    int mask;
+#if USE_SYSCALL_WRAPPER
+    ctx = (struct pt_regs *) PT_REGS_PARM1(ctx);
+    bpf_probe_read(&mask, sizeof(mask), &PT_REGS_PARM1(ctx));
+#else
+    mask = (int) PT_REGS_PARM1(ctx);
+#endif
+
+    struct syscall_cache_t syscall = {
+        .type = EVENT_UMASK,
+        .umask = {
+            // the kernel only keeps the permission bits
+            .mask = mask & 0777,
+        }
+    };
+
+    cache_syscall(&syscall);
+    return 0;
+}
+
+SYSCALL_KRETPROBE(umask) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    // umask always succeeds and returns the previous mask
+    int retval = PT_REGS_RC(ctx);
+
+    struct umask_event_t event = {
+        .event.type = EVENT_UMASK,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .mask = syscall->umask.mask,
+    };
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+#endif
@@ -8,18 +8,50 @@ struct umount_event_t {
     struct process_context_t process;
     struct container_context_t container;
     int mount_id;
-
+    int flags;
 };
 
+/*
+  the umount2 syscall is implemented by the umount syscall function of the kernel, its entry
+  is traced to capture the flags (MNT_FORCE, MNT_DETACH, ...) while its return sends the event
+*/
+
+SYSCALL_KPROBE(umount) {
+    int flags;
+#if USE_SYSCALL_WRAPPER
+    ctx = (struct pt_regs *) PT_REGS_PARM1(ctx);
+    bpf_probe_read(&flags, sizeof(flags), &PT_REGS_PARM2(ctx));
+#else
+    flags = (int) PT_REGS_PARM2(ctx);
+#endif
+
+    struct syscall_cache_t syscall = {
+        .type = EVENT_UMOUNT,
+        .umount = {
+            .flags = flags,
+        }
+    };
+
+    cache_syscall(&syscall);
+    return 0;
+}
+
 SEC("kprobe/security_sb_umount")
 int kprobe__security_sb_umount(struct pt_regs *ctx) {
-    struct syscall_cache_t syscall = {
+    struct syscall_cache_t *syscall = peek_syscall();
+    if (syscall && syscall->type == EVENT_UMOUNT) {
+        syscall->umount.vfs = (struct vfsmount *)PT_REGS_PARM1(ctx);
+        return 0;
+    }
+
+    struct syscall_cache_t umount = {
+        .type = EVENT_UMOUNT,
         .umount = {
             .vfs = (struct vfsmount *)PT_REGS_PARM1(ctx),
         }
     };
 
-    cache_syscall(&syscall);
+    cache_syscall(&umount);
     return 0;
 }
 
@@ -28,11 +60,16 @@ SYSCALL_KRETPROBE(umount) {
     if (!syscall)
         return 0;
 
+    // the mount point wasn't resolved, the umount failed early
+    if (!syscall->umount.vfs)
+        return 0;
+
     struct umount_event_t event = {
         .event.retval = PT_REGS_RC(ctx),
         .event.type = EVENT_UMOUNT,
         .event.timestamp = bpf_ktime_get_ns(),
         .mount_id = get_vfsmount_mount_id(syscall->umount.vfs),
+        .flags = syscall->umount.flags,
     };
 
     struct proc_cache_t *entry = fill_process_data(&event.process);
@@ -0,0 +1,168 @@
+#ifndef _WRITE_H_
+#define _WRITE_H_
+
+#include "filters.h"
+#include "syscalls.h"
+#include "open_filter.h"
+
+struct bpf_map_def SEC("maps/write_policy") write_policy = {
+    .type = BPF_MAP_TYPE_ARRAY,
+    .key_size = sizeof(u32),
+    .value_size = sizeof(struct policy_t),
+    .max_entries = 1,
+    .pinning = 0,
+    .namespace = "",
+};
+
+struct bpf_map_def SEC("maps/write_basename_approvers") write_basename_approvers = {
+    .type = BPF_MAP_TYPE_HASH,
+    .key_size = BASENAME_FILTER_SIZE,
+    .value_size = sizeof(struct filter_t),
+    .max_entries = 255,
+    .pinning = 0,
+    .namespace = "",
+};
+
+struct bpf_map_def SEC("maps/write_path_inode_discarders") write_path_inode_discarders = {
+    .type = BPF_MAP_TYPE_LRU_HASH,
+    .key_size = sizeof(struct path_key_t),
+    .value_size = sizeof(struct filter_t),
+    .max_entries = 512,
+    .pinning = 0,
+    .namespace = "",
+};
+
+struct write_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    struct file_t file;
+    u64 count;
+};
+
+int __attribute__((always_inline)) trace__sys_write() {
+    struct syscall_cache_t syscall = {
+        .type = EVENT_WRITE,
+        .policy = {.mode = ACCEPT},
+    };
+
+    u32 key = 0;
+    struct policy_t *policy = bpf_map_lookup_elem(&write_policy, &key);
+    if (policy) {
+        syscall.policy.mode = policy->mode;
+        syscall.policy.flags = policy->flags;
+    }
+
+    cache_syscall(&syscall);
+    return 0;
+}
+
+SYSCALL_KPROBE(write) {
+    return trace__sys_write();
+}
+
+SYSCALL_KPROBE(pwrite64) {
+    return trace__sys_write();
+}
+
+int __attribute__((always_inline)) write_approve_by_basename(struct dentry *dentry) {
+    struct open_basename_t basename = {};
+    get_dentry_name(dentry, &basename, sizeof(basename));
+
+    struct filter_t *filter = bpf_map_lookup_elem(&write_basename_approvers, &basename);
+    if (filter) {
+#ifdef DEBUG
+        bpf_printk("kprobe/vfs_write basename %s approved\n", basename.value);
+#endif
+        return 1;
+    }
+    return 0;
+}
+
+/*
+  the file written is resolved from the file the descriptor points to, the writes of the files that are
+  not approved are dropped here to keep the volume of events sane
+*/
+SEC("kprobe/vfs_write")
+int kprobe__vfs_write(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = peek_syscall();
+    if (!syscall || syscall->type != EVENT_WRITE)
+        return 0;
+
+    if (syscall->write.dentry)
+        return 0;
+
+    struct file *file = (struct file *)PT_REGS_PARM1(ctx);
+    syscall->write.dentry = get_file_dentry(file);
+    syscall->write.path_key = get_key(syscall->write.dentry, &file->f_path);
+    syscall->write.count = (u64)PT_REGS_PARM3(ctx);
+
+    if (syscall->policy.mode == DENY) {
+        char pass_to_userspace = 0;
+        if ((syscall->policy.flags & BASENAME) > 0) {
+            pass_to_userspace = write_approve_by_basename(syscall->write.dentry);
+        }
+
+        if (!pass_to_userspace) {
+            pop_syscall();
+        }
+    }
+
+    return 0;
+}
+
+int __attribute__((always_inline)) trace__sys_write_ret(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = pop_syscall();
+    if (!syscall)
+        return 0;
+
+    int retval = PT_REGS_RC(ctx);
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    // the fd couldn't be resolved to a file
+    if (!syscall->write.dentry)
+        return 0;
+
+    struct write_event_t event = {
+        .event.type = EVENT_WRITE,
+        .syscall = {
+            .retval = retval,
+            .timestamp = bpf_ktime_get_ns(),
+        },
+        .file = {
+            .mount_id = syscall->write.path_key.mount_id,
+            .inode = syscall->write.path_key.ino,
+            .overlay_numlower = get_overlay_numlower(syscall->write.dentry),
+        },
+        .count = syscall->write.count,
+    };
+
+    int ret = 0;
+    if (syscall->policy.mode == NO_FILTER) {
+        ret = resolve_dentry(syscall->write.dentry, syscall->write.path_key, NULL);
+    } else {
+        ret = resolve_dentry(syscall->write.dentry, syscall->write.path_key, &write_path_inode_discarders);
+    }
+    if (ret < 0) {
+        return 0;
+    }
+
+    struct proc_cache_t *entry = fill_process_data(&event.process);
+    fill_container_data(entry, &event.container);
+
+    send_event(ctx, event);
+
+    return 0;
+}
+
+SYSCALL_KRETPROBE(write) {
+    return trace__sys_write_ret(ctx);
+}
+
+SYSCALL_KRETPROBE(pwrite64) {
+    return trace__sys_write_ret(ctx);
+}
+
+#endif
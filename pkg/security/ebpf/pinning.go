@@ -0,0 +1,265 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package ebpf
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+// bpf syscall commands, see include/uapi/linux/bpf.h
+const (
+	bpfMapLookupElem  = 1
+	bpfMapUpdateElem  = 2
+	bpfMapDeleteElem  = 3
+	bpfMapGetNextKey  = 4
+	bpfObjPin         = 6
+	bpfObjGet         = 7
+	bpfObjGetInfoByFD = 15
+)
+
+// bpf map types whose entries can be copied from one map to another
+const (
+	bpfMapTypeHash    = 1
+	bpfMapTypeArray   = 2
+	bpfMapTypeLRUHash = 9
+)
+
+// bpfFSMagic is the magic number of the bpf filesystem
+const bpfFSMagic = 0xcafe4a11
+
+type bpfObjAttr struct {
+	pathname  uint64
+	fd        uint32
+	fileFlags uint32
+}
+
+type bpfMapElemAttr struct {
+	mapFd uint32
+	_     uint32
+	key   uint64
+	value uint64
+	flags uint64
+}
+
+type bpfObjInfoAttr struct {
+	fd      uint32
+	infoLen uint32
+	info    uint64
+}
+
+// bpfMapInfo holds the first fields of struct bpf_map_info
+type bpfMapInfo struct {
+	mapType    uint32
+	id         uint32
+	keySize    uint32
+	valueSize  uint32
+	maxEntries uint32
+	mapFlags   uint32
+}
+
+func bpfCall(cmd int, attr unsafe.Pointer, size uintptr) (int, error) {
+	r, _, errno := unix.Syscall(unix.SYS_BPF, uintptr(cmd), uintptr(attr), size)
+	if errno != 0 {
+		return int(r), errno
+	}
+	return int(r), nil
+}
+
+func bpfObjPinPath(fd int, path string) error {
+	pathname, err := unix.BytePtrFromString(path)
+	if err != nil {
+		return err
+	}
+	attr := bpfObjAttr{
+		pathname: uint64(uintptr(unsafe.Pointer(pathname))),
+		fd:       uint32(fd),
+	}
+	_, err = bpfCall(bpfObjPin, unsafe.Pointer(&attr), unsafe.Sizeof(attr))
+	runtime.KeepAlive(pathname)
+	return err
+}
+
+func bpfObjGetPath(path string) (int, error) {
+	pathname, err := unix.BytePtrFromString(path)
+	if err != nil {
+		return -1, err
+	}
+	attr := bpfObjAttr{
+		pathname: uint64(uintptr(unsafe.Pointer(pathname))),
+	}
+	fd, err := bpfCall(bpfObjGet, unsafe.Pointer(&attr), unsafe.Sizeof(attr))
+	runtime.KeepAlive(pathname)
+	return fd, err
+}
+
+func bpfGetMapInfo(fd int) (bpfMapInfo, error) {
+	var info bpfMapInfo
+	attr := bpfObjInfoAttr{
+		fd:      uint32(fd),
+		infoLen: uint32(unsafe.Sizeof(info)),
+		info:    uint64(uintptr(unsafe.Pointer(&info))),
+	}
+	_, err := bpfCall(bpfObjGetInfoByFD, unsafe.Pointer(&attr), unsafe.Sizeof(attr))
+	return info, err
+}
+
+func bpfMapElemCall(cmd int, fd int, key, value unsafe.Pointer, flags uint64) error {
+	attr := bpfMapElemAttr{
+		mapFd: uint32(fd),
+		key:   uint64(uintptr(key)),
+		value: uint64(uintptr(value)),
+		flags: flags,
+	}
+	_, err := bpfCall(cmd, unsafe.Pointer(&attr), unsafe.Sizeof(attr))
+	return err
+}
+
+// copyMapEntries copies the entries of the map src into the map dst, both maps are expected to share
+// the same key and value sizes. It returns the number of entries copied.
+func copyMapEntries(src, dst int, info bpfMapInfo) (int, error) {
+	key := make([]byte, info.keySize)
+	nextKey := make([]byte, info.keySize)
+	value := make([]byte, info.valueSize)
+
+	copied := 0
+	var pKey unsafe.Pointer // a nil key returns the first key of the map
+	for {
+		if err := bpfMapElemCall(bpfMapGetNextKey, src, pKey, unsafe.Pointer(&nextKey[0]), 0); err != nil {
+			if err == unix.ENOENT {
+				return copied, nil
+			}
+			return copied, err
+		}
+		copy(key, nextKey)
+		pKey = unsafe.Pointer(&key[0])
+
+		if err := bpfMapElemCall(bpfMapLookupElem, src, pKey, unsafe.Pointer(&value[0]), 0); err != nil {
+			if err == unix.ENOENT {
+				// the entry was removed in the meantime
+				continue
+			}
+			return copied, err
+		}
+
+		if err := bpfMapElemCall(bpfMapUpdateElem, dst, pKey, unsafe.Pointer(&value[0]), 0); err != nil {
+			return copied, err
+		}
+		copied++
+	}
+}
+
+// Clear removes all the entries of the table, the entries of an array are reset to zero
+func (t *Table) Clear() error {
+	info, err := bpfGetMapInfo(t.Fd())
+	if err != nil {
+		return fmt.Errorf("failed to get the definition of table %s: %w", t.Name, err)
+	}
+
+	key := make([]byte, info.keySize)
+	if info.mapType == bpfMapTypeArray {
+		value := make([]byte, info.valueSize)
+		for i := uint32(0); i < info.maxEntries; i++ {
+			ByteOrder.PutUint32(key, i)
+			if err := bpfMapElemCall(bpfMapUpdateElem, t.Fd(), unsafe.Pointer(&key[0]), unsafe.Pointer(&value[0]), 0); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for {
+		// the first key is looked up each time as the previous one was deleted
+		if err := bpfMapElemCall(bpfMapGetNextKey, t.Fd(), nil, unsafe.Pointer(&key[0]), 0); err != nil {
+			if err == unix.ENOENT {
+				return nil
+			}
+			return err
+		}
+		if err := bpfMapElemCall(bpfMapDeleteElem, t.Fd(), unsafe.Pointer(&key[0]), nil, 0); err != nil && err != unix.ENOENT {
+			return err
+		}
+	}
+}
+
+// isCopyable returns whether the entries of the pinned map can be copied into the loaded map
+func isCopyable(pinned, loaded bpfMapInfo) bool {
+	switch pinned.mapType {
+	case bpfMapTypeHash, bpfMapTypeArray, bpfMapTypeLRUHash:
+	default:
+		return false
+	}
+
+	return pinned.mapType == loaded.mapType &&
+		pinned.keySize == loaded.keySize &&
+		pinned.valueSize == loaded.valueSize &&
+		pinned.maxEntries <= loaded.maxEntries
+}
+
+// Pin pins the table at path, on a bpf filesystem, so that the table outlives the process. When a table
+// is already pinned at path, its entries are first copied into this table if reuse is set and both tables
+// share the same definition. Otherwise, or when the definition changed, the pinned table is dropped and
+// recreated from this table. The pins are not removed when the process exits, they have to be removed
+// from the bpf filesystem once they are not needed anymore.
+func (t *Table) Pin(path string, reuse bool) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	var stat unix.Statfs_t
+	if err := unix.Statfs(dir, &stat); err != nil {
+		return err
+	}
+	if stat.Type != bpfFSMagic {
+		return fmt.Errorf("%s is not on a bpf filesystem", dir)
+	}
+
+	info, err := bpfGetMapInfo(t.Fd())
+	if err != nil {
+		return fmt.Errorf("failed to get the definition of table %s: %w", t.Name, err)
+	}
+
+	pinnedFd, err := bpfObjGetPath(path)
+	switch {
+	case err == nil:
+		defer unix.Close(pinnedFd)
+
+		pinnedInfo, err := bpfGetMapInfo(pinnedFd)
+		if err != nil {
+			return fmt.Errorf("failed to get the definition of the table pinned at %s: %w", path, err)
+		}
+
+		if !reuse {
+			log.Debugf("Recreating the table pinned at %s", path)
+		} else if !isCopyable(pinnedInfo, info) {
+			log.Infof("The definition of table %s changed, recreating the table pinned at %s", t.Name, path)
+		} else {
+			copied, err := copyMapEntries(pinnedFd, t.Fd(), info)
+			if err != nil {
+				return fmt.Errorf("failed to reuse the table pinned at %s: %w", path, err)
+			}
+			log.Debugf("Reused %d entries of the table pinned at %s", copied, path)
+		}
+
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+	case err != unix.ENOENT:
+		return fmt.Errorf("failed to open the table pinned at %s: %w", path, err)
+	}
+
+	return bpfObjPinPath(t.Fd(), path)
+}
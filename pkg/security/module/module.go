@@ -182,6 +182,11 @@ func LoadPolicies(config *config.Config, probe *sprobe.Probe) (*rules.RuleSet, e
 		}
 	}
 
+	// Fail fast when a rule relies on an event type that the probe can't report
+	if err := sprobe.ValidateRuleSet(ruleSet); err != nil {
+		result = multierror.Append(result, err)
+	}
+
 	return ruleSet, result.ErrorOrNil()
 }
 
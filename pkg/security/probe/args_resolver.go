@@ -0,0 +1,93 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/DataDog/datadog-agent/pkg/security/config"
+	"github.com/DataDog/datadog-agent/pkg/security/utils"
+)
+
+// maxCmdlineSize is the maximum number of bytes of a command line read from the proc fs
+const maxCmdlineSize = 128 * 1024
+
+// ArgsResolver resolves the arguments of the executed processes. They are captured once, when the process
+// cache entry of the process is resolved, and bounded by a maximum number of arguments and a maximum total
+// length. Arguments beyond these limits are dropped and the result is flagged as truncated, the kept
+// arguments are a prefix of the original ones so that rules can still match on them.
+type ArgsResolver struct {
+	enabled   bool
+	maxCount  int
+	maxLength int
+}
+
+// NewArgsResolver returns a new process arguments resolver
+func NewArgsResolver(config *config.Config) *ArgsResolver {
+	return &ArgsResolver{
+		enabled:   config.ExecArgsEnabled,
+		maxCount:  config.ExecArgsMaxCount,
+		maxLength: config.ExecArgsMaxLength,
+	}
+}
+
+// Resolve returns the arguments of a process and whether they were truncated. No argument is returned
+// if the capture is disabled or if the arguments couldn't be read.
+func (ar *ArgsResolver) Resolve(pid uint32) ([]string, bool) {
+	if !ar.enabled {
+		return nil, false
+	}
+
+	f, err := os.Open(utils.ProcCmdlinePath(pid))
+	if err != nil {
+		return nil, false
+	}
+	defer f.Close()
+
+	// only read what the limits can keep, each argument is followed by a separator. One more byte is
+	// read to detect a longer command line.
+	limit := int64(maxCmdlineSize)
+	if ar.maxCount > 0 && ar.maxLength > 0 && ar.maxCount+ar.maxLength < maxCmdlineSize {
+		limit = int64(ar.maxCount + ar.maxLength)
+	}
+
+	cmdline, err := ioutil.ReadAll(io.LimitReader(f, limit+1))
+	if err != nil || len(cmdline) == 0 {
+		return nil, false
+	}
+
+	args := bytes.Split(bytes.TrimRight(cmdline, "\x00"), []byte{0})
+	result, truncated := truncateArgs(args, ar.maxCount, ar.maxLength)
+	return result, truncated || int64(len(cmdline)) > limit
+}
+
+// truncateArgs keeps at most maxCount arguments and maxLength bytes, a limit lower or equal to 0 disables it
+func truncateArgs(args [][]byte, maxCount, maxLength int) ([]string, bool) {
+	var truncated bool
+	if maxCount > 0 && len(args) > maxCount {
+		args, truncated = args[:maxCount], true
+	}
+
+	result := make([]string, 0, len(args))
+	length := 0
+	for _, arg := range args {
+		if maxLength > 0 && length+len(arg) > maxLength {
+			if remaining := maxLength - length; remaining > 0 {
+				result = append(result, string(arg[:remaining]))
+			}
+			return result, true
+		}
+		result = append(result, string(arg))
+		length += len(arg)
+	}
+
+	return result, truncated
+}
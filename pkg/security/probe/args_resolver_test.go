@@ -0,0 +1,71 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTruncateArgs(t *testing.T) {
+	args := [][]byte{[]byte("ls"), []byte("-l"), []byte("/etc/passwd")}
+
+	tests := []struct {
+		name      string
+		maxCount  int
+		maxLength int
+		expected  []string
+		truncated bool
+	}{
+		{
+			name:      "no limit",
+			expected:  []string{"ls", "-l", "/etc/passwd"},
+			truncated: false,
+		},
+		{
+			name:      "within limits",
+			maxCount:  3,
+			maxLength: 15,
+			expected:  []string{"ls", "-l", "/etc/passwd"},
+			truncated: false,
+		},
+		{
+			name:      "max count",
+			maxCount:  2,
+			maxLength: 1024,
+			expected:  []string{"ls", "-l"},
+			truncated: true,
+		},
+		{
+			name:      "max length",
+			maxCount:  32,
+			maxLength: 8,
+			expected:  []string{"ls", "-l", "/etc"},
+			truncated: true,
+		},
+		{
+			name:      "max length on argument boundary",
+			maxCount:  32,
+			maxLength: 4,
+			expected:  []string{"ls", "-l"},
+			truncated: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, truncated := truncateArgs(args, test.maxCount, test.maxLength)
+			if !reflect.DeepEqual(result, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, result)
+			}
+			if truncated != test.truncated {
+				t.Errorf("expected truncated to be %v, got %v", test.truncated, truncated)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import (
+	"github.com/DataDog/datadog-agent/pkg/security/ebpf"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/eval"
+)
+
+// bindHookPoints holds the list of bind's kProbes. The bound address is read by security_socket_bind, once
+// copied from user space, only the IPv4 and IPv6 addresses being captured.
+var bindHookPoints = []*HookPoint{
+	{
+		Name:    "sys_bind",
+		KProbes: syscallKprobe("bind"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"bind": {},
+		},
+	},
+	{
+		Name: "security_socket_bind",
+		KProbes: []*ebpf.KProbe{{
+			EntryFunc: "kprobe/security_socket_bind",
+		}},
+		EventTypes: map[eval.EventType]Capabilities{
+			"bind": {},
+		},
+	},
+}
@@ -0,0 +1,44 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import (
+	"github.com/DataDog/datadog-agent/pkg/security/ebpf"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/eval"
+)
+
+// chdirHookPoints holds the list of chdir's kProbes, they are always attached to keep the working directory of the
+// process cache entries up to date
+var chdirHookPoints = []*HookPoint{
+	{
+		Name:    "sys_chdir",
+		KProbes: syscallKprobe("chdir"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"*":     {},
+			"chdir": {},
+		},
+	},
+	{
+		Name:    "sys_fchdir",
+		KProbes: syscallKprobe("fchdir"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"*":     {},
+			"chdir": {},
+		},
+	},
+	{
+		Name: "set_fs_pwd",
+		KProbes: []*ebpf.KProbe{{
+			EntryFunc: "kprobe/set_fs_pwd",
+		}},
+		EventTypes: map[eval.EventType]Capabilities{
+			"*":     {},
+			"chdir": {},
+		},
+	},
+}
@@ -0,0 +1,31 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import "github.com/DataDog/datadog-agent/pkg/security/secl/eval"
+
+// cloneHookPoints holds the list of clone's kProbes. The event is sent by the parent once the syscall
+// returns, with the pid of the new task as return value. clone3 is only available since Linux 5.3,
+// the hook point is optional.
+var cloneHookPoints = []*HookPoint{
+	{
+		Name:    "sys_clone",
+		KProbes: syscallKprobe("clone"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"clone": {},
+		},
+	},
+	{
+		Name:    "sys_clone3",
+		KProbes: syscallKprobe("clone3"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"clone": {},
+		},
+		Optional: true,
+	},
+}
@@ -10,6 +10,7 @@ package probe
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"syscall"
 
@@ -46,6 +47,50 @@ const (
 	FileMountEventType
 	// FileUmountEventType - Umount event
 	FileUmountEventType
+	// FileGetXattrEventType - Getxattr event
+	FileGetXattrEventType
+	// FileChdirEventType - Chdir event
+	FileChdirEventType
+	// FileMemfdEventType - Memfd event
+	FileMemfdEventType
+	// FileFallocateEventType - Fallocate event
+	FileFallocateEventType
+	// FileDupEventType - Dup event
+	FileDupEventType
+	// FileSetrlimitEventType - Setrlimit event
+	FileSetrlimitEventType
+	// FileSetnsEventType - Setns event
+	FileSetnsEventType
+	// FileQuotactlEventType - Quotactl event
+	FileQuotactlEventType
+	// FilePipeEventType - Pipe event
+	FilePipeEventType
+	// FileIoctlEventType - Ioctl event
+	FileIoctlEventType
+	// FileUmaskEventType - Umask event
+	FileUmaskEventType
+	// FileStatEventType - Stat event
+	FileStatEventType
+	// FileWriteEventType - Write event
+	FileWriteEventType
+	// FileCloneEventType - Clone event
+	FileCloneEventType
+	// FileSendfileEventType - Sendfile event
+	FileSendfileEventType
+	// FileKeyctlEventType - Keyctl event
+	FileKeyctlEventType
+	// FilePrctlEventType - Prctl event
+	FilePrctlEventType
+	// FileLoadModuleEventType - Load module event
+	FileLoadModuleEventType
+	// BindEventType - Bind event
+	BindEventType
+	// ExecEventType - Exec event, used to resolve the process cache entry of the new process image
+	ExecEventType
+	// ForkEventType - Fork event, used to copy the process cache entry of the parent to the child
+	ForkEventType
+	// ExitEventType - Exit event, used to drop the process cache entry of the process
+	ExitEventType
 	// internalEventType - used internally to get the maximum number of event. Has to be the last one
 	maxEventType
 )
@@ -74,6 +119,50 @@ func (t EventType) String() string {
 		return "mount"
 	case FileUmountEventType:
 		return "umount"
+	case FileGetXattrEventType:
+		return "getxattr"
+	case FileChdirEventType:
+		return "chdir"
+	case FileMemfdEventType:
+		return "memfd"
+	case FileFallocateEventType:
+		return "fallocate"
+	case FileDupEventType:
+		return "dup"
+	case FileSetrlimitEventType:
+		return "setrlimit"
+	case FileSetnsEventType:
+		return "setns"
+	case FileQuotactlEventType:
+		return "quotactl"
+	case FilePipeEventType:
+		return "pipe"
+	case FileIoctlEventType:
+		return "ioctl"
+	case FileUmaskEventType:
+		return "umask"
+	case FileStatEventType:
+		return "stat"
+	case FileWriteEventType:
+		return "write"
+	case FileCloneEventType:
+		return "clone"
+	case FileSendfileEventType:
+		return "sendfile"
+	case FileKeyctlEventType:
+		return "keyctl"
+	case FilePrctlEventType:
+		return "prctl"
+	case FileLoadModuleEventType:
+		return "load_module"
+	case BindEventType:
+		return "bind"
+	case ExecEventType:
+		return "exec"
+	case ForkEventType:
+		return "fork"
+	case ExitEventType:
+		return "exit"
 	}
 	return "unknown"
 }
@@ -240,6 +329,19 @@ var (
 		"O_RSYNC":     syscall.O_RSYNC,
 	}
 
+	// openFlagsAliases are the open flags sharing their value with another flag or mask, they
+	// are available in the rules but not used to decode the flags
+	openFlagsAliases = map[string]bool{
+		"O_RDONLY":    true,
+		"O_WRONLY":    true,
+		"O_RDWR":      true,
+		"O_ACCMODE":   true,
+		"O_FSYNC":     true,
+		"O_NDELAY":    true,
+		"O_RSYNC":     true,
+		"O_LARGEFILE": syscall.O_LARGEFILE == 0,
+	}
+
 	chmodModeConstants = map[string]int{
 		//"S_IEXEC":  syscall.S_IEXEC, deprecated
 		"S_IFBLK":  syscall.S_IFBLK,
@@ -273,6 +375,234 @@ var (
 		"AT_REMOVEDIR": unix.AT_REMOVEDIR,
 	}
 
+	memfdFlagsConstants = map[string]int{
+		"MFD_CLOEXEC":       unix.MFD_CLOEXEC,
+		"MFD_ALLOW_SEALING": unix.MFD_ALLOW_SEALING,
+		"MFD_HUGETLB":       unix.MFD_HUGETLB,
+	}
+
+	mountFlagsConstants = map[string]int{
+		"MS_RDONLY":      unix.MS_RDONLY,
+		"MS_NOSUID":      unix.MS_NOSUID,
+		"MS_NODEV":       unix.MS_NODEV,
+		"MS_NOEXEC":      unix.MS_NOEXEC,
+		"MS_SYNCHRONOUS": unix.MS_SYNCHRONOUS,
+		"MS_REMOUNT":     unix.MS_REMOUNT,
+		"MS_MANDLOCK":    unix.MS_MANDLOCK,
+		"MS_DIRSYNC":     unix.MS_DIRSYNC,
+		"MS_NOATIME":     unix.MS_NOATIME,
+		"MS_NODIRATIME":  unix.MS_NODIRATIME,
+		"MS_BIND":        unix.MS_BIND,
+		"MS_MOVE":        unix.MS_MOVE,
+		"MS_REC":         unix.MS_REC,
+		"MS_SILENT":      unix.MS_SILENT,
+		"MS_POSIXACL":    unix.MS_POSIXACL,
+		"MS_UNBINDABLE":  unix.MS_UNBINDABLE,
+		"MS_PRIVATE":     unix.MS_PRIVATE,
+		"MS_SLAVE":       unix.MS_SLAVE,
+		"MS_SHARED":      unix.MS_SHARED,
+		"MS_RELATIME":    unix.MS_RELATIME,
+		"MS_STRICTATIME": unix.MS_STRICTATIME,
+		"MS_LAZYTIME":    unix.MS_LAZYTIME,
+	}
+
+	umountFlagsConstants = map[string]int{
+		"MNT_FORCE":       unix.MNT_FORCE,
+		"MNT_DETACH":      unix.MNT_DETACH,
+		"MNT_EXPIRE":      unix.MNT_EXPIRE,
+		"UMOUNT_NOFOLLOW": unix.UMOUNT_NOFOLLOW,
+	}
+
+	fallocateModeConstants = map[string]int{
+		"FALLOC_FL_KEEP_SIZE":      unix.FALLOC_FL_KEEP_SIZE,
+		"FALLOC_FL_PUNCH_HOLE":     unix.FALLOC_FL_PUNCH_HOLE,
+		"FALLOC_FL_NO_HIDE_STALE":  unix.FALLOC_FL_NO_HIDE_STALE,
+		"FALLOC_FL_COLLAPSE_RANGE": unix.FALLOC_FL_COLLAPSE_RANGE,
+		"FALLOC_FL_ZERO_RANGE":     unix.FALLOC_FL_ZERO_RANGE,
+		"FALLOC_FL_INSERT_RANGE":   unix.FALLOC_FL_INSERT_RANGE,
+	}
+
+	rlimitResourceConstants = map[string]int{
+		"RLIMIT_CPU":    unix.RLIMIT_CPU,
+		"RLIMIT_FSIZE":  unix.RLIMIT_FSIZE,
+		"RLIMIT_DATA":   unix.RLIMIT_DATA,
+		"RLIMIT_STACK":  unix.RLIMIT_STACK,
+		"RLIMIT_CORE":   unix.RLIMIT_CORE,
+		"RLIMIT_NOFILE": unix.RLIMIT_NOFILE,
+		"RLIMIT_AS":     unix.RLIMIT_AS,
+	}
+
+	namespaceTypeConstants = map[string]int{
+		"CLONE_NEWNS":     unix.CLONE_NEWNS,
+		"CLONE_NEWCGROUP": unix.CLONE_NEWCGROUP,
+		"CLONE_NEWUTS":    unix.CLONE_NEWUTS,
+		"CLONE_NEWIPC":    unix.CLONE_NEWIPC,
+		"CLONE_NEWUSER":   unix.CLONE_NEWUSER,
+		"CLONE_NEWPID":    unix.CLONE_NEWPID,
+		"CLONE_NEWNET":    unix.CLONE_NEWNET,
+	}
+
+	// cloneFlagsConstants holds the clone flags besides the namespace ones, shared with setns
+	cloneFlagsConstants = map[string]int{
+		"CLONE_VM":       unix.CLONE_VM,
+		"CLONE_FS":       unix.CLONE_FS,
+		"CLONE_FILES":    unix.CLONE_FILES,
+		"CLONE_SIGHAND":  unix.CLONE_SIGHAND,
+		"CLONE_PTRACE":   unix.CLONE_PTRACE,
+		"CLONE_VFORK":    unix.CLONE_VFORK,
+		"CLONE_PARENT":   unix.CLONE_PARENT,
+		"CLONE_THREAD":   unix.CLONE_THREAD,
+		"CLONE_SYSVSEM":  unix.CLONE_SYSVSEM,
+		"CLONE_SETTLS":   unix.CLONE_SETTLS,
+		"CLONE_UNTRACED": unix.CLONE_UNTRACED,
+		"CLONE_IO":       unix.CLONE_IO,
+	}
+
+	pipeFlagsConstants = map[string]int{
+		"O_CLOEXEC":  unix.O_CLOEXEC,
+		"O_DIRECT":   unix.O_DIRECT,
+		"O_NONBLOCK": unix.O_NONBLOCK,
+	}
+
+	// ioctlRequestConstants holds the ioctl request codes that can be referenced by name in the configuration
+	ioctlRequestConstants = map[string]int{
+		"TIOCSTI":   unix.TIOCSTI,
+		"TIOCSCTTY": unix.TIOCSCTTY,
+		"TIOCSETD":  unix.TIOCSETD,
+		"TIOCLINUX": unix.TIOCLINUX,
+	}
+
+	// quotactl sub-commands and quota types as defined in linux/quota.h
+	quotactlCmdConstants = map[string]int{
+		"Q_SYNC":     0x800001,
+		"Q_QUOTAON":  0x800002,
+		"Q_QUOTAOFF": 0x800003,
+		"Q_GETFMT":   0x800004,
+		"Q_GETINFO":  0x800005,
+		"Q_SETINFO":  0x800006,
+		"Q_GETQUOTA": 0x800007,
+		"Q_SETQUOTA": 0x800008,
+	}
+
+	quotaTypeConstants = map[string]int{
+		"USRQUOTA": 0,
+		"GRPQUOTA": 1,
+		"PRJQUOTA": 2,
+	}
+
+	// keyctl operations as defined in linux/keyctl.h, add_key is reported as the ADD_KEY operation
+	keyctlOperationConstants = map[string]int{
+		"ADD_KEY":                     -1,
+		"KEYCTL_GET_KEYRING_ID":       0,
+		"KEYCTL_JOIN_SESSION_KEYRING": 1,
+		"KEYCTL_UPDATE":               2,
+		"KEYCTL_REVOKE":               3,
+		"KEYCTL_CHOWN":                4,
+		"KEYCTL_SETPERM":              5,
+		"KEYCTL_DESCRIBE":             6,
+		"KEYCTL_CLEAR":                7,
+		"KEYCTL_LINK":                 8,
+		"KEYCTL_UNLINK":               9,
+		"KEYCTL_SEARCH":               10,
+		"KEYCTL_READ":                 11,
+		"KEYCTL_INSTANTIATE":          12,
+		"KEYCTL_NEGATE":               13,
+		"KEYCTL_SET_REQKEY_KEYRING":   14,
+		"KEYCTL_SET_TIMEOUT":          15,
+		"KEYCTL_ASSUME_AUTHORITY":     16,
+		"KEYCTL_GET_SECURITY":         17,
+		"KEYCTL_SESSION_TO_PARENT":    18,
+		"KEYCTL_REJECT":               19,
+		"KEYCTL_INSTANTIATE_IOV":      20,
+		"KEYCTL_INVALIDATE":           21,
+		"KEYCTL_GET_PERSISTENT":       22,
+		"KEYCTL_DH_COMPUTE":           23,
+		"KEYCTL_PKEY_QUERY":           24,
+		"KEYCTL_PKEY_ENCRYPT":         25,
+		"KEYCTL_PKEY_DECRYPT":         26,
+		"KEYCTL_PKEY_SIGN":            27,
+		"KEYCTL_PKEY_VERIFY":          28,
+		"KEYCTL_RESTRICT_KEYRING":     29,
+		"KEYCTL_MOVE":                 30,
+		"KEYCTL_CAPABILITIES":         31,
+	}
+
+	// special key IDs referring to the keyrings of the calling process
+	keySpecConstants = map[string]int{
+		"KEY_SPEC_THREAD_KEYRING":       -1,
+		"KEY_SPEC_PROCESS_KEYRING":      -2,
+		"KEY_SPEC_SESSION_KEYRING":      -3,
+		"KEY_SPEC_USER_KEYRING":         -4,
+		"KEY_SPEC_USER_SESSION_KEYRING": -5,
+		"KEY_SPEC_GROUP_KEYRING":        -6,
+		"KEY_SPEC_REQKEY_AUTH_KEY":      -7,
+		"KEY_SPEC_REQUESTOR_KEYRING":    -8,
+	}
+
+	// prctl options as defined in linux/prctl.h
+	prctlOptionConstants = map[string]int{
+		"PR_SET_PDEATHSIG":            unix.PR_SET_PDEATHSIG,
+		"PR_GET_PDEATHSIG":            unix.PR_GET_PDEATHSIG,
+		"PR_GET_DUMPABLE":             unix.PR_GET_DUMPABLE,
+		"PR_SET_DUMPABLE":             unix.PR_SET_DUMPABLE,
+		"PR_GET_UNALIGN":              unix.PR_GET_UNALIGN,
+		"PR_SET_UNALIGN":              unix.PR_SET_UNALIGN,
+		"PR_GET_KEEPCAPS":             unix.PR_GET_KEEPCAPS,
+		"PR_SET_KEEPCAPS":             unix.PR_SET_KEEPCAPS,
+		"PR_GET_FPEMU":                unix.PR_GET_FPEMU,
+		"PR_SET_FPEMU":                unix.PR_SET_FPEMU,
+		"PR_GET_FPEXC":                unix.PR_GET_FPEXC,
+		"PR_SET_FPEXC":                unix.PR_SET_FPEXC,
+		"PR_GET_TIMING":               unix.PR_GET_TIMING,
+		"PR_SET_TIMING":               unix.PR_SET_TIMING,
+		"PR_SET_NAME":                 unix.PR_SET_NAME,
+		"PR_GET_NAME":                 unix.PR_GET_NAME,
+		"PR_GET_ENDIAN":               unix.PR_GET_ENDIAN,
+		"PR_SET_ENDIAN":               unix.PR_SET_ENDIAN,
+		"PR_GET_SECCOMP":              unix.PR_GET_SECCOMP,
+		"PR_SET_SECCOMP":              unix.PR_SET_SECCOMP,
+		"PR_CAPBSET_READ":             unix.PR_CAPBSET_READ,
+		"PR_CAPBSET_DROP":             unix.PR_CAPBSET_DROP,
+		"PR_GET_TSC":                  unix.PR_GET_TSC,
+		"PR_SET_TSC":                  unix.PR_SET_TSC,
+		"PR_GET_SECUREBITS":           unix.PR_GET_SECUREBITS,
+		"PR_SET_SECUREBITS":           unix.PR_SET_SECUREBITS,
+		"PR_SET_TIMERSLACK":           unix.PR_SET_TIMERSLACK,
+		"PR_GET_TIMERSLACK":           unix.PR_GET_TIMERSLACK,
+		"PR_TASK_PERF_EVENTS_DISABLE": unix.PR_TASK_PERF_EVENTS_DISABLE,
+		"PR_TASK_PERF_EVENTS_ENABLE":  unix.PR_TASK_PERF_EVENTS_ENABLE,
+		"PR_MCE_KILL":                 unix.PR_MCE_KILL,
+		"PR_MCE_KILL_GET":             unix.PR_MCE_KILL_GET,
+		"PR_SET_MM":                   unix.PR_SET_MM,
+		"PR_SET_PTRACER":              unix.PR_SET_PTRACER,
+		"PR_SET_CHILD_SUBREAPER":      unix.PR_SET_CHILD_SUBREAPER,
+		"PR_GET_CHILD_SUBREAPER":      unix.PR_GET_CHILD_SUBREAPER,
+		"PR_SET_NO_NEW_PRIVS":         unix.PR_SET_NO_NEW_PRIVS,
+		"PR_GET_NO_NEW_PRIVS":         unix.PR_GET_NO_NEW_PRIVS,
+		"PR_GET_TID_ADDRESS":          unix.PR_GET_TID_ADDRESS,
+		"PR_SET_THP_DISABLE":          unix.PR_SET_THP_DISABLE,
+		"PR_GET_THP_DISABLE":          unix.PR_GET_THP_DISABLE,
+		"PR_MPX_ENABLE_MANAGEMENT":    unix.PR_MPX_ENABLE_MANAGEMENT,
+		"PR_MPX_DISABLE_MANAGEMENT":   unix.PR_MPX_DISABLE_MANAGEMENT,
+		"PR_SET_FP_MODE":              unix.PR_SET_FP_MODE,
+		"PR_GET_FP_MODE":              unix.PR_GET_FP_MODE,
+		"PR_CAP_AMBIENT":              unix.PR_CAP_AMBIENT,
+		"PR_SVE_SET_VL":               unix.PR_SVE_SET_VL,
+		"PR_SVE_GET_VL":               unix.PR_SVE_GET_VL,
+		"PR_GET_SPECULATION_CTRL":     unix.PR_GET_SPECULATION_CTRL,
+		"PR_SET_SPECULATION_CTRL":     unix.PR_SET_SPECULATION_CTRL,
+		"PR_PAC_RESET_KEYS":           unix.PR_PAC_RESET_KEYS,
+		"PR_SET_TAGGED_ADDR_CTRL":     unix.PR_SET_TAGGED_ADDR_CTRL,
+		"PR_GET_TAGGED_ADDR_CTRL":     unix.PR_GET_TAGGED_ADDR_CTRL,
+	}
+
+	// addressFamilyConstants are the address families of the bound sockets
+	addressFamilyConstants = map[string]int{
+		"AF_UNIX":  unix.AF_UNIX,
+		"AF_INET":  unix.AF_INET,
+		"AF_INET6": unix.AF_INET6,
+	}
+
 	// SECLConstants are constants available in runtime security agent rules
 	SECLConstants = map[string]interface{}{
 		// boolean
@@ -282,9 +612,23 @@ var (
 )
 
 var (
-	openFlagsStrings   = map[int]string{}
-	chmodModeStrings   = map[int]string{}
-	unlinkFlagsStrings = map[int]string{}
+	openFlagsStrings       = map[int]string{}
+	chmodModeStrings       = map[int]string{}
+	unlinkFlagsStrings     = map[int]string{}
+	memfdFlagsStrings      = map[int]string{}
+	fallocateModeStrings   = map[int]string{}
+	mountFlagsStrings      = map[int]string{}
+	umountFlagsStrings     = map[int]string{}
+	rlimitResourceStrings  = map[int]string{}
+	namespaceTypeStrings   = map[int]string{}
+	cloneFlagsStrings      = map[int]string{}
+	quotactlCmdStrings     = map[int]string{}
+	quotaTypeStrings       = map[int]string{}
+	pipeFlagsStrings       = map[int]string{}
+	ioctlRequestStrings    = map[int]string{}
+	keyctlOperationStrings = map[int]string{}
+	prctlOptionStrings     = map[int]string{}
+	addressFamilyStrings   = map[int]string{}
 )
 
 func initOpenConstants() {
@@ -293,7 +637,9 @@ func initOpenConstants() {
 	}
 
 	for k, v := range openFlagsConstants {
-		openFlagsStrings[v] = k
+		if !openFlagsAliases[k] {
+			openFlagsStrings[v] = k
+		}
 	}
 }
 
@@ -317,6 +663,152 @@ func initUnlinkConstanst() {
 	}
 }
 
+func initMemfdConstants() {
+	for k, v := range memfdFlagsConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+	}
+
+	for k, v := range memfdFlagsConstants {
+		memfdFlagsStrings[v] = k
+	}
+}
+
+func initFallocateConstants() {
+	for k, v := range fallocateModeConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+	}
+
+	for k, v := range fallocateModeConstants {
+		fallocateModeStrings[v] = k
+	}
+}
+
+func initMountConstants() {
+	for k, v := range mountFlagsConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+	}
+
+	for k, v := range mountFlagsConstants {
+		mountFlagsStrings[v] = k
+	}
+}
+
+func initUmountConstants() {
+	for k, v := range umountFlagsConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+	}
+
+	for k, v := range umountFlagsConstants {
+		umountFlagsStrings[v] = k
+	}
+}
+
+func initRlimitConstants() {
+	for k, v := range rlimitResourceConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+	}
+
+	for k, v := range rlimitResourceConstants {
+		rlimitResourceStrings[v] = k
+	}
+
+	// limits are reported as signed integers, an unlimited resource is then reported as -1
+	SECLConstants["RLIM_INFINITY"] = &eval.IntEvaluator{Value: unix.RLIM_INFINITY}
+}
+
+func initNamespaceConstants() {
+	for k, v := range namespaceTypeConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+	}
+
+	for k, v := range namespaceTypeConstants {
+		namespaceTypeStrings[v] = k
+	}
+}
+
+func initCloneConstants() {
+	for k, v := range cloneFlagsConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+	}
+
+	for k, v := range cloneFlagsConstants {
+		cloneFlagsStrings[v] = k
+	}
+
+	for k, v := range namespaceTypeConstants {
+		cloneFlagsStrings[v] = k
+	}
+}
+
+func initQuotactlConstants() {
+	for k, v := range quotactlCmdConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+	}
+
+	for k, v := range quotactlCmdConstants {
+		quotactlCmdStrings[v] = k
+	}
+
+	for k, v := range quotaTypeConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+	}
+
+	for k, v := range quotaTypeConstants {
+		quotaTypeStrings[v] = k
+	}
+}
+
+func initPipeConstants() {
+	// the pipe flags are open flags, the SECL constants are already defined by initOpenConstants
+	for k, v := range pipeFlagsConstants {
+		pipeFlagsStrings[v] = k
+	}
+}
+
+func initIoctlConstants() {
+	for k, v := range ioctlRequestConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+	}
+
+	for k, v := range ioctlRequestConstants {
+		ioctlRequestStrings[v] = k
+	}
+}
+
+func initKeyctlConstants() {
+	for k, v := range keyctlOperationConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+	}
+
+	for k, v := range keyctlOperationConstants {
+		keyctlOperationStrings[v] = k
+	}
+
+	for k, v := range keySpecConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+	}
+}
+
+func initPrctlConstants() {
+	for k, v := range prctlOptionConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+	}
+
+	for k, v := range prctlOptionConstants {
+		prctlOptionStrings[v] = k
+	}
+}
+
+func initBindConstants() {
+	for k, v := range addressFamilyConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+	}
+
+	for k, v := range addressFamilyConstants {
+		addressFamilyStrings[v] = k
+	}
+}
+
 func initErrorConstants() {
 	for k, v := range errorConstants {
 		SECLConstants[k] = &eval.IntEvaluator{Value: v}
@@ -328,6 +820,19 @@ func initConstants() {
 	initOpenConstants()
 	initChmodConstants()
 	initUnlinkConstanst()
+	initMemfdConstants()
+	initFallocateConstants()
+	initMountConstants()
+	initUmountConstants()
+	initRlimitConstants()
+	initNamespaceConstants()
+	initCloneConstants()
+	initQuotactlConstants()
+	initPipeConstants()
+	initIoctlConstants()
+	initKeyctlConstants()
+	initPrctlConstants()
+	initBindConstants()
 }
 
 func bitmaskToString(bitmask int, intToStrMap map[int]string) string {
@@ -357,8 +862,24 @@ func bitmaskToString(bitmask int, intToStrMap map[int]string) string {
 // OpenFlags represents an open flags bitmask value
 type OpenFlags int
 
+// String returns the access mode of the flags followed by the other flags, for example "O_WRONLY | O_CREAT | O_TRUNC"
 func (f OpenFlags) String() string {
-	return bitmaskToString(int(f), openFlagsStrings)
+	var accessMode string
+	switch int(f) & syscall.O_ACCMODE {
+	case syscall.O_RDONLY:
+		accessMode = "O_RDONLY"
+	case syscall.O_WRONLY:
+		accessMode = "O_WRONLY"
+	case syscall.O_RDWR:
+		accessMode = "O_RDWR"
+	default:
+		accessMode = "O_ACCMODE"
+	}
+
+	if flags := int(f) &^ syscall.O_ACCMODE; flags != 0 {
+		return accessMode + " | " + bitmaskToString(flags, openFlagsStrings)
+	}
+	return accessMode
 }
 
 // ChmodMode represent a chmod mode bitmask value
@@ -375,6 +896,129 @@ func (f UnlinkFlags) String() string {
 	return bitmaskToString(int(f), unlinkFlagsStrings)
 }
 
+// MemfdFlags represents a memfd_create flags bitmask value
+type MemfdFlags int
+
+func (f MemfdFlags) String() string {
+	return bitmaskToString(int(f), memfdFlagsStrings)
+}
+
+// FallocateMode represents a fallocate mode bitmask value
+type FallocateMode int
+
+func (m FallocateMode) String() string {
+	return bitmaskToString(int(m), fallocateModeStrings)
+}
+
+// MountFlags represents a mount flags bitmask value
+type MountFlags int
+
+func (f MountFlags) String() string {
+	return bitmaskToString(int(f), mountFlagsStrings)
+}
+
+// UmountFlags represents an umount2 flags bitmask value
+type UmountFlags int
+
+func (f UmountFlags) String() string {
+	return bitmaskToString(int(f), umountFlagsStrings)
+}
+
+// RlimitResource represents a resource limited by setrlimit
+type RlimitResource int
+
+func (r RlimitResource) String() string {
+	if s, found := rlimitResourceStrings[int(r)]; found {
+		return s
+	}
+	return strconv.Itoa(int(r))
+}
+
+// NamespaceType represents a setns namespace type bitmask value
+type NamespaceType int
+
+func (t NamespaceType) String() string {
+	return bitmaskToString(int(t), namespaceTypeStrings)
+}
+
+// cloneSignalMask masks the lowest byte of the clone flags, which holds the signal sent to the parent when
+// the child exits rather than a flag
+const cloneSignalMask = 0xff
+
+// CloneFlags represents a clone flags bitmask value
+type CloneFlags int
+
+func (f CloneFlags) String() string {
+	return bitmaskToString(int(f)&^cloneSignalMask, cloneFlagsStrings)
+}
+
+// PipeFlags represents a pipe2 flags bitmask value
+type PipeFlags int
+
+func (f PipeFlags) String() string {
+	return bitmaskToString(int(f), pipeFlagsStrings)
+}
+
+// IoctlRequest represents an ioctl request code
+type IoctlRequest int
+
+func (r IoctlRequest) String() string {
+	if s, found := ioctlRequestStrings[int(r)]; found {
+		return s
+	}
+	return fmt.Sprintf("0x%x", int(r))
+}
+
+// QuotactlCmd represents a quotactl sub-command
+type QuotactlCmd int
+
+func (c QuotactlCmd) String() string {
+	if s, found := quotactlCmdStrings[int(c)]; found {
+		return s
+	}
+	return strconv.Itoa(int(c))
+}
+
+// QuotaType represents the type of quota targeted by quotactl
+type QuotaType int
+
+func (t QuotaType) String() string {
+	if s, found := quotaTypeStrings[int(t)]; found {
+		return s
+	}
+	return strconv.Itoa(int(t))
+}
+
+// KeyctlOperation represents a keyctl operation
+type KeyctlOperation int
+
+func (o KeyctlOperation) String() string {
+	if s, found := keyctlOperationStrings[int(o)]; found {
+		return s
+	}
+	return strconv.Itoa(int(o))
+}
+
+// PrctlOption represents a prctl option
+type PrctlOption int
+
+func (o PrctlOption) String() string {
+	if s, found := prctlOptionStrings[int(o)]; found {
+		return s
+	}
+	return strconv.Itoa(int(o))
+}
+
+// AddressFamily represents the address family of a socket
+type AddressFamily int
+
+func (f AddressFamily) String() string {
+	if s, found := addressFamilyStrings[int(f)]; found {
+		return s
+	}
+	return strconv.Itoa(int(f))
+}
+
 // ReturnValue represents a syscall return value
 type RetValError int
 
@@ -15,7 +15,7 @@ import (
 
 func TestFlagsToString(t *testing.T) {
 	str := OpenFlags(syscall.O_EXCL | syscall.O_TRUNC).String()
-	if str != "O_EXCL | O_TRUNC" {
+	if str != "O_RDONLY | O_EXCL | O_TRUNC" {
 		t.Errorf("expexted flags not found, got: %s", str)
 	}
 
@@ -25,7 +25,27 @@ func TestFlagsToString(t *testing.T) {
 	}
 
 	str = OpenFlags(syscall.O_EXCL | syscall.O_TRUNC | 1<<32).String()
-	if str != fmt.Sprintf("%d | O_EXCL | O_TRUNC", 1<<32) {
+	if str != fmt.Sprintf("O_RDONLY | %d | O_EXCL | O_TRUNC", 1<<32) {
+		t.Errorf("expexted flags not found, got: %s", str)
+	}
+
+	str = OpenFlags(syscall.O_WRONLY | syscall.O_CREAT | syscall.O_TRUNC).String()
+	if str != "O_WRONLY | O_CREAT | O_TRUNC" {
+		t.Errorf("expexted flags not found, got: %s", str)
+	}
+
+	str = OpenFlags(syscall.O_RDWR | syscall.O_NONBLOCK).String()
+	if str != "O_RDWR | O_NONBLOCK" {
+		t.Errorf("expexted flags not found, got: %s", str)
+	}
+
+	str = MountFlags(syscall.MS_REMOUNT | syscall.MS_BIND | syscall.MS_RDONLY).String()
+	if str != "MS_BIND | MS_RDONLY | MS_REMOUNT" {
+		t.Errorf("expexted flags not found, got: %s", str)
+	}
+
+	str = CloneFlags(syscall.CLONE_THREAD | syscall.CLONE_NEWNS | int(syscall.SIGCHLD)).String()
+	if str != "CLONE_NEWNS | CLONE_THREAD" {
 		t.Errorf("expexted flags not found, got: %s", str)
 	}
 }
@@ -0,0 +1,35 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import "github.com/DataDog/datadog-agent/pkg/security/secl/eval"
+
+// dupHookPoints holds the list of dup's kProbes
+var dupHookPoints = []*HookPoint{
+	{
+		Name:    "sys_dup",
+		KProbes: syscallKprobe("dup"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"dup": {},
+		},
+	},
+	{
+		Name:    "sys_dup2",
+		KProbes: syscallKprobe("dup2"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"dup": {},
+		},
+	},
+	{
+		Name:    "sys_dup3",
+		KProbes: syscallKprobe("dup3"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"dup": {},
+		},
+	},
+}
@@ -18,19 +18,15 @@ var execTables = []string{
 // execHookPoints holds the list of hookpoints to track processes execution
 var execHookPoints = []*HookPoint{
 	{
-		Name: "sys_execve",
-		KProbes: []*ebpf.KProbe{{
-			EntryFunc: "kprobe/" + getSyscallFnName("execve"),
-		}},
+		Name:    "sys_execve",
+		KProbes: syscallKprobe("execve"),
 		EventTypes: map[string]Capabilities{
 			"*": {},
 		},
 	},
 	{
-		Name: "sys_execveat",
-		KProbes: []*ebpf.KProbe{{
-			EntryFunc: "kprobe/" + getSyscallFnName("execveat"),
-		}},
+		Name:    "sys_execveat",
+		KProbes: syscallKprobe("execveat"),
 		EventTypes: map[string]Capabilities{
 			"*": {},
 		},
@@ -43,6 +39,15 @@ var execHookPoints = []*HookPoint{
 			"*": {},
 		},
 	},
+	{
+		Name: "wake_up_new_task",
+		KProbes: []*ebpf.KProbe{{
+			EntryFunc: "kprobe/wake_up_new_task",
+		}},
+		EventTypes: map[string]Capabilities{
+			"*": {},
+		},
+	},
 	{
 		Name: "do_exit",
 		KProbes: []*ebpf.KProbe{{
@@ -0,0 +1,33 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import (
+	"github.com/DataDog/datadog-agent/pkg/security/ebpf"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/eval"
+)
+
+// fallocateHookPoints holds the list of fallocate's kProbes
+var fallocateHookPoints = []*HookPoint{
+	{
+		Name:    "sys_fallocate",
+		KProbes: syscallKprobe("fallocate"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"fallocate": {},
+		},
+	},
+	{
+		Name: "vfs_fallocate",
+		KProbes: []*ebpf.KProbe{{
+			EntryFunc: "kprobe/vfs_fallocate",
+		}},
+		EventTypes: map[eval.EventType]Capabilities{
+			"fallocate": {},
+		},
+	},
+}
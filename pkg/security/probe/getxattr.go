@@ -0,0 +1,87 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import (
+	"github.com/DataDog/datadog-agent/pkg/security/ebpf"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/eval"
+)
+
+// getxattrHookPoints holds the list of getxattr's kProbes. Extended attributes are read at a
+// very high frequency (ls, file managers, capability and SELinux label lookups...), those hook
+// points are optional and only attached when a loaded rule relies on the getxattr event type.
+var getxattrHookPoints = []*HookPoint{
+	{
+		Name:    "sys_getxattr",
+		KProbes: syscallKprobe("getxattr"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"getxattr": {},
+		},
+		Optional: true,
+	},
+	{
+		Name:    "sys_lgetxattr",
+		KProbes: syscallKprobe("lgetxattr"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"getxattr": {},
+		},
+		Optional: true,
+	},
+	{
+		Name:    "sys_fgetxattr",
+		KProbes: syscallKprobe("fgetxattr"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"getxattr": {},
+		},
+		Optional: true,
+	},
+	{
+		Name:    "sys_listxattr",
+		KProbes: syscallKprobe("listxattr"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"getxattr": {},
+		},
+		Optional: true,
+	},
+	{
+		Name:    "sys_llistxattr",
+		KProbes: syscallKprobe("llistxattr"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"getxattr": {},
+		},
+		Optional: true,
+	},
+	{
+		Name:    "sys_flistxattr",
+		KProbes: syscallKprobe("flistxattr"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"getxattr": {},
+		},
+		Optional: true,
+	},
+	{
+		Name: "vfs_getxattr",
+		KProbes: []*ebpf.KProbe{{
+			EntryFunc: "kprobe/vfs_getxattr",
+		}},
+		EventTypes: map[eval.EventType]Capabilities{
+			"getxattr": {},
+		},
+		Optional: true,
+	},
+	{
+		Name: "vfs_listxattr",
+		KProbes: []*ebpf.KProbe{{
+			EntryFunc: "kprobe/vfs_listxattr",
+		}},
+		EventTypes: map[eval.EventType]Capabilities{
+			"getxattr": {},
+		},
+		Optional: true,
+	},
+}
@@ -0,0 +1,68 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/DataDog/datadog-agent/pkg/security/ebpf"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/eval"
+)
+
+// ioctlTables is the list of eBPF tables used by ioctl's kProbes
+var ioctlTables = []string{
+	"ioctl_requests",
+}
+
+// ioctlHookPoints holds the list of ioctl's kProbes. ioctl is called at a very high frequency, only
+// the request codes listed in the configuration are reported, the others are filtered in kernel.
+var ioctlHookPoints = []*HookPoint{
+	{
+		Name:    "sys_ioctl",
+		KProbes: syscallKprobe("ioctl"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"ioctl": {},
+		},
+	},
+}
+
+// parseIoctlRequest returns the code of a request given either by name, e.g. TIOCSTI, or by value, e.g. 0x5412
+func parseIoctlRequest(request string) (uint32, error) {
+	if code, found := ioctlRequestConstants[request]; found {
+		return uint32(code), nil
+	}
+
+	code, err := strconv.ParseUint(request, 0, 32)
+	if err != nil {
+		return 0, errors.Errorf("invalid ioctl request `%s`", request)
+	}
+	return uint32(code), nil
+}
+
+// setIoctlRequests pushes in kernel the list of ioctl request codes to report
+func setIoctlRequests(probe *Probe, requests []string) error {
+	table := probe.Table("ioctl_requests")
+	if table == nil {
+		return errors.New("ioctl_requests BPF_HASH table doesn't exist")
+	}
+
+	for _, request := range requests {
+		code, err := parseIoctlRequest(request)
+		if err != nil {
+			return err
+		}
+
+		if err := table.Set(ebpf.Uint32TableItem(code), ebpf.ZeroUint8TableItem); err != nil {
+			return errors.Wrapf(err, "couldn't push ioctl request `%s`", request)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import (
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestParseIoctlRequest(t *testing.T) {
+	for request, expected := range map[string]uint32{
+		"TIOCSTI": unix.TIOCSTI,
+		"0x5412":  0x5412,
+		"21522":   21522,
+	} {
+		code, err := parseIoctlRequest(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if code != expected {
+			t.Errorf("expected request code 0x%x for %s, got 0x%x", expected, request, code)
+		}
+	}
+
+	if _, err := parseIoctlRequest("TIOCUNKNOWN"); err == nil {
+		t.Error("expected an error for an unknown request")
+	}
+}
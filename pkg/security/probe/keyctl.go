@@ -0,0 +1,34 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import "github.com/DataDog/datadog-agent/pkg/security/secl/eval"
+
+// keyctlHookPoints holds the list of keyctl's kProbes, add_key being reported as a keyctl event with
+// the ADD_KEY operation. The key type and description are only captured for add_key and the keyctl
+// operations passing them (KEYCTL_SEARCH, KEYCTL_JOIN_SESSION_KEYRING), the others only carry the key ID.
+// The kernel keyring is rarely used outside of specialized setups, the hook points are only attached
+// for the rules on keyctl events and are optional as the kernels built without CONFIG_KEYS lack them.
+var keyctlHookPoints = []*HookPoint{
+	{
+		Name:    "sys_keyctl",
+		KProbes: syscallKprobe("keyctl"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"keyctl": {},
+		},
+		Optional: true,
+	},
+	{
+		Name:    "sys_add_key",
+		KProbes: syscallKprobe("add_key"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"keyctl": {},
+		},
+		Optional: true,
+	},
+}
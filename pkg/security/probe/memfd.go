@@ -0,0 +1,27 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import "github.com/DataDog/datadog-agent/pkg/security/secl/eval"
+
+// memfdNamePrefix is the prefix the kernel adds to the dentry name of the anonymous files
+// created by memfd_create. The process executed from such a file is flagged with process.is_memfd.
+const memfdNamePrefix = "memfd:"
+
+// memfdHookPoints holds the list of memfd_create's kProbes. memfd_create was introduced
+// in kernel 3.17, the hook point is optional on older kernels.
+var memfdHookPoints = []*HookPoint{
+	{
+		Name:    "sys_memfd_create",
+		KProbes: syscallKprobe("memfd_create"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"memfd": {},
+		},
+		Optional: true,
+	},
+}
@@ -14,6 +14,7 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"net"
 	"os/user"
 	"path"
 	"strconv"
@@ -43,6 +44,12 @@ var InvalidDiscarders = map[eval.Field][]interface{}{
 	"link.source.filename": dentryInvalidDiscarder,
 	"link.target.filename": dentryInvalidDiscarder,
 	"process.filename":     dentryInvalidDiscarder,
+	"getxattr.filename":    dentryInvalidDiscarder,
+	"chdir.filename":       dentryInvalidDiscarder,
+	"fallocate.filename":   dentryInvalidDiscarder,
+	"stat.filename":        dentryInvalidDiscarder,
+	"write.filename":       dentryInvalidDiscarder,
+	"load_module.filename": dentryInvalidDiscarder,
 }
 
 // ErrNotEnoughData is returned when the buffer is too small to unmarshal the event
@@ -284,6 +291,8 @@ func (e *ChownEvent) UnmarshalBinary(data []byte) (int, error) {
 type OpenEvent struct {
 	BaseEvent
 	FileEvent
+	// Flags holds the open flags, rules can match them with the O_* constants, e.g. `open.flags & O_TRUNC > 0`
+	// or `open.flags & O_ACCMODE == O_WRONLY`. They are reported as a set such as "O_WRONLY | O_CREAT | O_TRUNC".
 	Flags uint32 `yaml:"flags" field:"flags"`
 	Mode  uint32 `yaml:"mode" field:"mode"`
 }
@@ -497,20 +506,841 @@ func (e *LinkEvent) UnmarshalBinary(data []byte) (int, error) {
 	return unmarshalBinary(data, &e.BaseEvent, &e.Source, &e.Target)
 }
 
+// GetXattrEvent represents a getxattr or listxattr event
+type GetXattrEvent struct {
+	BaseEvent
+	FileEvent
+	Name string `field:"name" handler:"ResolveName,string"`
+
+	NameRaw [64]byte `field:"-"`
+}
+
+func (e *GetXattrEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"filename":"%s",`, e.ResolveInode(resolvers))
+	fmt.Fprintf(&buf, `"container_path":"%s",`, e.ResolveContainerPath(resolvers))
+	fmt.Fprintf(&buf, `"inode":%d,`, e.Inode)
+	fmt.Fprintf(&buf, `"mount_id":%d,`, e.MountID)
+	fmt.Fprintf(&buf, `"overlay_numlower":%d,`, e.OverlayNumLower)
+	fmt.Fprintf(&buf, `"name":"%s"`, e.GetName())
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *GetXattrEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent, &e.FileEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 64 {
+		return n, ErrNotEnoughData
+	}
+
+	if err := binary.Read(bytes.NewBuffer(data[0:64]), byteOrder, &e.NameRaw); err != nil {
+		return n, err
+	}
+
+	return n + 64, nil
+}
+
+// ResolveName resolves the name of the extended attribute
+func (e *GetXattrEvent) ResolveName(resolvers *Resolvers) string {
+	return e.GetName()
+}
+
+// GetName returns the name of the extended attribute, empty for the listxattr syscalls
+func (e *GetXattrEvent) GetName() string {
+	if len(e.Name) == 0 {
+		e.Name = string(bytes.Trim(e.NameRaw[:], "\x00"))
+	}
+	return e.Name
+}
+
+// ChdirEvent represents a chdir event
+type ChdirEvent struct {
+	BaseEvent
+	FileEvent
+}
+
+func (e *ChdirEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"filename":"%s",`, e.ResolveInode(resolvers))
+	fmt.Fprintf(&buf, `"container_path":"%s",`, e.ResolveContainerPath(resolvers))
+	fmt.Fprintf(&buf, `"inode":%d,`, e.Inode)
+	fmt.Fprintf(&buf, `"mount_id":%d,`, e.MountID)
+	fmt.Fprintf(&buf, `"overlay_numlower":%d`, e.OverlayNumLower)
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *ChdirEvent) UnmarshalBinary(data []byte) (int, error) {
+	return unmarshalBinary(data, &e.BaseEvent, &e.FileEvent)
+}
+
+// StatEvent represents a stat, lstat or fstatat event
+type StatEvent struct {
+	BaseEvent
+	FileEvent
+}
+
+func (e *StatEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"filename":"%s",`, e.ResolveInode(resolvers))
+	fmt.Fprintf(&buf, `"container_path":"%s",`, e.ResolveContainerPath(resolvers))
+	fmt.Fprintf(&buf, `"inode":%d,`, e.Inode)
+	fmt.Fprintf(&buf, `"mount_id":%d,`, e.MountID)
+	fmt.Fprintf(&buf, `"overlay_numlower":%d`, e.OverlayNumLower)
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *StatEvent) UnmarshalBinary(data []byte) (int, error) {
+	return unmarshalBinary(data, &e.BaseEvent, &e.FileEvent)
+}
+
+// WriteEvent represents a write or pwrite64 event
+type WriteEvent struct {
+	BaseEvent
+	FileEvent
+	Count uint64 `field:"count"`
+}
+
+func (e *WriteEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"filename":"%s",`, e.ResolveInode(resolvers))
+	fmt.Fprintf(&buf, `"container_path":"%s",`, e.ResolveContainerPath(resolvers))
+	fmt.Fprintf(&buf, `"inode":%d,`, e.Inode)
+	fmt.Fprintf(&buf, `"mount_id":%d,`, e.MountID)
+	fmt.Fprintf(&buf, `"overlay_numlower":%d,`, e.OverlayNumLower)
+	fmt.Fprintf(&buf, `"count":%d`, e.Count)
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *WriteEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent, &e.FileEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 8 {
+		return n, ErrNotEnoughData
+	}
+
+	e.Count = byteOrder.Uint64(data[0:8])
+	return n + 8, nil
+}
+
+// CloneEvent represents a clone or clone3 event
+type CloneEvent struct {
+	BaseEvent
+	Flags uint64 `field:"flags"`
+}
+
+// IsThread returns whether the clone created a thread of the calling process
+func (e *CloneEvent) IsThread() bool {
+	return e.Flags&syscall.CLONE_THREAD != 0
+}
+
+func (e *CloneEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"flags":"%s",`, CloneFlags(e.Flags))
+	fmt.Fprintf(&buf, `"thread":%t`, e.IsThread())
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *CloneEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 8 {
+		return n, ErrNotEnoughData
+	}
+
+	e.Flags = byteOrder.Uint64(data[0:8])
+	return n + 8, nil
+}
+
+// SendfileEvent represents a sendfile or splice event
+type SendfileEvent struct {
+	BaseEvent
+	InFd  int32  `field:"in_fd"`
+	OutFd int32  `field:"out_fd"`
+	Count uint64 `field:"count"`
+}
+
+func (e *SendfileEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"in_fd":%d,`, e.InFd)
+	fmt.Fprintf(&buf, `"out_fd":%d,`, e.OutFd)
+	fmt.Fprintf(&buf, `"count":%d`, e.Count)
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *SendfileEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 16 {
+		return n, ErrNotEnoughData
+	}
+
+	e.InFd = int32(byteOrder.Uint32(data[0:4]))
+	e.OutFd = int32(byteOrder.Uint32(data[4:8]))
+	e.Count = byteOrder.Uint64(data[8:16])
+	return n + 16, nil
+}
+
+// KeyctlEvent represents a keyctl or add_key event
+type KeyctlEvent struct {
+	BaseEvent
+	Operation   int32  `field:"operation"`
+	KeyID       int32  `field:"key_id"`
+	KeyType     string `field:"key_type" handler:"ResolveKeyType,string"`
+	Description string `field:"description" handler:"ResolveDescription,string"`
+
+	KeyTypeRaw     [32]byte `field:"-"`
+	DescriptionRaw [64]byte `field:"-"`
+}
+
+func (e *KeyctlEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"operation":"%s",`, KeyctlOperation(e.Operation))
+	fmt.Fprintf(&buf, `"key_id":%d,`, e.KeyID)
+	fmt.Fprintf(&buf, `"key_type":"%s",`, e.GetKeyType())
+	fmt.Fprintf(&buf, `"description":"%s"`, e.GetDescription())
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *KeyctlEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 104 {
+		return n, ErrNotEnoughData
+	}
+
+	e.Operation = int32(byteOrder.Uint32(data[0:4]))
+	e.KeyID = int32(byteOrder.Uint32(data[4:8]))
+	if err := binary.Read(bytes.NewBuffer(data[8:40]), byteOrder, &e.KeyTypeRaw); err != nil {
+		return n + 8, err
+	}
+	if err := binary.Read(bytes.NewBuffer(data[40:104]), byteOrder, &e.DescriptionRaw); err != nil {
+		return n + 40, err
+	}
+
+	return n + 104, nil
+}
+
+// ResolveKeyType resolves the type of the key
+func (e *KeyctlEvent) ResolveKeyType(resolvers *Resolvers) string {
+	return e.GetKeyType()
+}
+
+// GetKeyType returns the type of the key, only known for add_key and the KEYCTL_SEARCH operation
+func (e *KeyctlEvent) GetKeyType() string {
+	if len(e.KeyType) == 0 {
+		e.KeyType = string(bytes.Trim(e.KeyTypeRaw[:], "\x00"))
+	}
+	return e.KeyType
+}
+
+// ResolveDescription resolves the description of the key
+func (e *KeyctlEvent) ResolveDescription(resolvers *Resolvers) string {
+	return e.GetDescription()
+}
+
+// GetDescription returns the description of the key, only known for add_key and the KEYCTL_SEARCH and
+// KEYCTL_JOIN_SESSION_KEYRING operations
+func (e *KeyctlEvent) GetDescription() string {
+	if len(e.Description) == 0 {
+		e.Description = string(bytes.Trim(e.DescriptionRaw[:], "\x00"))
+	}
+	return e.Description
+}
+
+// PrctlEvent represents a prctl event
+type PrctlEvent struct {
+	BaseEvent
+	Option int32  `field:"option"`
+	Arg2   uint64 `field:"arg2"`
+	Arg3   uint64 `field:"arg3"`
+	Arg4   uint64 `field:"arg4"`
+	Arg5   uint64 `field:"arg5"`
+	Name   string `field:"name" handler:"ResolveName,string"`
+
+	NameRaw [16]byte `field:"-"`
+}
+
+func (e *PrctlEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"option":"%s",`, PrctlOption(e.Option))
+	if name := e.GetName(); name != "" {
+		fmt.Fprintf(&buf, `"name":%s,`, strconv.Quote(name))
+	}
+	fmt.Fprintf(&buf, `"arg2":%d,`, e.Arg2)
+	fmt.Fprintf(&buf, `"arg3":%d,`, e.Arg3)
+	fmt.Fprintf(&buf, `"arg4":%d,`, e.Arg4)
+	fmt.Fprintf(&buf, `"arg5":%d`, e.Arg5)
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *PrctlEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 56 {
+		return n, ErrNotEnoughData
+	}
+
+	e.Option = int32(byteOrder.Uint32(data[0:4]))
+	// padding
+	e.Arg2 = byteOrder.Uint64(data[8:16])
+	e.Arg3 = byteOrder.Uint64(data[16:24])
+	e.Arg4 = byteOrder.Uint64(data[24:32])
+	e.Arg5 = byteOrder.Uint64(data[32:40])
+	if err := binary.Read(bytes.NewBuffer(data[40:56]), byteOrder, &e.NameRaw); err != nil {
+		return n + 40, err
+	}
+
+	return n + 56, nil
+}
+
+// ResolveName resolves the name given to the thread
+func (e *PrctlEvent) ResolveName(resolvers *Resolvers) string {
+	return e.GetName()
+}
+
+// GetName returns the name given to the thread, only set for the PR_SET_NAME option
+func (e *PrctlEvent) GetName() string {
+	if len(e.Name) == 0 {
+		e.Name = string(bytes.Trim(e.NameRaw[:], "\x00"))
+	}
+	return e.Name
+}
+
+// LoadModuleEvent represents an init_module or finit_module event. The file the module was read from is
+// only set for finit_module, init_module loading the module from a buffer of the caller.
+type LoadModuleEvent struct {
+	BaseEvent
+	FileEvent
+	LoadedFromMemory bool `field:"loaded_from_memory"`
+}
+
+func (e *LoadModuleEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	if !e.LoadedFromMemory && e.Inode != 0 {
+		fmt.Fprintf(&buf, `"filename":"%s",`, e.ResolveInode(resolvers))
+		fmt.Fprintf(&buf, `"container_path":"%s",`, e.ResolveContainerPath(resolvers))
+		fmt.Fprintf(&buf, `"inode":%d,`, e.Inode)
+		fmt.Fprintf(&buf, `"mount_id":%d,`, e.MountID)
+		fmt.Fprintf(&buf, `"overlay_numlower":%d,`, e.OverlayNumLower)
+	}
+	fmt.Fprintf(&buf, `"loaded_from_memory":%t`, e.LoadedFromMemory)
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *LoadModuleEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent, &e.FileEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 8 {
+		return n, ErrNotEnoughData
+	}
+
+	e.LoadedFromMemory = byteOrder.Uint32(data[0:4]) != 0
+	return n + 8, nil
+}
+
+// BindEvent represents a bind event. The address and the port are only set for the IPv4 and IPv6 families.
+type BindEvent struct {
+	BaseEvent
+	Family uint16 `field:"family"`
+	Addr   string `field:"addr" handler:"ResolveAddr,string"`
+	Port   uint16 `field:"port"`
+
+	AddrRaw [16]byte `field:"-"`
+}
+
+func (e *BindEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	if addr := e.GetAddr(); addr != "" {
+		fmt.Fprintf(&buf, `"addr":"%s",`, addr)
+		fmt.Fprintf(&buf, `"port":%d,`, e.Port)
+	}
+	fmt.Fprintf(&buf, `"family":"%s"`, AddressFamily(e.Family))
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *BindEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 24 {
+		return n, ErrNotEnoughData
+	}
+
+	copy(e.AddrRaw[:], data[0:16])
+	e.Family = byteOrder.Uint16(data[16:18])
+	// the port is in network byte order
+	e.Port = binary.BigEndian.Uint16(data[18:20])
+	// padding
+
+	return n + 24, nil
+}
+
+// ResolveAddr resolves the bound address
+func (e *BindEvent) ResolveAddr(resolvers *Resolvers) string {
+	return e.GetAddr()
+}
+
+// GetAddr returns the bound address, empty for the families other than IPv4 and IPv6
+func (e *BindEvent) GetAddr() string {
+	if len(e.Addr) == 0 {
+		switch e.Family {
+		case syscall.AF_INET:
+			e.Addr = net.IP(e.AddrRaw[:4]).String()
+		case syscall.AF_INET6:
+			e.Addr = net.IP(e.AddrRaw[:]).String()
+		}
+	}
+	return e.Addr
+}
+
+// MemfdEvent represents a memfd_create event
+type MemfdEvent struct {
+	BaseEvent
+	Flags uint32 `field:"flags"`
+	Name  string `field:"name" handler:"ResolveName,string"`
+
+	NameRaw [64]byte `field:"-"`
+}
+
+func (e *MemfdEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"name":"%s",`, e.GetName())
+	fmt.Fprintf(&buf, `"flags":"%s"`, MemfdFlags(e.Flags))
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *MemfdEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 72 {
+		return n, ErrNotEnoughData
+	}
+
+	e.Flags = byteOrder.Uint32(data[0:4])
+	if err := binary.Read(bytes.NewBuffer(data[8:72]), byteOrder, &e.NameRaw); err != nil {
+		return n + 8, err
+	}
+
+	return n + 72, nil
+}
+
+// ResolveName resolves the name given to the anonymous file
+func (e *MemfdEvent) ResolveName(resolvers *Resolvers) string {
+	return e.GetName()
+}
+
+// GetName returns the name given to the anonymous file
+func (e *MemfdEvent) GetName() string {
+	if len(e.Name) == 0 {
+		e.Name = string(bytes.Trim(e.NameRaw[:], "\x00"))
+	}
+	return e.Name
+}
+
+// FallocateEvent represents a fallocate event
+type FallocateEvent struct {
+	BaseEvent
+	FileEvent
+	Mode   uint32 `field:"mode"`
+	Length uint64 `field:"length"`
+}
+
+func (e *FallocateEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"filename":"%s",`, e.ResolveInode(resolvers))
+	fmt.Fprintf(&buf, `"container_path":"%s",`, e.ResolveContainerPath(resolvers))
+	fmt.Fprintf(&buf, `"inode":%d,`, e.Inode)
+	fmt.Fprintf(&buf, `"mount_id":%d,`, e.MountID)
+	fmt.Fprintf(&buf, `"overlay_numlower":%d,`, e.OverlayNumLower)
+	fmt.Fprintf(&buf, `"mode":"%s",`, FallocateMode(e.Mode))
+	fmt.Fprintf(&buf, `"length":%d`, e.Length)
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *FallocateEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent, &e.FileEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 16 {
+		return n, ErrNotEnoughData
+	}
+
+	e.Mode = byteOrder.Uint32(data[0:4])
+	e.Length = byteOrder.Uint64(data[8:16])
+	return n + 16, nil
+}
+
+// DupEvent represents a dup, dup2 or dup3 event
+type DupEvent struct {
+	BaseEvent
+	OldFd int32 `field:"old_fd"`
+	NewFd int32 `field:"new_fd"`
+}
+
+func (e *DupEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"old_fd":%d,`, e.OldFd)
+	fmt.Fprintf(&buf, `"new_fd":%d`, e.NewFd)
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *DupEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 8 {
+		return n, ErrNotEnoughData
+	}
+
+	e.OldFd = int32(byteOrder.Uint32(data[0:4]))
+	e.NewFd = int32(byteOrder.Uint32(data[4:8]))
+	return n + 8, nil
+}
+
+// SetrlimitEvent represents a setrlimit or prlimit64 event
+type SetrlimitEvent struct {
+	BaseEvent
+	Resource uint32 `field:"resource"`
+	Pid      uint32 `field:"pid"`
+	Soft     int64  `field:"soft"`
+	Hard     int64  `field:"hard"`
+}
+
+func (e *SetrlimitEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"resource":"%s",`, RlimitResource(e.Resource))
+	fmt.Fprintf(&buf, `"pid":%d,`, e.Pid)
+	fmt.Fprintf(&buf, `"soft":%d,`, e.Soft)
+	fmt.Fprintf(&buf, `"hard":%d`, e.Hard)
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *SetrlimitEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 24 {
+		return n, ErrNotEnoughData
+	}
+
+	e.Resource = byteOrder.Uint32(data[0:4])
+	e.Pid = byteOrder.Uint32(data[4:8])
+	e.Soft = int64(byteOrder.Uint64(data[8:16]))
+	e.Hard = int64(byteOrder.Uint64(data[16:24]))
+	return n + 24, nil
+}
+
+// SetnsEvent represents a setns event
+type SetnsEvent struct {
+	BaseEvent
+	Fd     int32  `field:"fd"`
+	NSType uint32 `field:"nstype"`
+}
+
+func (e *SetnsEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"fd":%d,`, e.Fd)
+	fmt.Fprintf(&buf, `"nstype":"%s"`, NamespaceType(e.NSType))
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *SetnsEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 8 {
+		return n, ErrNotEnoughData
+	}
+
+	e.Fd = int32(byteOrder.Uint32(data[0:4]))
+	e.NSType = byteOrder.Uint32(data[4:8])
+	return n + 8, nil
+}
+
+// QuotactlEvent represents a quotactl event
+type QuotactlEvent struct {
+	BaseEvent
+	Cmd       uint32 `field:"cmd"`
+	QuotaType uint32 `field:"type"`
+	ID        uint32 `field:"id"`
+	Special   string `field:"special" handler:"ResolveSpecial,string"`
+
+	SpecialRaw [64]byte `field:"-"`
+}
+
+func (e *QuotactlEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"cmd":"%s",`, QuotactlCmd(e.Cmd))
+	fmt.Fprintf(&buf, `"type":"%s",`, QuotaType(e.QuotaType))
+	fmt.Fprintf(&buf, `"id":%d,`, e.ID)
+	fmt.Fprintf(&buf, `"special":"%s"`, e.GetSpecial())
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *QuotactlEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 72 {
+		return n, ErrNotEnoughData
+	}
+
+	// the command holds the sub-command in its upper bits and the quota type in its lower byte
+	cmd := byteOrder.Uint32(data[0:4])
+	e.Cmd, e.QuotaType = cmd>>8, cmd&0xff
+	e.ID = byteOrder.Uint32(data[4:8])
+	if err := binary.Read(bytes.NewBuffer(data[8:72]), byteOrder, &e.SpecialRaw); err != nil {
+		return n + 8, err
+	}
+
+	return n + 72, nil
+}
+
+// ResolveSpecial resolves the path of the block device targeted by quotactl
+func (e *QuotactlEvent) ResolveSpecial(resolvers *Resolvers) string {
+	return e.GetSpecial()
+}
+
+// GetSpecial returns the path of the block device targeted by quotactl
+func (e *QuotactlEvent) GetSpecial() string {
+	if len(e.Special) == 0 {
+		e.Special = string(bytes.Trim(e.SpecialRaw[:], "\x00"))
+	}
+	return e.Special
+}
+
+// PipeEvent represents a pipe or pipe2 event
+type PipeEvent struct {
+	BaseEvent
+	ReadFd  int32  `field:"read_fd"`
+	WriteFd int32  `field:"write_fd"`
+	Flags   uint32 `field:"flags"`
+}
+
+func (e *PipeEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"read_fd":%d,`, e.ReadFd)
+	fmt.Fprintf(&buf, `"write_fd":%d,`, e.WriteFd)
+	fmt.Fprintf(&buf, `"flags":"%s"`, PipeFlags(e.Flags))
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *PipeEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 16 {
+		return n, ErrNotEnoughData
+	}
+
+	e.ReadFd = int32(byteOrder.Uint32(data[0:4]))
+	e.WriteFd = int32(byteOrder.Uint32(data[4:8]))
+	e.Flags = byteOrder.Uint32(data[8:12])
+	return n + 16, nil
+}
+
+// IoctlEvent represents an ioctl event
+type IoctlEvent struct {
+	BaseEvent
+	Fd      int32  `field:"fd"`
+	Request uint32 `field:"request"`
+}
+
+func (e *IoctlEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"fd":%d,`, e.Fd)
+	fmt.Fprintf(&buf, `"request":"%s"`, IoctlRequest(e.Request))
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *IoctlEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 8 {
+		return n, ErrNotEnoughData
+	}
+
+	e.Fd = int32(byteOrder.Uint32(data[0:4]))
+	e.Request = byteOrder.Uint32(data[4:8])
+	return n + 8, nil
+}
+
+// UmaskEvent represents an umask event
+type UmaskEvent struct {
+	BaseEvent
+	Mask uint32 `field:"mask"`
+}
+
+func (e *UmaskEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	fmt.Fprintf(&buf, `"mask":"%#o"`, e.Mask)
+	buf.WriteRune('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshals a binary representation of itself
+func (e *UmaskEvent) UnmarshalBinary(data []byte) (int, error) {
+	n, err := unmarshalBinary(data, &e.BaseEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 8 {
+		return n, ErrNotEnoughData
+	}
+
+	e.Mask = byteOrder.Uint32(data[0:4])
+	return n + 8, nil
+}
+
 // MountEvent represents a mount event
 type MountEvent struct {
-	NewMountID    uint32
-	NewGroupID    uint32
-	NewDevice     uint32
-	ParentMountID uint32
-	ParentInode   uint64
-	FSType        string
-	MountPointStr string
-	RootMountID   uint32
-	RootInode     uint64
-	RootStr       string
+	BaseEvent
+	NewMountID    uint32 `field:"-"`
+	NewGroupID    uint32 `field:"-"`
+	NewDevice     uint32 `field:"-"`
+	ParentMountID uint32 `field:"-"`
+	ParentInode   uint64 `field:"-"`
+	FSType        string `field:"fs_type" handler:"ResolveFSType,string"`
+	Flags         uint64 `field:"flags"`
+	MountPointStr string `field:"mountpoint" handler:"ResolveMountPoint,string"`
+	RootMountID   uint32 `field:"-"`
+	RootInode     uint64 `field:"-"`
+	RootStr       string `field:"-"`
 
-	FSTypeRaw [16]byte
+	FSTypeRaw [16]byte `field:"-"`
 }
 
 func (e *MountEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
@@ -525,7 +1355,8 @@ func (e *MountEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
 	fmt.Fprintf(&buf, `"new_mount_id":%d,`, e.NewMountID)
 	fmt.Fprintf(&buf, `"new_group_id":%d,`, e.NewGroupID)
 	fmt.Fprintf(&buf, `"new_device":%d,`, e.NewDevice)
-	fmt.Fprintf(&buf, `"fstype":"%s"`, e.GetFSType())
+	fmt.Fprintf(&buf, `"fstype":"%s",`, e.GetFSType())
+	fmt.Fprintf(&buf, `"flags":"%s"`, MountFlags(e.Flags))
 	buf.WriteRune('}')
 
 	return buf.Bytes(), nil
@@ -533,8 +1364,14 @@ func (e *MountEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
 
 // UnmarshalBinary unmarshals a binary representation of itself
 func (e *MountEvent) UnmarshalBinary(data []byte) (int, error) {
-	if len(data) < 56 {
-		return 0, ErrNotEnoughData
+	n, err := unmarshalBinary(data, &e.BaseEvent)
+	if err != nil {
+		return n, err
+	}
+
+	data = data[n:]
+	if len(data) < 64 {
+		return n, ErrNotEnoughData
 	}
 
 	e.NewMountID = byteOrder.Uint32(data[0:4])
@@ -546,10 +1383,11 @@ func (e *MountEvent) UnmarshalBinary(data []byte) (int, error) {
 	e.RootMountID = byteOrder.Uint32(data[32:36])
 
 	if err := binary.Read(bytes.NewBuffer(data[40:56]), byteOrder, &e.FSTypeRaw); err != nil {
-		return 40, err
+		return n + 40, err
 	}
+	e.Flags = byteOrder.Uint64(data[56:64])
 
-	return 56, nil
+	return n + 64, nil
 }
 
 // ResolveMountPoint resolves the mountpoint to a full path
@@ -568,6 +1406,16 @@ func (e *MountEvent) ResolveRoot(resolvers *Resolvers) string {
 	return e.RootStr
 }
 
+// ResolveFSType resolves the filesystem type of the mountpoint
+func (e *MountEvent) ResolveFSType(resolvers *Resolvers) string {
+	return e.GetFSType()
+}
+
+// IsRemount returns whether the event comes from the remount of an existing mount point
+func (e *MountEvent) IsRemount() bool {
+	return e.Flags&syscall.MS_REMOUNT != 0
+}
+
 // GetFSType returns the filesystem type of the mountpoint
 func (e *MountEvent) GetFSType() string {
 	if len(e.FSType) == 0 {
@@ -579,12 +1427,14 @@ func (e *MountEvent) GetFSType() string {
 // UmountEvent represents an umount event
 type UmountEvent struct {
 	MountID uint32
+	Flags   uint32
 }
 
 func (e *UmountEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
 	var buf bytes.Buffer
 	buf.WriteRune('{')
-	fmt.Fprintf(&buf, `"mount_id":%d`, e.MountID)
+	fmt.Fprintf(&buf, `"mount_id":%d,`, e.MountID)
+	fmt.Fprintf(&buf, `"flags":"%s"`, UmountFlags(e.Flags))
 	buf.WriteRune('}')
 
 	return buf.Bytes(), nil
@@ -592,12 +1442,13 @@ func (e *UmountEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
 
 // UnmarshalBinary unmarshals a binary representation of itself
 func (e *UmountEvent) UnmarshalBinary(data []byte) (int, error) {
-	if len(data) < 4 {
+	if len(data) < 8 {
 		return 0, ErrNotEnoughData
 	}
 
 	e.MountID = byteOrder.Uint32(data[0:4])
-	return 4, nil
+	e.Flags = byteOrder.Uint32(data[4:8])
+	return 8, nil
 }
 
 // ContainerEvent holds the container context of an event
@@ -657,9 +1508,18 @@ type ProcessEvent struct {
 	GID     uint32 `field:"gid"`
 	User    string `field:"user" handler:"ResolveUser,string"`
 	Group   string `field:"group" handler:"ResolveGroup,string"`
+	Cwd     string `field:"cwd" handler:"ResolveCwd,string"`
+	IsMemfd bool   `field:"is_memfd" handler:"ResolveIsMemfd,bool"`
+	Args    string `field:"args" handler:"ResolveArgs,string"`
+	Umask   int    `field:"umask" handler:"ResolveUmask,int"`
+
+	ArgsTruncated bool `field:"args_truncated" handler:"ResolveArgsTruncated,bool"`
 
-	CommRaw    [16]byte `field:"-"`
-	TTYNameRaw [64]byte `field:"-"`
+	CommRaw       [16]byte `field:"-"`
+	TTYNameRaw    [64]byte `field:"-"`
+	ExecTimestamp uint64   `field:"-"`
+	argsResolved  bool
+	umaskResolved bool
 }
 
 func (p *ProcessEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
@@ -674,6 +1534,21 @@ func (p *ProcessEvent) marshalJSON(resolvers *Resolvers) ([]byte, error) {
 	fmt.Fprintf(&buf, `"tid":%d,`, p.Tid)
 	fmt.Fprintf(&buf, `"uid":%d,`, p.UID)
 	fmt.Fprintf(&buf, `"gid":%d`, p.GID)
+	if cwd := p.ResolveCwd(resolvers); cwd != "" {
+		fmt.Fprintf(&buf, `,"cwd":"%s"`, cwd)
+	}
+	if p.ResolveIsMemfd(resolvers) {
+		buf.WriteString(`,"is_memfd":true`)
+	}
+	if umask := p.ResolveUmask(resolvers); umask >= 0 {
+		fmt.Fprintf(&buf, `,"umask":"%#o"`, umask)
+	}
+	if args := p.ResolveArgs(resolvers); args != "" {
+		fmt.Fprintf(&buf, `,"args":%s`, strconv.Quote(args))
+		if p.ResolveArgsTruncated(resolvers) {
+			buf.WriteString(`,"args_truncated":true`)
+		}
+	}
 	buf.WriteRune('}')
 
 	return buf.Bytes(), nil
@@ -723,9 +1598,52 @@ func (p *ProcessEvent) ResolveGroup(resolvers *Resolvers) string {
 	return p.Group
 }
 
+// ResolveCwd resolves the current working directory of the process
+func (p *ProcessEvent) ResolveCwd(resolvers *Resolvers) string {
+	if len(p.Cwd) == 0 {
+		p.Cwd = resolvers.ProcessResolver.ResolveCwd(p.Pid, p.ExecTimestamp)
+	}
+	return p.Cwd
+}
+
+// ResolveIsMemfd returns whether the process executable is an anonymous file created with memfd_create
+func (p *ProcessEvent) ResolveIsMemfd(resolvers *Resolvers) bool {
+	if !p.IsMemfd {
+		// the kernel names the dentries of anonymous files after the name given to memfd_create
+		p.IsMemfd = strings.HasPrefix(p.ResolveBasename(resolvers), memfdNamePrefix)
+	}
+	return p.IsMemfd
+}
+
+// ResolveUmask resolves the umask of the process, -1 if it couldn't be resolved. The permissions of a
+// file created by the process are the requested ones without the bits of the umask.
+func (p *ProcessEvent) ResolveUmask(resolvers *Resolvers) int {
+	if !p.umaskResolved {
+		p.Umask = resolvers.UmaskResolver.Resolve(p.Pid)
+		p.umaskResolved = true
+	}
+	return p.Umask
+}
+
+// ResolveArgs resolves the arguments of the process, joined with spaces
+func (p *ProcessEvent) ResolveArgs(resolvers *Resolvers) string {
+	if !p.argsResolved {
+		args, truncated := resolvers.ProcessResolver.ResolveArgs(p.Pid, p.ExecTimestamp)
+		p.Args, p.ArgsTruncated = strings.Join(args, " "), truncated
+		p.argsResolved = true
+	}
+	return p.Args
+}
+
+// ResolveArgsTruncated returns whether the arguments of the process exceeded the capture limits
+func (p *ProcessEvent) ResolveArgsTruncated(resolvers *Resolvers) bool {
+	p.ResolveArgs(resolvers)
+	return p.ArgsTruncated
+}
+
 // UnmarshalBinary unmarshals a binary representation of itself
 func (p *ProcessEvent) UnmarshalBinary(data []byte) (int, error) {
-	if len(data) < 108 {
+	if len(data) < 128 {
 		return 0, ErrNotEnoughData
 	}
 	p.Pidns = byteOrder.Uint64(data[0:8])
@@ -744,7 +1662,8 @@ func (p *ProcessEvent) UnmarshalBinary(data []byte) (int, error) {
 	if err != nil {
 		return 104 + read, err
 	}
-	return 104 + read, nil
+	p.ExecTimestamp = byteOrder.Uint64(data[120:128])
+	return 128, nil
 }
 
 // Event represents an event sent from the kernel
@@ -753,19 +1672,38 @@ type Event struct {
 	ID   string `field:"-"`
 	Type uint64 `field:"-"`
 
-	Process   ProcessEvent   `yaml:"process" field:"process" event:"*"`
-	Container ContainerEvent `yaml:"container" field:"container"`
-	Chmod     ChmodEvent     `yaml:"chmod" field:"chmod" event:"chmod"`
-	Chown     ChownEvent     `yaml:"chown" field:"chown" event:"chown"`
-	Open      OpenEvent      `yaml:"open" field:"open" event:"open"`
-	Mkdir     MkdirEvent     `yaml:"mkdir" field:"mkdir" event:"mkdir"`
-	Rmdir     RmdirEvent     `yaml:"rmdir" field:"rmdir" event:"rmdir"`
-	Rename    RenameEvent    `yaml:"rename" field:"rename" event:"rename"`
-	Unlink    UnlinkEvent    `yaml:"unlink" field:"unlink" event:"unlink"`
-	Utimes    UtimesEvent    `yaml:"utimes" field:"utimes" event:"utimes"`
-	Link      LinkEvent      `yaml:"link" field:"link" event:"link"`
-	Mount     MountEvent     `yaml:"mount" field:"-"`
-	Umount    UmountEvent    `yaml:"umount" field:"-"`
+	Process    ProcessEvent    `yaml:"process" field:"process" event:"*"`
+	Container  ContainerEvent  `yaml:"container" field:"container"`
+	Chmod      ChmodEvent      `yaml:"chmod" field:"chmod" event:"chmod"`
+	Chown      ChownEvent      `yaml:"chown" field:"chown" event:"chown"`
+	Open       OpenEvent       `yaml:"open" field:"open" event:"open"`
+	Mkdir      MkdirEvent      `yaml:"mkdir" field:"mkdir" event:"mkdir"`
+	Rmdir      RmdirEvent      `yaml:"rmdir" field:"rmdir" event:"rmdir"`
+	Rename     RenameEvent     `yaml:"rename" field:"rename" event:"rename"`
+	Unlink     UnlinkEvent     `yaml:"unlink" field:"unlink" event:"unlink"`
+	Utimes     UtimesEvent     `yaml:"utimes" field:"utimes" event:"utimes"`
+	Link       LinkEvent       `yaml:"link" field:"link" event:"link"`
+	GetXattr   GetXattrEvent   `yaml:"getxattr" field:"getxattr" event:"getxattr"`
+	Chdir      ChdirEvent      `yaml:"chdir" field:"chdir" event:"chdir"`
+	Memfd      MemfdEvent      `yaml:"memfd" field:"memfd" event:"memfd"`
+	Fallocate  FallocateEvent  `yaml:"fallocate" field:"fallocate" event:"fallocate"`
+	Dup        DupEvent        `yaml:"dup" field:"dup" event:"dup"`
+	Setrlimit  SetrlimitEvent  `yaml:"setrlimit" field:"setrlimit" event:"setrlimit"`
+	Setns      SetnsEvent      `yaml:"setns" field:"setns" event:"setns"`
+	Quotactl   QuotactlEvent   `yaml:"quotactl" field:"quotactl" event:"quotactl"`
+	Pipe       PipeEvent       `yaml:"pipe" field:"pipe" event:"pipe"`
+	Ioctl      IoctlEvent      `yaml:"ioctl" field:"ioctl" event:"ioctl"`
+	Umask      UmaskEvent      `yaml:"umask" field:"umask" event:"umask"`
+	Stat       StatEvent       `yaml:"stat" field:"stat" event:"stat"`
+	Write      WriteEvent      `yaml:"write" field:"write" event:"write"`
+	Clone      CloneEvent      `yaml:"clone" field:"clone" event:"clone"`
+	Sendfile   SendfileEvent   `yaml:"sendfile" field:"sendfile" event:"sendfile"`
+	Keyctl     KeyctlEvent     `yaml:"keyctl" field:"keyctl" event:"keyctl"`
+	Prctl      PrctlEvent      `yaml:"prctl" field:"prctl" event:"prctl"`
+	LoadModule LoadModuleEvent `yaml:"load_module" field:"load_module" event:"load_module"`
+	Bind       BindEvent       `yaml:"bind" field:"bind" event:"bind"`
+	Mount      MountEvent      `yaml:"mount" field:"mount" event:"mount"`
+	Umount     UmountEvent     `yaml:"umount" field:"-"`
 
 	resolvers *Resolvers `field:"-"`
 }
@@ -914,6 +1852,10 @@ func (e *Event) MarshalJSON() ([]byte, error) {
 			})
 	case FileMountEventType:
 		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Mount.BaseEvent),
+			},
 			eventMarshaler{
 				field:      "mount",
 				marshalFnc: e.Mount.marshalJSON,
@@ -924,6 +1866,196 @@ func (e *Event) MarshalJSON() ([]byte, error) {
 				field:      "umount",
 				marshalFnc: e.Umount.marshalJSON,
 			})
+	case FileGetXattrEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.GetXattr.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "file",
+				marshalFnc: e.GetXattr.marshalJSON,
+			})
+	case FileChdirEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Chdir.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "file",
+				marshalFnc: e.Chdir.marshalJSON,
+			})
+	case FileMemfdEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Memfd.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "memfd",
+				marshalFnc: e.Memfd.marshalJSON,
+			})
+	case FileFallocateEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Fallocate.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "file",
+				marshalFnc: e.Fallocate.marshalJSON,
+			})
+	case FileDupEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Dup.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "dup",
+				marshalFnc: e.Dup.marshalJSON,
+			})
+	case FileSetrlimitEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Setrlimit.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "setrlimit",
+				marshalFnc: e.Setrlimit.marshalJSON,
+			})
+	case FileSetnsEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Setns.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "setns",
+				marshalFnc: e.Setns.marshalJSON,
+			})
+	case FileQuotactlEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Quotactl.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "quotactl",
+				marshalFnc: e.Quotactl.marshalJSON,
+			})
+	case FilePipeEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Pipe.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "pipe",
+				marshalFnc: e.Pipe.marshalJSON,
+			})
+	case FileIoctlEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Ioctl.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "ioctl",
+				marshalFnc: e.Ioctl.marshalJSON,
+			})
+	case FileUmaskEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Umask.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "umask",
+				marshalFnc: e.Umask.marshalJSON,
+			})
+	case FileStatEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Stat.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "file",
+				marshalFnc: e.Stat.marshalJSON,
+			})
+	case FileWriteEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Write.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "file",
+				marshalFnc: e.Write.marshalJSON,
+			})
+	case FileCloneEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Clone.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "clone",
+				marshalFnc: e.Clone.marshalJSON,
+			})
+	case FileSendfileEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Sendfile.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "sendfile",
+				marshalFnc: e.Sendfile.marshalJSON,
+			})
+	case FileKeyctlEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Keyctl.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "keyctl",
+				marshalFnc: e.Keyctl.marshalJSON,
+			})
+	case FilePrctlEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Prctl.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "prctl",
+				marshalFnc: e.Prctl.marshalJSON,
+			})
+	case FileLoadModuleEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.LoadModule.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "file",
+				marshalFnc: e.LoadModule.marshalJSON,
+			})
+	case BindEventType:
+		entries = append(entries,
+			eventMarshaler{
+				field:      "syscall",
+				marshalFnc: eventMarshalJSON(&e.Bind.BaseEvent),
+			},
+			eventMarshaler{
+				field:      "bind",
+				marshalFnc: e.Bind.marshalJSON,
+			})
 	}
 
 	var prev bool
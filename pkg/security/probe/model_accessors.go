@@ -13,6 +13,86 @@ import (
 func (m *Model) GetEvaluator(field eval.Field) (eval.Evaluator, error) {
 	switch field {
 
+	case "bind.addr":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Bind.ResolveAddr((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "bind.family":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Bind.Family) },
+
+			Field: field,
+		}, nil
+
+	case "bind.port":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Bind.Port) },
+
+			Field: field,
+		}, nil
+
+	case "chdir.basename":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Chdir.ResolveBasename((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "chdir.container_path":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Chdir.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "chdir.filename":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Chdir.ResolveInode((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "chdir.inode":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Chdir.Inode) },
+
+			Field: field,
+		}, nil
+
+	case "chdir.overlay_numlower":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Chdir.OverlayNumLower) },
+
+			Field: field,
+		}, nil
+
+	case "chdir.retval":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Chdir.Retval) },
+
+			Field: field,
+		}, nil
+
 	case "chmod.basename":
 
 		return &eval.StringEvaluator{
@@ -145,1751 +225,3925 @@ func (m *Model) GetEvaluator(field eval.Field) (eval.Evaluator, error) {
 			Field: field,
 		}, nil
 
-	case "container.id":
-
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Container.ResolveContainerID((*Event)(ctx.Object).resolvers)
-			},
-
-			Field: field,
-		}, nil
-
-	case "link.retval":
+	case "clone.flags":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Link.Retval) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Clone.Flags) },
 
 			Field: field,
 		}, nil
 
-	case "link.source.basename":
+	case "clone.retval":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Link.Source.ResolveBasename((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Clone.Retval) },
 
 			Field: field,
 		}, nil
 
-	case "link.source.container_path":
+	case "container.id":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Link.Source.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Container.ResolveContainerID((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "link.source.filename":
+	case "dup.new_fd":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Link.Source.ResolveInode((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Dup.NewFd) },
 
 			Field: field,
 		}, nil
 
-	case "link.source.inode":
+	case "dup.old_fd":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Link.Source.Inode) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Dup.OldFd) },
 
 			Field: field,
 		}, nil
 
-	case "link.source.overlay_numlower":
+	case "dup.retval":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Link.Source.OverlayNumLower) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Dup.Retval) },
 
 			Field: field,
 		}, nil
 
-	case "link.target.basename":
+	case "fallocate.basename":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Link.Target.ResolveBasename((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Fallocate.ResolveBasename((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "link.target.container_path":
+	case "fallocate.container_path":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Link.Target.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Fallocate.ResolveContainerPath((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "link.target.filename":
+	case "fallocate.filename":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Link.Target.ResolveInode((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Fallocate.ResolveInode((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "link.target.inode":
+	case "fallocate.inode":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Link.Target.Inode) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Fallocate.Inode) },
 
 			Field: field,
 		}, nil
 
-	case "link.target.overlay_numlower":
+	case "fallocate.length":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Link.Target.OverlayNumLower) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Fallocate.Length) },
 
 			Field: field,
 		}, nil
 
-	case "mkdir.basename":
+	case "fallocate.mode":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Mkdir.ResolveBasename((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Fallocate.Mode) },
 
 			Field: field,
 		}, nil
 
-	case "mkdir.container_path":
+	case "fallocate.overlay_numlower":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Mkdir.ResolveContainerPath((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Fallocate.OverlayNumLower) },
 
 			Field: field,
 		}, nil
 
-	case "mkdir.filename":
+	case "fallocate.retval":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Mkdir.ResolveInode((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Fallocate.Retval) },
 
 			Field: field,
 		}, nil
 
-	case "mkdir.inode":
+	case "getxattr.basename":
 
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Mkdir.Inode) },
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).GetXattr.ResolveBasename((*Event)(ctx.Object).resolvers)
+			},
 
 			Field: field,
 		}, nil
 
-	case "mkdir.mode":
+	case "getxattr.container_path":
 
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Mkdir.Mode) },
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).GetXattr.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+			},
 
 			Field: field,
 		}, nil
 
-	case "mkdir.overlay_numlower":
+	case "getxattr.filename":
 
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Mkdir.OverlayNumLower) },
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).GetXattr.ResolveInode((*Event)(ctx.Object).resolvers)
+			},
 
 			Field: field,
 		}, nil
 
-	case "mkdir.retval":
+	case "getxattr.inode":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Mkdir.Retval) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).GetXattr.Inode) },
 
 			Field: field,
 		}, nil
 
-	case "open.basename":
+	case "getxattr.name":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Open.ResolveBasename((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).GetXattr.ResolveName((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "open.container_path":
+	case "getxattr.overlay_numlower":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Open.ResolveContainerPath((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).GetXattr.OverlayNumLower) },
 
 			Field: field,
 		}, nil
 
-	case "open.filename":
+	case "getxattr.retval":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Open.ResolveInode((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).GetXattr.Retval) },
 
 			Field: field,
 		}, nil
 
-	case "open.flags":
+	case "ioctl.fd":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Open.Flags) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Ioctl.Fd) },
 
 			Field: field,
 		}, nil
 
-	case "open.inode":
+	case "ioctl.request":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Open.Inode) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Ioctl.Request) },
 
 			Field: field,
 		}, nil
 
-	case "open.mode":
+	case "ioctl.retval":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Open.Mode) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Ioctl.Retval) },
 
 			Field: field,
 		}, nil
 
-	case "open.overlay_numlower":
+	case "keyctl.description":
 
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Open.OverlayNumLower) },
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Keyctl.ResolveDescription((*Event)(ctx.Object).resolvers)
+			},
 
 			Field: field,
 		}, nil
 
-	case "open.retval":
+	case "keyctl.key_id":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Open.Retval) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Keyctl.KeyID) },
 
 			Field: field,
 		}, nil
 
-	case "process.basename":
+	case "keyctl.key_type":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Process.ResolveBasename((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Keyctl.ResolveKeyType((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "process.container_path":
+	case "keyctl.operation":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Process.ResolveContainerPath((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Keyctl.Operation) },
 
 			Field: field,
 		}, nil
 
-	case "process.filename":
+	case "keyctl.retval":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Process.ResolveInode((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Keyctl.Retval) },
 
 			Field: field,
 		}, nil
 
-	case "process.gid":
+	case "link.retval":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.GID) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Link.Retval) },
 
 			Field: field,
 		}, nil
 
-	case "process.group":
+	case "link.source.basename":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Process.ResolveGroup((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Link.Source.ResolveBasename((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "process.inode":
+	case "link.source.container_path":
 
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.Inode) },
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Link.Source.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+			},
 
 			Field: field,
 		}, nil
 
-	case "process.name":
+	case "link.source.filename":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Process.ResolveComm((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Link.Source.ResolveInode((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "process.overlay_numlower":
+	case "link.source.inode":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.OverlayNumLower) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Link.Source.Inode) },
 
 			Field: field,
 		}, nil
 
-	case "process.pid":
+	case "link.source.overlay_numlower":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.Pid) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Link.Source.OverlayNumLower) },
 
 			Field: field,
 		}, nil
 
-	case "process.pidns":
+	case "link.target.basename":
 
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.Pidns) },
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Link.Target.ResolveBasename((*Event)(ctx.Object).resolvers)
+			},
 
 			Field: field,
 		}, nil
 
-	case "process.tid":
+	case "link.target.container_path":
 
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.Tid) },
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Link.Target.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+			},
 
 			Field: field,
 		}, nil
 
-	case "process.tty_name":
+	case "link.target.filename":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Process.ResolveTTY((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Link.Target.ResolveInode((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "process.uid":
+	case "link.target.inode":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.UID) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Link.Target.Inode) },
 
 			Field: field,
 		}, nil
 
-	case "process.user":
+	case "link.target.overlay_numlower":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Process.ResolveUser((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Link.Target.OverlayNumLower) },
 
 			Field: field,
 		}, nil
 
-	case "rename.new.basename":
+	case "load_module.basename":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Rename.New.ResolveBasename((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).LoadModule.ResolveBasename((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "rename.new.container_path":
+	case "load_module.container_path":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Rename.New.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).LoadModule.ResolveContainerPath((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "rename.new.filename":
+	case "load_module.filename":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Rename.New.ResolveInode((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).LoadModule.ResolveInode((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "rename.new.inode":
+	case "load_module.inode":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rename.New.Inode) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).LoadModule.Inode) },
 
 			Field: field,
 		}, nil
 
-	case "rename.new.overlay_numlower":
+	case "load_module.loaded_from_memory":
 
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rename.New.OverlayNumLower) },
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				return (*Event)(ctx.Object).LoadModule.LoadedFromMemory
+			},
 
 			Field: field,
 		}, nil
 
-	case "rename.old.basename":
-
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Rename.Old.ResolveBasename((*Event)(ctx.Object).resolvers)
-			},
-
-			Field: field,
-		}, nil
-
-	case "rename.old.container_path":
+	case "load_module.overlay_numlower":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Rename.Old.ResolveContainerPath((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).LoadModule.OverlayNumLower) },
 
 			Field: field,
 		}, nil
 
-	case "rename.old.filename":
+	case "load_module.retval":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Rename.Old.ResolveInode((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).LoadModule.Retval) },
 
 			Field: field,
 		}, nil
 
-	case "rename.old.inode":
+	case "memfd.flags":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rename.Old.Inode) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Memfd.Flags) },
 
 			Field: field,
 		}, nil
 
-	case "rename.old.overlay_numlower":
+	case "memfd.name":
 
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rename.Old.OverlayNumLower) },
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Memfd.ResolveName((*Event)(ctx.Object).resolvers)
+			},
 
 			Field: field,
 		}, nil
 
-	case "rename.retval":
+	case "memfd.retval":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rename.Retval) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Memfd.Retval) },
 
 			Field: field,
 		}, nil
 
-	case "rmdir.basename":
+	case "mkdir.basename":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Rmdir.ResolveBasename((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Mkdir.ResolveBasename((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "rmdir.container_path":
+	case "mkdir.container_path":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Rmdir.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Mkdir.ResolveContainerPath((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "rmdir.filename":
+	case "mkdir.filename":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Rmdir.ResolveInode((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Mkdir.ResolveInode((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "rmdir.inode":
+	case "mkdir.inode":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rmdir.Inode) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Mkdir.Inode) },
 
 			Field: field,
 		}, nil
 
-	case "rmdir.overlay_numlower":
+	case "mkdir.mode":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rmdir.OverlayNumLower) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Mkdir.Mode) },
 
 			Field: field,
 		}, nil
 
-	case "rmdir.retval":
+	case "mkdir.overlay_numlower":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rmdir.Retval) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Mkdir.OverlayNumLower) },
 
 			Field: field,
 		}, nil
 
-	case "unlink.basename":
+	case "mkdir.retval":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Unlink.ResolveBasename((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Mkdir.Retval) },
 
 			Field: field,
 		}, nil
 
-	case "unlink.container_path":
+	case "mount.flags":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Unlink.ResolveContainerPath((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Mount.Flags) },
 
 			Field: field,
 		}, nil
 
-	case "unlink.filename":
+	case "mount.fs_type":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Unlink.ResolveInode((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Mount.ResolveFSType((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "unlink.flags":
-
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Unlink.Flags) },
-
-			Field: field,
-		}, nil
-
-	case "unlink.inode":
+	case "mount.mountpoint":
 
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Unlink.Inode) },
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Mount.ResolveMountPoint((*Event)(ctx.Object).resolvers)
+			},
 
 			Field: field,
 		}, nil
 
-	case "unlink.overlay_numlower":
+	case "mount.retval":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Unlink.OverlayNumLower) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Mount.Retval) },
 
 			Field: field,
 		}, nil
 
-	case "unlink.retval":
+	case "open.basename":
 
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Unlink.Retval) },
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Open.ResolveBasename((*Event)(ctx.Object).resolvers)
+			},
 
 			Field: field,
 		}, nil
 
-	case "utimes.basename":
+	case "open.container_path":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Utimes.ResolveBasename((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Open.ResolveContainerPath((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "utimes.container_path":
+	case "open.filename":
 
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Utimes.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+				return (*Event)(ctx.Object).Open.ResolveInode((*Event)(ctx.Object).resolvers)
 			},
 
 			Field: field,
 		}, nil
 
-	case "utimes.filename":
+	case "open.flags":
 
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-				return (*Event)(ctx.Object).Utimes.ResolveInode((*Event)(ctx.Object).resolvers)
-			},
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Open.Flags) },
 
 			Field: field,
 		}, nil
 
-	case "utimes.inode":
+	case "open.inode":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Utimes.Inode) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Open.Inode) },
 
 			Field: field,
 		}, nil
 
-	case "utimes.overlay_numlower":
+	case "open.mode":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Utimes.OverlayNumLower) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Open.Mode) },
 
 			Field: field,
 		}, nil
 
-	case "utimes.retval":
+	case "open.overlay_numlower":
 
 		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Utimes.Retval) },
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Open.OverlayNumLower) },
 
 			Field: field,
 		}, nil
 
-	}
+	case "open.retval":
 
-	return nil, &eval.ErrFieldNotFound{Field: field}
-}
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Open.Retval) },
 
-func (e *Event) GetFieldValue(field eval.Field) (interface{}, error) {
-	switch field {
+			Field: field,
+		}, nil
 
-	case "chmod.basename":
+	case "pipe.flags":
 
-		return e.Chmod.ResolveBasename(e.resolvers), nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Pipe.Flags) },
 
-	case "chmod.container_path":
+			Field: field,
+		}, nil
 
-		return e.Chmod.ResolveContainerPath(e.resolvers), nil
+	case "pipe.read_fd":
 
-	case "chmod.filename":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Pipe.ReadFd) },
 
-		return e.Chmod.ResolveInode(e.resolvers), nil
+			Field: field,
+		}, nil
 
-	case "chmod.inode":
+	case "pipe.retval":
 
-		return int(e.Chmod.Inode), nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Pipe.Retval) },
 
-	case "chmod.mode":
+			Field: field,
+		}, nil
 
-		return int(e.Chmod.Mode), nil
+	case "pipe.write_fd":
 
-	case "chmod.overlay_numlower":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Pipe.WriteFd) },
 
-		return int(e.Chmod.OverlayNumLower), nil
+			Field: field,
+		}, nil
 
-	case "chmod.retval":
+	case "prctl.arg2":
 
-		return int(e.Chmod.Retval), nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Prctl.Arg2) },
 
-	case "chown.basename":
+			Field: field,
+		}, nil
 
-		return e.Chown.ResolveBasename(e.resolvers), nil
+	case "prctl.arg3":
 
-	case "chown.container_path":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Prctl.Arg3) },
 
-		return e.Chown.ResolveContainerPath(e.resolvers), nil
+			Field: field,
+		}, nil
 
-	case "chown.filename":
+	case "prctl.arg4":
 
-		return e.Chown.ResolveInode(e.resolvers), nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Prctl.Arg4) },
 
-	case "chown.gid":
+			Field: field,
+		}, nil
 
-		return int(e.Chown.GID), nil
+	case "prctl.arg5":
 
-	case "chown.inode":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Prctl.Arg5) },
 
-		return int(e.Chown.Inode), nil
+			Field: field,
+		}, nil
 
-	case "chown.overlay_numlower":
+	case "prctl.name":
 
-		return int(e.Chown.OverlayNumLower), nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Prctl.ResolveName((*Event)(ctx.Object).resolvers)
+			},
 
-	case "chown.retval":
+			Field: field,
+		}, nil
 
-		return int(e.Chown.Retval), nil
+	case "prctl.option":
 
-	case "chown.uid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Prctl.Option) },
 
-		return int(e.Chown.UID), nil
+			Field: field,
+		}, nil
 
-	case "container.id":
+	case "prctl.retval":
 
-		return e.Container.ResolveContainerID(e.resolvers), nil
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Prctl.Retval) },
 
-	case "link.retval":
+			Field: field,
+		}, nil
 
-		return int(e.Link.Retval), nil
+	case "process.args":
 
-	case "link.source.basename":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Process.ResolveArgs((*Event)(ctx.Object).resolvers)
+			},
 
-		return e.Link.Source.ResolveBasename(e.resolvers), nil
+			Field: field,
+		}, nil
 
-	case "link.source.container_path":
+	case "process.args_truncated":
 
-		return e.Link.Source.ResolveContainerPath(e.resolvers), nil
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				return (*Event)(ctx.Object).Process.ResolveArgsTruncated((*Event)(ctx.Object).resolvers)
+			},
 
-	case "link.source.filename":
+			Field: field,
+		}, nil
 
-		return e.Link.Source.ResolveInode(e.resolvers), nil
+	case "process.basename":
 
-	case "link.source.inode":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Process.ResolveBasename((*Event)(ctx.Object).resolvers)
+			},
 
-		return int(e.Link.Source.Inode), nil
+			Field: field,
+		}, nil
 
-	case "link.source.overlay_numlower":
+	case "process.container_path":
 
-		return int(e.Link.Source.OverlayNumLower), nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Process.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+			},
 
-	case "link.target.basename":
+			Field: field,
+		}, nil
 
-		return e.Link.Target.ResolveBasename(e.resolvers), nil
+	case "process.cwd":
 
-	case "link.target.container_path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Process.ResolveCwd((*Event)(ctx.Object).resolvers)
+			},
 
-		return e.Link.Target.ResolveContainerPath(e.resolvers), nil
+			Field: field,
+		}, nil
 
-	case "link.target.filename":
+	case "process.filename":
 
-		return e.Link.Target.ResolveInode(e.resolvers), nil
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Process.ResolveInode((*Event)(ctx.Object).resolvers)
+			},
 
-	case "link.target.inode":
+			Field: field,
+		}, nil
 
-		return int(e.Link.Target.Inode), nil
+	case "process.gid":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.GID) },
+
+			Field: field,
+		}, nil
+
+	case "process.group":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Process.ResolveGroup((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "process.inode":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.Inode) },
+
+			Field: field,
+		}, nil
+
+	case "process.is_memfd":
+
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				return (*Event)(ctx.Object).Process.ResolveIsMemfd((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "process.name":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Process.ResolveComm((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "process.overlay_numlower":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.OverlayNumLower) },
+
+			Field: field,
+		}, nil
+
+	case "process.pid":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.Pid) },
+
+			Field: field,
+		}, nil
+
+	case "process.pidns":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.Pidns) },
+
+			Field: field,
+		}, nil
+
+	case "process.tid":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.Tid) },
+
+			Field: field,
+		}, nil
+
+	case "process.tty_name":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Process.ResolveTTY((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "process.uid":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Process.UID) },
+
+			Field: field,
+		}, nil
+
+	case "process.umask":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				return int((*Event)(ctx.Object).Process.ResolveUmask((*Event)(ctx.Object).resolvers))
+			},
+
+			Field: field,
+		}, nil
+
+	case "process.user":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Process.ResolveUser((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "quotactl.cmd":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Quotactl.Cmd) },
+
+			Field: field,
+		}, nil
+
+	case "quotactl.id":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Quotactl.ID) },
+
+			Field: field,
+		}, nil
+
+	case "quotactl.retval":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Quotactl.Retval) },
+
+			Field: field,
+		}, nil
+
+	case "quotactl.special":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Quotactl.ResolveSpecial((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "quotactl.type":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Quotactl.QuotaType) },
+
+			Field: field,
+		}, nil
+
+	case "rename.new.basename":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Rename.New.ResolveBasename((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "rename.new.container_path":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Rename.New.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "rename.new.filename":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Rename.New.ResolveInode((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "rename.new.inode":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rename.New.Inode) },
+
+			Field: field,
+		}, nil
+
+	case "rename.new.overlay_numlower":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rename.New.OverlayNumLower) },
+
+			Field: field,
+		}, nil
+
+	case "rename.old.basename":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Rename.Old.ResolveBasename((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "rename.old.container_path":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Rename.Old.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "rename.old.filename":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Rename.Old.ResolveInode((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "rename.old.inode":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rename.Old.Inode) },
+
+			Field: field,
+		}, nil
+
+	case "rename.old.overlay_numlower":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rename.Old.OverlayNumLower) },
+
+			Field: field,
+		}, nil
+
+	case "rename.retval":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rename.Retval) },
+
+			Field: field,
+		}, nil
+
+	case "rmdir.basename":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Rmdir.ResolveBasename((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "rmdir.container_path":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Rmdir.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "rmdir.filename":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Rmdir.ResolveInode((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "rmdir.inode":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rmdir.Inode) },
+
+			Field: field,
+		}, nil
+
+	case "rmdir.overlay_numlower":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rmdir.OverlayNumLower) },
+
+			Field: field,
+		}, nil
+
+	case "rmdir.retval":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Rmdir.Retval) },
+
+			Field: field,
+		}, nil
+
+	case "sendfile.count":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Sendfile.Count) },
+
+			Field: field,
+		}, nil
+
+	case "sendfile.in_fd":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Sendfile.InFd) },
+
+			Field: field,
+		}, nil
+
+	case "sendfile.out_fd":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Sendfile.OutFd) },
+
+			Field: field,
+		}, nil
+
+	case "sendfile.retval":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Sendfile.Retval) },
+
+			Field: field,
+		}, nil
+
+	case "setns.fd":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Setns.Fd) },
+
+			Field: field,
+		}, nil
+
+	case "setns.nstype":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Setns.NSType) },
+
+			Field: field,
+		}, nil
+
+	case "setns.retval":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Setns.Retval) },
+
+			Field: field,
+		}, nil
+
+	case "setrlimit.hard":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Setrlimit.Hard) },
+
+			Field: field,
+		}, nil
+
+	case "setrlimit.pid":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Setrlimit.Pid) },
+
+			Field: field,
+		}, nil
+
+	case "setrlimit.resource":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Setrlimit.Resource) },
+
+			Field: field,
+		}, nil
+
+	case "setrlimit.retval":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Setrlimit.Retval) },
+
+			Field: field,
+		}, nil
+
+	case "setrlimit.soft":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Setrlimit.Soft) },
+
+			Field: field,
+		}, nil
+
+	case "stat.basename":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Stat.ResolveBasename((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "stat.container_path":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Stat.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "stat.filename":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Stat.ResolveInode((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "stat.inode":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Stat.Inode) },
+
+			Field: field,
+		}, nil
+
+	case "stat.overlay_numlower":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Stat.OverlayNumLower) },
+
+			Field: field,
+		}, nil
+
+	case "stat.retval":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Stat.Retval) },
+
+			Field: field,
+		}, nil
+
+	case "umask.mask":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Umask.Mask) },
+
+			Field: field,
+		}, nil
+
+	case "umask.retval":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Umask.Retval) },
+
+			Field: field,
+		}, nil
+
+	case "unlink.basename":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Unlink.ResolveBasename((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "unlink.container_path":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Unlink.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "unlink.filename":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Unlink.ResolveInode((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "unlink.flags":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Unlink.Flags) },
+
+			Field: field,
+		}, nil
+
+	case "unlink.inode":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Unlink.Inode) },
+
+			Field: field,
+		}, nil
+
+	case "unlink.overlay_numlower":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Unlink.OverlayNumLower) },
+
+			Field: field,
+		}, nil
+
+	case "unlink.retval":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Unlink.Retval) },
+
+			Field: field,
+		}, nil
+
+	case "utimes.basename":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Utimes.ResolveBasename((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "utimes.container_path":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Utimes.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "utimes.filename":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Utimes.ResolveInode((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "utimes.inode":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Utimes.Inode) },
+
+			Field: field,
+		}, nil
+
+	case "utimes.overlay_numlower":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Utimes.OverlayNumLower) },
+
+			Field: field,
+		}, nil
+
+	case "utimes.retval":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Utimes.Retval) },
+
+			Field: field,
+		}, nil
+
+	case "write.basename":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Write.ResolveBasename((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "write.container_path":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Write.ResolveContainerPath((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "write.count":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Write.Count) },
+
+			Field: field,
+		}, nil
+
+	case "write.filename":
+
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				return (*Event)(ctx.Object).Write.ResolveInode((*Event)(ctx.Object).resolvers)
+			},
+
+			Field: field,
+		}, nil
+
+	case "write.inode":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Write.Inode) },
+
+			Field: field,
+		}, nil
+
+	case "write.overlay_numlower":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Write.OverlayNumLower) },
+
+			Field: field,
+		}, nil
+
+	case "write.retval":
+
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return int((*Event)(ctx.Object).Write.Retval) },
+
+			Field: field,
+		}, nil
+
+	}
+
+	return nil, &eval.ErrFieldNotFound{Field: field}
+}
+
+func (e *Event) GetFieldValue(field eval.Field) (interface{}, error) {
+	switch field {
+
+	case "bind.addr":
+
+		return e.Bind.ResolveAddr(e.resolvers), nil
+
+	case "bind.family":
+
+		return int(e.Bind.Family), nil
+
+	case "bind.port":
+
+		return int(e.Bind.Port), nil
+
+	case "chdir.basename":
+
+		return e.Chdir.ResolveBasename(e.resolvers), nil
+
+	case "chdir.container_path":
+
+		return e.Chdir.ResolveContainerPath(e.resolvers), nil
+
+	case "chdir.filename":
+
+		return e.Chdir.ResolveInode(e.resolvers), nil
+
+	case "chdir.inode":
+
+		return int(e.Chdir.Inode), nil
+
+	case "chdir.overlay_numlower":
+
+		return int(e.Chdir.OverlayNumLower), nil
+
+	case "chdir.retval":
+
+		return int(e.Chdir.Retval), nil
+
+	case "chmod.basename":
+
+		return e.Chmod.ResolveBasename(e.resolvers), nil
+
+	case "chmod.container_path":
+
+		return e.Chmod.ResolveContainerPath(e.resolvers), nil
+
+	case "chmod.filename":
+
+		return e.Chmod.ResolveInode(e.resolvers), nil
+
+	case "chmod.inode":
+
+		return int(e.Chmod.Inode), nil
+
+	case "chmod.mode":
+
+		return int(e.Chmod.Mode), nil
+
+	case "chmod.overlay_numlower":
+
+		return int(e.Chmod.OverlayNumLower), nil
+
+	case "chmod.retval":
+
+		return int(e.Chmod.Retval), nil
+
+	case "chown.basename":
+
+		return e.Chown.ResolveBasename(e.resolvers), nil
+
+	case "chown.container_path":
+
+		return e.Chown.ResolveContainerPath(e.resolvers), nil
+
+	case "chown.filename":
+
+		return e.Chown.ResolveInode(e.resolvers), nil
+
+	case "chown.gid":
+
+		return int(e.Chown.GID), nil
+
+	case "chown.inode":
+
+		return int(e.Chown.Inode), nil
+
+	case "chown.overlay_numlower":
+
+		return int(e.Chown.OverlayNumLower), nil
+
+	case "chown.retval":
+
+		return int(e.Chown.Retval), nil
+
+	case "chown.uid":
+
+		return int(e.Chown.UID), nil
+
+	case "clone.flags":
+
+		return int(e.Clone.Flags), nil
+
+	case "clone.retval":
+
+		return int(e.Clone.Retval), nil
+
+	case "container.id":
+
+		return e.Container.ResolveContainerID(e.resolvers), nil
+
+	case "dup.new_fd":
+
+		return int(e.Dup.NewFd), nil
+
+	case "dup.old_fd":
+
+		return int(e.Dup.OldFd), nil
+
+	case "dup.retval":
+
+		return int(e.Dup.Retval), nil
+
+	case "fallocate.basename":
+
+		return e.Fallocate.ResolveBasename(e.resolvers), nil
+
+	case "fallocate.container_path":
+
+		return e.Fallocate.ResolveContainerPath(e.resolvers), nil
+
+	case "fallocate.filename":
+
+		return e.Fallocate.ResolveInode(e.resolvers), nil
+
+	case "fallocate.inode":
+
+		return int(e.Fallocate.Inode), nil
+
+	case "fallocate.length":
+
+		return int(e.Fallocate.Length), nil
+
+	case "fallocate.mode":
+
+		return int(e.Fallocate.Mode), nil
+
+	case "fallocate.overlay_numlower":
+
+		return int(e.Fallocate.OverlayNumLower), nil
+
+	case "fallocate.retval":
+
+		return int(e.Fallocate.Retval), nil
+
+	case "getxattr.basename":
+
+		return e.GetXattr.ResolveBasename(e.resolvers), nil
+
+	case "getxattr.container_path":
+
+		return e.GetXattr.ResolveContainerPath(e.resolvers), nil
+
+	case "getxattr.filename":
+
+		return e.GetXattr.ResolveInode(e.resolvers), nil
+
+	case "getxattr.inode":
+
+		return int(e.GetXattr.Inode), nil
+
+	case "getxattr.name":
+
+		return e.GetXattr.ResolveName(e.resolvers), nil
+
+	case "getxattr.overlay_numlower":
+
+		return int(e.GetXattr.OverlayNumLower), nil
+
+	case "getxattr.retval":
+
+		return int(e.GetXattr.Retval), nil
+
+	case "ioctl.fd":
+
+		return int(e.Ioctl.Fd), nil
+
+	case "ioctl.request":
+
+		return int(e.Ioctl.Request), nil
+
+	case "ioctl.retval":
+
+		return int(e.Ioctl.Retval), nil
+
+	case "keyctl.description":
+
+		return e.Keyctl.ResolveDescription(e.resolvers), nil
+
+	case "keyctl.key_id":
+
+		return int(e.Keyctl.KeyID), nil
+
+	case "keyctl.key_type":
+
+		return e.Keyctl.ResolveKeyType(e.resolvers), nil
+
+	case "keyctl.operation":
+
+		return int(e.Keyctl.Operation), nil
+
+	case "keyctl.retval":
+
+		return int(e.Keyctl.Retval), nil
+
+	case "link.retval":
+
+		return int(e.Link.Retval), nil
+
+	case "link.source.basename":
+
+		return e.Link.Source.ResolveBasename(e.resolvers), nil
+
+	case "link.source.container_path":
+
+		return e.Link.Source.ResolveContainerPath(e.resolvers), nil
+
+	case "link.source.filename":
+
+		return e.Link.Source.ResolveInode(e.resolvers), nil
+
+	case "link.source.inode":
+
+		return int(e.Link.Source.Inode), nil
+
+	case "link.source.overlay_numlower":
+
+		return int(e.Link.Source.OverlayNumLower), nil
+
+	case "link.target.basename":
+
+		return e.Link.Target.ResolveBasename(e.resolvers), nil
+
+	case "link.target.container_path":
+
+		return e.Link.Target.ResolveContainerPath(e.resolvers), nil
+
+	case "link.target.filename":
+
+		return e.Link.Target.ResolveInode(e.resolvers), nil
+
+	case "link.target.inode":
+
+		return int(e.Link.Target.Inode), nil
+
+	case "link.target.overlay_numlower":
+
+		return int(e.Link.Target.OverlayNumLower), nil
+
+	case "load_module.basename":
+
+		return e.LoadModule.ResolveBasename(e.resolvers), nil
+
+	case "load_module.container_path":
+
+		return e.LoadModule.ResolveContainerPath(e.resolvers), nil
+
+	case "load_module.filename":
+
+		return e.LoadModule.ResolveInode(e.resolvers), nil
+
+	case "load_module.inode":
+
+		return int(e.LoadModule.Inode), nil
+
+	case "load_module.loaded_from_memory":
+
+		return e.LoadModule.LoadedFromMemory, nil
+
+	case "load_module.overlay_numlower":
+
+		return int(e.LoadModule.OverlayNumLower), nil
+
+	case "load_module.retval":
+
+		return int(e.LoadModule.Retval), nil
+
+	case "memfd.flags":
+
+		return int(e.Memfd.Flags), nil
+
+	case "memfd.name":
+
+		return e.Memfd.ResolveName(e.resolvers), nil
+
+	case "memfd.retval":
+
+		return int(e.Memfd.Retval), nil
+
+	case "mkdir.basename":
+
+		return e.Mkdir.ResolveBasename(e.resolvers), nil
+
+	case "mkdir.container_path":
+
+		return e.Mkdir.ResolveContainerPath(e.resolvers), nil
+
+	case "mkdir.filename":
+
+		return e.Mkdir.ResolveInode(e.resolvers), nil
+
+	case "mkdir.inode":
+
+		return int(e.Mkdir.Inode), nil
+
+	case "mkdir.mode":
+
+		return int(e.Mkdir.Mode), nil
+
+	case "mkdir.overlay_numlower":
+
+		return int(e.Mkdir.OverlayNumLower), nil
+
+	case "mkdir.retval":
+
+		return int(e.Mkdir.Retval), nil
+
+	case "mount.flags":
+
+		return int(e.Mount.Flags), nil
+
+	case "mount.fs_type":
+
+		return e.Mount.ResolveFSType(e.resolvers), nil
+
+	case "mount.mountpoint":
+
+		return e.Mount.ResolveMountPoint(e.resolvers), nil
+
+	case "mount.retval":
+
+		return int(e.Mount.Retval), nil
+
+	case "open.basename":
+
+		return e.Open.ResolveBasename(e.resolvers), nil
+
+	case "open.container_path":
+
+		return e.Open.ResolveContainerPath(e.resolvers), nil
+
+	case "open.filename":
+
+		return e.Open.ResolveInode(e.resolvers), nil
+
+	case "open.flags":
+
+		return int(e.Open.Flags), nil
+
+	case "open.inode":
+
+		return int(e.Open.Inode), nil
+
+	case "open.mode":
+
+		return int(e.Open.Mode), nil
+
+	case "open.overlay_numlower":
+
+		return int(e.Open.OverlayNumLower), nil
+
+	case "open.retval":
+
+		return int(e.Open.Retval), nil
+
+	case "pipe.flags":
+
+		return int(e.Pipe.Flags), nil
+
+	case "pipe.read_fd":
+
+		return int(e.Pipe.ReadFd), nil
+
+	case "pipe.retval":
+
+		return int(e.Pipe.Retval), nil
+
+	case "pipe.write_fd":
+
+		return int(e.Pipe.WriteFd), nil
+
+	case "prctl.arg2":
+
+		return int(e.Prctl.Arg2), nil
+
+	case "prctl.arg3":
+
+		return int(e.Prctl.Arg3), nil
+
+	case "prctl.arg4":
+
+		return int(e.Prctl.Arg4), nil
+
+	case "prctl.arg5":
+
+		return int(e.Prctl.Arg5), nil
+
+	case "prctl.name":
+
+		return e.Prctl.ResolveName(e.resolvers), nil
+
+	case "prctl.option":
+
+		return int(e.Prctl.Option), nil
+
+	case "prctl.retval":
+
+		return int(e.Prctl.Retval), nil
+
+	case "process.args":
+
+		return e.Process.ResolveArgs(e.resolvers), nil
+
+	case "process.args_truncated":
+
+		return e.Process.ResolveArgsTruncated(e.resolvers), nil
+
+	case "process.basename":
+
+		return e.Process.ResolveBasename(e.resolvers), nil
+
+	case "process.container_path":
+
+		return e.Process.ResolveContainerPath(e.resolvers), nil
+
+	case "process.cwd":
+
+		return e.Process.ResolveCwd(e.resolvers), nil
+
+	case "process.filename":
+
+		return e.Process.ResolveInode(e.resolvers), nil
+
+	case "process.gid":
+
+		return int(e.Process.GID), nil
+
+	case "process.group":
+
+		return e.Process.ResolveGroup(e.resolvers), nil
+
+	case "process.inode":
+
+		return int(e.Process.Inode), nil
+
+	case "process.is_memfd":
+
+		return e.Process.ResolveIsMemfd(e.resolvers), nil
+
+	case "process.name":
+
+		return e.Process.ResolveComm(e.resolvers), nil
+
+	case "process.overlay_numlower":
+
+		return int(e.Process.OverlayNumLower), nil
+
+	case "process.pid":
+
+		return int(e.Process.Pid), nil
+
+	case "process.pidns":
+
+		return int(e.Process.Pidns), nil
+
+	case "process.tid":
+
+		return int(e.Process.Tid), nil
+
+	case "process.tty_name":
+
+		return e.Process.ResolveTTY(e.resolvers), nil
+
+	case "process.uid":
+
+		return int(e.Process.UID), nil
+
+	case "process.umask":
+
+		return int(e.Process.ResolveUmask(e.resolvers)), nil
+
+	case "process.user":
+
+		return e.Process.ResolveUser(e.resolvers), nil
+
+	case "quotactl.cmd":
+
+		return int(e.Quotactl.Cmd), nil
+
+	case "quotactl.id":
+
+		return int(e.Quotactl.ID), nil
+
+	case "quotactl.retval":
+
+		return int(e.Quotactl.Retval), nil
+
+	case "quotactl.special":
+
+		return e.Quotactl.ResolveSpecial(e.resolvers), nil
+
+	case "quotactl.type":
+
+		return int(e.Quotactl.QuotaType), nil
+
+	case "rename.new.basename":
+
+		return e.Rename.New.ResolveBasename(e.resolvers), nil
+
+	case "rename.new.container_path":
+
+		return e.Rename.New.ResolveContainerPath(e.resolvers), nil
+
+	case "rename.new.filename":
+
+		return e.Rename.New.ResolveInode(e.resolvers), nil
+
+	case "rename.new.inode":
+
+		return int(e.Rename.New.Inode), nil
+
+	case "rename.new.overlay_numlower":
+
+		return int(e.Rename.New.OverlayNumLower), nil
+
+	case "rename.old.basename":
+
+		return e.Rename.Old.ResolveBasename(e.resolvers), nil
+
+	case "rename.old.container_path":
+
+		return e.Rename.Old.ResolveContainerPath(e.resolvers), nil
+
+	case "rename.old.filename":
+
+		return e.Rename.Old.ResolveInode(e.resolvers), nil
+
+	case "rename.old.inode":
+
+		return int(e.Rename.Old.Inode), nil
+
+	case "rename.old.overlay_numlower":
+
+		return int(e.Rename.Old.OverlayNumLower), nil
+
+	case "rename.retval":
+
+		return int(e.Rename.Retval), nil
+
+	case "rmdir.basename":
+
+		return e.Rmdir.ResolveBasename(e.resolvers), nil
+
+	case "rmdir.container_path":
+
+		return e.Rmdir.ResolveContainerPath(e.resolvers), nil
+
+	case "rmdir.filename":
+
+		return e.Rmdir.ResolveInode(e.resolvers), nil
+
+	case "rmdir.inode":
+
+		return int(e.Rmdir.Inode), nil
+
+	case "rmdir.overlay_numlower":
+
+		return int(e.Rmdir.OverlayNumLower), nil
+
+	case "rmdir.retval":
+
+		return int(e.Rmdir.Retval), nil
+
+	case "sendfile.count":
+
+		return int(e.Sendfile.Count), nil
+
+	case "sendfile.in_fd":
+
+		return int(e.Sendfile.InFd), nil
+
+	case "sendfile.out_fd":
+
+		return int(e.Sendfile.OutFd), nil
+
+	case "sendfile.retval":
+
+		return int(e.Sendfile.Retval), nil
+
+	case "setns.fd":
+
+		return int(e.Setns.Fd), nil
+
+	case "setns.nstype":
+
+		return int(e.Setns.NSType), nil
+
+	case "setns.retval":
+
+		return int(e.Setns.Retval), nil
+
+	case "setrlimit.hard":
+
+		return int(e.Setrlimit.Hard), nil
+
+	case "setrlimit.pid":
+
+		return int(e.Setrlimit.Pid), nil
+
+	case "setrlimit.resource":
+
+		return int(e.Setrlimit.Resource), nil
+
+	case "setrlimit.retval":
+
+		return int(e.Setrlimit.Retval), nil
+
+	case "setrlimit.soft":
+
+		return int(e.Setrlimit.Soft), nil
+
+	case "stat.basename":
+
+		return e.Stat.ResolveBasename(e.resolvers), nil
+
+	case "stat.container_path":
+
+		return e.Stat.ResolveContainerPath(e.resolvers), nil
+
+	case "stat.filename":
+
+		return e.Stat.ResolveInode(e.resolvers), nil
+
+	case "stat.inode":
+
+		return int(e.Stat.Inode), nil
+
+	case "stat.overlay_numlower":
+
+		return int(e.Stat.OverlayNumLower), nil
+
+	case "stat.retval":
+
+		return int(e.Stat.Retval), nil
+
+	case "umask.mask":
+
+		return int(e.Umask.Mask), nil
+
+	case "umask.retval":
+
+		return int(e.Umask.Retval), nil
+
+	case "unlink.basename":
+
+		return e.Unlink.ResolveBasename(e.resolvers), nil
+
+	case "unlink.container_path":
+
+		return e.Unlink.ResolveContainerPath(e.resolvers), nil
+
+	case "unlink.filename":
+
+		return e.Unlink.ResolveInode(e.resolvers), nil
+
+	case "unlink.flags":
+
+		return int(e.Unlink.Flags), nil
+
+	case "unlink.inode":
+
+		return int(e.Unlink.Inode), nil
+
+	case "unlink.overlay_numlower":
+
+		return int(e.Unlink.OverlayNumLower), nil
+
+	case "unlink.retval":
+
+		return int(e.Unlink.Retval), nil
+
+	case "utimes.basename":
+
+		return e.Utimes.ResolveBasename(e.resolvers), nil
+
+	case "utimes.container_path":
+
+		return e.Utimes.ResolveContainerPath(e.resolvers), nil
+
+	case "utimes.filename":
+
+		return e.Utimes.ResolveInode(e.resolvers), nil
+
+	case "utimes.inode":
+
+		return int(e.Utimes.Inode), nil
+
+	case "utimes.overlay_numlower":
+
+		return int(e.Utimes.OverlayNumLower), nil
+
+	case "utimes.retval":
+
+		return int(e.Utimes.Retval), nil
+
+	case "write.basename":
+
+		return e.Write.ResolveBasename(e.resolvers), nil
+
+	case "write.container_path":
+
+		return e.Write.ResolveContainerPath(e.resolvers), nil
+
+	case "write.count":
+
+		return int(e.Write.Count), nil
+
+	case "write.filename":
+
+		return e.Write.ResolveInode(e.resolvers), nil
+
+	case "write.inode":
+
+		return int(e.Write.Inode), nil
+
+	case "write.overlay_numlower":
+
+		return int(e.Write.OverlayNumLower), nil
+
+	case "write.retval":
+
+		return int(e.Write.Retval), nil
+
+	}
+
+	return nil, &eval.ErrFieldNotFound{Field: field}
+}
+
+func (e *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
+	switch field {
+
+	case "bind.addr":
+		return "bind", nil
+
+	case "bind.family":
+		return "bind", nil
+
+	case "bind.port":
+		return "bind", nil
+
+	case "chdir.basename":
+		return "chdir", nil
+
+	case "chdir.container_path":
+		return "chdir", nil
+
+	case "chdir.filename":
+		return "chdir", nil
+
+	case "chdir.inode":
+		return "chdir", nil
+
+	case "chdir.overlay_numlower":
+		return "chdir", nil
+
+	case "chdir.retval":
+		return "chdir", nil
+
+	case "chmod.basename":
+		return "chmod", nil
+
+	case "chmod.container_path":
+		return "chmod", nil
+
+	case "chmod.filename":
+		return "chmod", nil
+
+	case "chmod.inode":
+		return "chmod", nil
+
+	case "chmod.mode":
+		return "chmod", nil
+
+	case "chmod.overlay_numlower":
+		return "chmod", nil
+
+	case "chmod.retval":
+		return "chmod", nil
+
+	case "chown.basename":
+		return "chown", nil
+
+	case "chown.container_path":
+		return "chown", nil
+
+	case "chown.filename":
+		return "chown", nil
+
+	case "chown.gid":
+		return "chown", nil
+
+	case "chown.inode":
+		return "chown", nil
+
+	case "chown.overlay_numlower":
+		return "chown", nil
+
+	case "chown.retval":
+		return "chown", nil
+
+	case "chown.uid":
+		return "chown", nil
+
+	case "clone.flags":
+		return "clone", nil
+
+	case "clone.retval":
+		return "clone", nil
+
+	case "container.id":
+		return "*", nil
+
+	case "dup.new_fd":
+		return "dup", nil
+
+	case "dup.old_fd":
+		return "dup", nil
+
+	case "dup.retval":
+		return "dup", nil
+
+	case "fallocate.basename":
+		return "fallocate", nil
+
+	case "fallocate.container_path":
+		return "fallocate", nil
+
+	case "fallocate.filename":
+		return "fallocate", nil
+
+	case "fallocate.inode":
+		return "fallocate", nil
+
+	case "fallocate.length":
+		return "fallocate", nil
+
+	case "fallocate.mode":
+		return "fallocate", nil
+
+	case "fallocate.overlay_numlower":
+		return "fallocate", nil
+
+	case "fallocate.retval":
+		return "fallocate", nil
+
+	case "getxattr.basename":
+		return "getxattr", nil
+
+	case "getxattr.container_path":
+		return "getxattr", nil
+
+	case "getxattr.filename":
+		return "getxattr", nil
+
+	case "getxattr.inode":
+		return "getxattr", nil
+
+	case "getxattr.name":
+		return "getxattr", nil
+
+	case "getxattr.overlay_numlower":
+		return "getxattr", nil
+
+	case "getxattr.retval":
+		return "getxattr", nil
+
+	case "ioctl.fd":
+		return "ioctl", nil
+
+	case "ioctl.request":
+		return "ioctl", nil
+
+	case "ioctl.retval":
+		return "ioctl", nil
+
+	case "keyctl.description":
+		return "keyctl", nil
+
+	case "keyctl.key_id":
+		return "keyctl", nil
+
+	case "keyctl.key_type":
+		return "keyctl", nil
+
+	case "keyctl.operation":
+		return "keyctl", nil
+
+	case "keyctl.retval":
+		return "keyctl", nil
+
+	case "link.retval":
+		return "link", nil
+
+	case "link.source.basename":
+		return "link", nil
+
+	case "link.source.container_path":
+		return "link", nil
+
+	case "link.source.filename":
+		return "link", nil
+
+	case "link.source.inode":
+		return "link", nil
+
+	case "link.source.overlay_numlower":
+		return "link", nil
+
+	case "link.target.basename":
+		return "link", nil
+
+	case "link.target.container_path":
+		return "link", nil
+
+	case "link.target.filename":
+		return "link", nil
+
+	case "link.target.inode":
+		return "link", nil
 
 	case "link.target.overlay_numlower":
+		return "link", nil
+
+	case "load_module.basename":
+		return "load_module", nil
+
+	case "load_module.container_path":
+		return "load_module", nil
+
+	case "load_module.filename":
+		return "load_module", nil
+
+	case "load_module.inode":
+		return "load_module", nil
+
+	case "load_module.loaded_from_memory":
+		return "load_module", nil
+
+	case "load_module.overlay_numlower":
+		return "load_module", nil
+
+	case "load_module.retval":
+		return "load_module", nil
+
+	case "memfd.flags":
+		return "memfd", nil
+
+	case "memfd.name":
+		return "memfd", nil
+
+	case "memfd.retval":
+		return "memfd", nil
+
+	case "mkdir.basename":
+		return "mkdir", nil
+
+	case "mkdir.container_path":
+		return "mkdir", nil
+
+	case "mkdir.filename":
+		return "mkdir", nil
+
+	case "mkdir.inode":
+		return "mkdir", nil
+
+	case "mkdir.mode":
+		return "mkdir", nil
+
+	case "mkdir.overlay_numlower":
+		return "mkdir", nil
+
+	case "mkdir.retval":
+		return "mkdir", nil
+
+	case "mount.flags":
+		return "mount", nil
+
+	case "mount.fs_type":
+		return "mount", nil
+
+	case "mount.mountpoint":
+		return "mount", nil
+
+	case "mount.retval":
+		return "mount", nil
+
+	case "open.basename":
+		return "open", nil
+
+	case "open.container_path":
+		return "open", nil
+
+	case "open.filename":
+		return "open", nil
+
+	case "open.flags":
+		return "open", nil
+
+	case "open.inode":
+		return "open", nil
+
+	case "open.mode":
+		return "open", nil
+
+	case "open.overlay_numlower":
+		return "open", nil
+
+	case "open.retval":
+		return "open", nil
+
+	case "pipe.flags":
+		return "pipe", nil
+
+	case "pipe.read_fd":
+		return "pipe", nil
+
+	case "pipe.retval":
+		return "pipe", nil
+
+	case "pipe.write_fd":
+		return "pipe", nil
+
+	case "prctl.arg2":
+		return "prctl", nil
+
+	case "prctl.arg3":
+		return "prctl", nil
+
+	case "prctl.arg4":
+		return "prctl", nil
+
+	case "prctl.arg5":
+		return "prctl", nil
+
+	case "prctl.name":
+		return "prctl", nil
+
+	case "prctl.option":
+		return "prctl", nil
+
+	case "prctl.retval":
+		return "prctl", nil
+
+	case "process.args":
+		return "*", nil
+
+	case "process.args_truncated":
+		return "*", nil
+
+	case "process.basename":
+		return "*", nil
+
+	case "process.container_path":
+		return "*", nil
+
+	case "process.cwd":
+		return "*", nil
+
+	case "process.filename":
+		return "*", nil
 
-		return int(e.Link.Target.OverlayNumLower), nil
+	case "process.gid":
+		return "*", nil
 
-	case "mkdir.basename":
+	case "process.group":
+		return "*", nil
+
+	case "process.inode":
+		return "*", nil
+
+	case "process.is_memfd":
+		return "*", nil
+
+	case "process.name":
+		return "*", nil
+
+	case "process.overlay_numlower":
+		return "*", nil
+
+	case "process.pid":
+		return "*", nil
+
+	case "process.pidns":
+		return "*", nil
+
+	case "process.tid":
+		return "*", nil
+
+	case "process.tty_name":
+		return "*", nil
+
+	case "process.uid":
+		return "*", nil
+
+	case "process.umask":
+		return "*", nil
+
+	case "process.user":
+		return "*", nil
+
+	case "quotactl.cmd":
+		return "quotactl", nil
+
+	case "quotactl.id":
+		return "quotactl", nil
+
+	case "quotactl.retval":
+		return "quotactl", nil
+
+	case "quotactl.special":
+		return "quotactl", nil
+
+	case "quotactl.type":
+		return "quotactl", nil
+
+	case "rename.new.basename":
+		return "rename", nil
+
+	case "rename.new.container_path":
+		return "rename", nil
+
+	case "rename.new.filename":
+		return "rename", nil
+
+	case "rename.new.inode":
+		return "rename", nil
+
+	case "rename.new.overlay_numlower":
+		return "rename", nil
+
+	case "rename.old.basename":
+		return "rename", nil
+
+	case "rename.old.container_path":
+		return "rename", nil
+
+	case "rename.old.filename":
+		return "rename", nil
+
+	case "rename.old.inode":
+		return "rename", nil
+
+	case "rename.old.overlay_numlower":
+		return "rename", nil
+
+	case "rename.retval":
+		return "rename", nil
+
+	case "rmdir.basename":
+		return "rmdir", nil
+
+	case "rmdir.container_path":
+		return "rmdir", nil
+
+	case "rmdir.filename":
+		return "rmdir", nil
+
+	case "rmdir.inode":
+		return "rmdir", nil
+
+	case "rmdir.overlay_numlower":
+		return "rmdir", nil
+
+	case "rmdir.retval":
+		return "rmdir", nil
+
+	case "sendfile.count":
+		return "sendfile", nil
+
+	case "sendfile.in_fd":
+		return "sendfile", nil
+
+	case "sendfile.out_fd":
+		return "sendfile", nil
+
+	case "sendfile.retval":
+		return "sendfile", nil
+
+	case "setns.fd":
+		return "setns", nil
+
+	case "setns.nstype":
+		return "setns", nil
+
+	case "setns.retval":
+		return "setns", nil
+
+	case "setrlimit.hard":
+		return "setrlimit", nil
+
+	case "setrlimit.pid":
+		return "setrlimit", nil
+
+	case "setrlimit.resource":
+		return "setrlimit", nil
+
+	case "setrlimit.retval":
+		return "setrlimit", nil
+
+	case "setrlimit.soft":
+		return "setrlimit", nil
+
+	case "stat.basename":
+		return "stat", nil
+
+	case "stat.container_path":
+		return "stat", nil
+
+	case "stat.filename":
+		return "stat", nil
+
+	case "stat.inode":
+		return "stat", nil
+
+	case "stat.overlay_numlower":
+		return "stat", nil
+
+	case "stat.retval":
+		return "stat", nil
+
+	case "umask.mask":
+		return "umask", nil
+
+	case "umask.retval":
+		return "umask", nil
+
+	case "unlink.basename":
+		return "unlink", nil
+
+	case "unlink.container_path":
+		return "unlink", nil
+
+	case "unlink.filename":
+		return "unlink", nil
+
+	case "unlink.flags":
+		return "unlink", nil
+
+	case "unlink.inode":
+		return "unlink", nil
+
+	case "unlink.overlay_numlower":
+		return "unlink", nil
+
+	case "unlink.retval":
+		return "unlink", nil
+
+	case "utimes.basename":
+		return "utimes", nil
+
+	case "utimes.container_path":
+		return "utimes", nil
+
+	case "utimes.filename":
+		return "utimes", nil
+
+	case "utimes.inode":
+		return "utimes", nil
+
+	case "utimes.overlay_numlower":
+		return "utimes", nil
+
+	case "utimes.retval":
+		return "utimes", nil
+
+	case "write.basename":
+		return "write", nil
+
+	case "write.container_path":
+		return "write", nil
+
+	case "write.count":
+		return "write", nil
+
+	case "write.filename":
+		return "write", nil
+
+	case "write.inode":
+		return "write", nil
+
+	case "write.overlay_numlower":
+		return "write", nil
+
+	case "write.retval":
+		return "write", nil
+
+	}
+
+	return "", &eval.ErrFieldNotFound{Field: field}
+}
+
+func (e *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
+	switch field {
+
+	case "bind.addr":
+
+		return reflect.String, nil
+
+	case "bind.family":
+
+		return reflect.Int, nil
+
+	case "bind.port":
+
+		return reflect.Int, nil
+
+	case "chdir.basename":
+
+		return reflect.String, nil
+
+	case "chdir.container_path":
+
+		return reflect.String, nil
+
+	case "chdir.filename":
+
+		return reflect.String, nil
+
+	case "chdir.inode":
+
+		return reflect.Int, nil
+
+	case "chdir.overlay_numlower":
+
+		return reflect.Int, nil
+
+	case "chdir.retval":
+
+		return reflect.Int, nil
+
+	case "chmod.basename":
+
+		return reflect.String, nil
+
+	case "chmod.container_path":
+
+		return reflect.String, nil
+
+	case "chmod.filename":
+
+		return reflect.String, nil
+
+	case "chmod.inode":
+
+		return reflect.Int, nil
+
+	case "chmod.mode":
+
+		return reflect.Int, nil
+
+	case "chmod.overlay_numlower":
+
+		return reflect.Int, nil
+
+	case "chmod.retval":
+
+		return reflect.Int, nil
+
+	case "chown.basename":
+
+		return reflect.String, nil
+
+	case "chown.container_path":
+
+		return reflect.String, nil
+
+	case "chown.filename":
+
+		return reflect.String, nil
+
+	case "chown.gid":
+
+		return reflect.Int, nil
+
+	case "chown.inode":
+
+		return reflect.Int, nil
+
+	case "chown.overlay_numlower":
+
+		return reflect.Int, nil
+
+	case "chown.retval":
+
+		return reflect.Int, nil
+
+	case "chown.uid":
+
+		return reflect.Int, nil
+
+	case "clone.flags":
 
-		return e.Mkdir.ResolveBasename(e.resolvers), nil
+		return reflect.Int, nil
 
-	case "mkdir.container_path":
+	case "clone.retval":
 
-		return e.Mkdir.ResolveContainerPath(e.resolvers), nil
+		return reflect.Int, nil
 
-	case "mkdir.filename":
+	case "container.id":
 
-		return e.Mkdir.ResolveInode(e.resolvers), nil
+		return reflect.String, nil
 
-	case "mkdir.inode":
+	case "dup.new_fd":
 
-		return int(e.Mkdir.Inode), nil
+		return reflect.Int, nil
 
-	case "mkdir.mode":
+	case "dup.old_fd":
 
-		return int(e.Mkdir.Mode), nil
+		return reflect.Int, nil
 
-	case "mkdir.overlay_numlower":
+	case "dup.retval":
 
-		return int(e.Mkdir.OverlayNumLower), nil
+		return reflect.Int, nil
 
-	case "mkdir.retval":
+	case "fallocate.basename":
 
-		return int(e.Mkdir.Retval), nil
+		return reflect.String, nil
 
-	case "open.basename":
+	case "fallocate.container_path":
 
-		return e.Open.ResolveBasename(e.resolvers), nil
+		return reflect.String, nil
 
-	case "open.container_path":
+	case "fallocate.filename":
 
-		return e.Open.ResolveContainerPath(e.resolvers), nil
+		return reflect.String, nil
 
-	case "open.filename":
+	case "fallocate.inode":
 
-		return e.Open.ResolveInode(e.resolvers), nil
+		return reflect.Int, nil
 
-	case "open.flags":
+	case "fallocate.length":
 
-		return int(e.Open.Flags), nil
+		return reflect.Int, nil
 
-	case "open.inode":
+	case "fallocate.mode":
 
-		return int(e.Open.Inode), nil
+		return reflect.Int, nil
 
-	case "open.mode":
+	case "fallocate.overlay_numlower":
 
-		return int(e.Open.Mode), nil
+		return reflect.Int, nil
 
-	case "open.overlay_numlower":
+	case "fallocate.retval":
 
-		return int(e.Open.OverlayNumLower), nil
+		return reflect.Int, nil
 
-	case "open.retval":
+	case "getxattr.basename":
 
-		return int(e.Open.Retval), nil
+		return reflect.String, nil
 
-	case "process.basename":
+	case "getxattr.container_path":
 
-		return e.Process.ResolveBasename(e.resolvers), nil
+		return reflect.String, nil
 
-	case "process.container_path":
+	case "getxattr.filename":
 
-		return e.Process.ResolveContainerPath(e.resolvers), nil
+		return reflect.String, nil
 
-	case "process.filename":
+	case "getxattr.inode":
 
-		return e.Process.ResolveInode(e.resolvers), nil
+		return reflect.Int, nil
 
-	case "process.gid":
+	case "getxattr.name":
 
-		return int(e.Process.GID), nil
+		return reflect.String, nil
 
-	case "process.group":
+	case "getxattr.overlay_numlower":
 
-		return e.Process.ResolveGroup(e.resolvers), nil
+		return reflect.Int, nil
 
-	case "process.inode":
+	case "getxattr.retval":
 
-		return int(e.Process.Inode), nil
+		return reflect.Int, nil
 
-	case "process.name":
+	case "ioctl.fd":
 
-		return e.Process.ResolveComm(e.resolvers), nil
+		return reflect.Int, nil
 
-	case "process.overlay_numlower":
+	case "ioctl.request":
 
-		return int(e.Process.OverlayNumLower), nil
+		return reflect.Int, nil
 
-	case "process.pid":
+	case "ioctl.retval":
 
-		return int(e.Process.Pid), nil
+		return reflect.Int, nil
 
-	case "process.pidns":
+	case "keyctl.description":
 
-		return int(e.Process.Pidns), nil
+		return reflect.String, nil
 
-	case "process.tid":
+	case "keyctl.key_id":
 
-		return int(e.Process.Tid), nil
+		return reflect.Int, nil
 
-	case "process.tty_name":
+	case "keyctl.key_type":
 
-		return e.Process.ResolveTTY(e.resolvers), nil
+		return reflect.String, nil
 
-	case "process.uid":
+	case "keyctl.operation":
 
-		return int(e.Process.UID), nil
+		return reflect.Int, nil
 
-	case "process.user":
+	case "keyctl.retval":
 
-		return e.Process.ResolveUser(e.resolvers), nil
+		return reflect.Int, nil
 
-	case "rename.new.basename":
+	case "link.retval":
 
-		return e.Rename.New.ResolveBasename(e.resolvers), nil
+		return reflect.Int, nil
 
-	case "rename.new.container_path":
+	case "link.source.basename":
 
-		return e.Rename.New.ResolveContainerPath(e.resolvers), nil
+		return reflect.String, nil
 
-	case "rename.new.filename":
+	case "link.source.container_path":
 
-		return e.Rename.New.ResolveInode(e.resolvers), nil
+		return reflect.String, nil
 
-	case "rename.new.inode":
+	case "link.source.filename":
 
-		return int(e.Rename.New.Inode), nil
+		return reflect.String, nil
 
-	case "rename.new.overlay_numlower":
+	case "link.source.inode":
 
-		return int(e.Rename.New.OverlayNumLower), nil
+		return reflect.Int, nil
 
-	case "rename.old.basename":
+	case "link.source.overlay_numlower":
 
-		return e.Rename.Old.ResolveBasename(e.resolvers), nil
+		return reflect.Int, nil
 
-	case "rename.old.container_path":
+	case "link.target.basename":
 
-		return e.Rename.Old.ResolveContainerPath(e.resolvers), nil
+		return reflect.String, nil
 
-	case "rename.old.filename":
+	case "link.target.container_path":
 
-		return e.Rename.Old.ResolveInode(e.resolvers), nil
+		return reflect.String, nil
 
-	case "rename.old.inode":
+	case "link.target.filename":
 
-		return int(e.Rename.Old.Inode), nil
+		return reflect.String, nil
 
-	case "rename.old.overlay_numlower":
+	case "link.target.inode":
 
-		return int(e.Rename.Old.OverlayNumLower), nil
+		return reflect.Int, nil
 
-	case "rename.retval":
+	case "link.target.overlay_numlower":
 
-		return int(e.Rename.Retval), nil
+		return reflect.Int, nil
 
-	case "rmdir.basename":
+	case "load_module.basename":
 
-		return e.Rmdir.ResolveBasename(e.resolvers), nil
+		return reflect.String, nil
 
-	case "rmdir.container_path":
+	case "load_module.container_path":
 
-		return e.Rmdir.ResolveContainerPath(e.resolvers), nil
+		return reflect.String, nil
 
-	case "rmdir.filename":
+	case "load_module.filename":
 
-		return e.Rmdir.ResolveInode(e.resolvers), nil
+		return reflect.String, nil
 
-	case "rmdir.inode":
+	case "load_module.inode":
 
-		return int(e.Rmdir.Inode), nil
+		return reflect.Int, nil
 
-	case "rmdir.overlay_numlower":
+	case "load_module.loaded_from_memory":
 
-		return int(e.Rmdir.OverlayNumLower), nil
+		return reflect.Bool, nil
 
-	case "rmdir.retval":
+	case "load_module.overlay_numlower":
 
-		return int(e.Rmdir.Retval), nil
+		return reflect.Int, nil
 
-	case "unlink.basename":
+	case "load_module.retval":
 
-		return e.Unlink.ResolveBasename(e.resolvers), nil
+		return reflect.Int, nil
 
-	case "unlink.container_path":
+	case "memfd.flags":
 
-		return e.Unlink.ResolveContainerPath(e.resolvers), nil
+		return reflect.Int, nil
 
-	case "unlink.filename":
+	case "memfd.name":
 
-		return e.Unlink.ResolveInode(e.resolvers), nil
+		return reflect.String, nil
 
-	case "unlink.flags":
+	case "memfd.retval":
 
-		return int(e.Unlink.Flags), nil
+		return reflect.Int, nil
 
-	case "unlink.inode":
+	case "mkdir.basename":
 
-		return int(e.Unlink.Inode), nil
+		return reflect.String, nil
 
-	case "unlink.overlay_numlower":
+	case "mkdir.container_path":
 
-		return int(e.Unlink.OverlayNumLower), nil
+		return reflect.String, nil
 
-	case "unlink.retval":
+	case "mkdir.filename":
 
-		return int(e.Unlink.Retval), nil
+		return reflect.String, nil
 
-	case "utimes.basename":
+	case "mkdir.inode":
 
-		return e.Utimes.ResolveBasename(e.resolvers), nil
+		return reflect.Int, nil
 
-	case "utimes.container_path":
+	case "mkdir.mode":
 
-		return e.Utimes.ResolveContainerPath(e.resolvers), nil
+		return reflect.Int, nil
 
-	case "utimes.filename":
+	case "mkdir.overlay_numlower":
 
-		return e.Utimes.ResolveInode(e.resolvers), nil
+		return reflect.Int, nil
 
-	case "utimes.inode":
+	case "mkdir.retval":
 
-		return int(e.Utimes.Inode), nil
+		return reflect.Int, nil
 
-	case "utimes.overlay_numlower":
+	case "mount.flags":
 
-		return int(e.Utimes.OverlayNumLower), nil
+		return reflect.Int, nil
 
-	case "utimes.retval":
+	case "mount.fs_type":
 
-		return int(e.Utimes.Retval), nil
+		return reflect.String, nil
 
-	}
+	case "mount.mountpoint":
 
-	return nil, &eval.ErrFieldNotFound{Field: field}
-}
+		return reflect.String, nil
 
-func (e *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
-	switch field {
+	case "mount.retval":
 
-	case "chmod.basename":
-		return "chmod", nil
+		return reflect.Int, nil
 
-	case "chmod.container_path":
-		return "chmod", nil
+	case "open.basename":
 
-	case "chmod.filename":
-		return "chmod", nil
+		return reflect.String, nil
 
-	case "chmod.inode":
-		return "chmod", nil
+	case "open.container_path":
 
-	case "chmod.mode":
-		return "chmod", nil
+		return reflect.String, nil
 
-	case "chmod.overlay_numlower":
-		return "chmod", nil
+	case "open.filename":
 
-	case "chmod.retval":
-		return "chmod", nil
+		return reflect.String, nil
 
-	case "chown.basename":
-		return "chown", nil
+	case "open.flags":
 
-	case "chown.container_path":
-		return "chown", nil
+		return reflect.Int, nil
 
-	case "chown.filename":
-		return "chown", nil
+	case "open.inode":
 
-	case "chown.gid":
-		return "chown", nil
+		return reflect.Int, nil
 
-	case "chown.inode":
-		return "chown", nil
+	case "open.mode":
 
-	case "chown.overlay_numlower":
-		return "chown", nil
+		return reflect.Int, nil
 
-	case "chown.retval":
-		return "chown", nil
+	case "open.overlay_numlower":
 
-	case "chown.uid":
-		return "chown", nil
+		return reflect.Int, nil
 
-	case "container.id":
-		return "*", nil
+	case "open.retval":
 
-	case "link.retval":
-		return "link", nil
+		return reflect.Int, nil
 
-	case "link.source.basename":
-		return "link", nil
+	case "pipe.flags":
 
-	case "link.source.container_path":
-		return "link", nil
+		return reflect.Int, nil
 
-	case "link.source.filename":
-		return "link", nil
+	case "pipe.read_fd":
 
-	case "link.source.inode":
-		return "link", nil
+		return reflect.Int, nil
 
-	case "link.source.overlay_numlower":
-		return "link", nil
+	case "pipe.retval":
 
-	case "link.target.basename":
-		return "link", nil
+		return reflect.Int, nil
 
-	case "link.target.container_path":
-		return "link", nil
+	case "pipe.write_fd":
 
-	case "link.target.filename":
-		return "link", nil
+		return reflect.Int, nil
 
-	case "link.target.inode":
-		return "link", nil
+	case "prctl.arg2":
 
-	case "link.target.overlay_numlower":
-		return "link", nil
+		return reflect.Int, nil
 
-	case "mkdir.basename":
-		return "mkdir", nil
+	case "prctl.arg3":
 
-	case "mkdir.container_path":
-		return "mkdir", nil
+		return reflect.Int, nil
 
-	case "mkdir.filename":
-		return "mkdir", nil
+	case "prctl.arg4":
 
-	case "mkdir.inode":
-		return "mkdir", nil
+		return reflect.Int, nil
 
-	case "mkdir.mode":
-		return "mkdir", nil
+	case "prctl.arg5":
 
-	case "mkdir.overlay_numlower":
-		return "mkdir", nil
+		return reflect.Int, nil
 
-	case "mkdir.retval":
-		return "mkdir", nil
+	case "prctl.name":
 
-	case "open.basename":
-		return "open", nil
+		return reflect.String, nil
 
-	case "open.container_path":
-		return "open", nil
+	case "prctl.option":
 
-	case "open.filename":
-		return "open", nil
+		return reflect.Int, nil
 
-	case "open.flags":
-		return "open", nil
+	case "prctl.retval":
 
-	case "open.inode":
-		return "open", nil
+		return reflect.Int, nil
 
-	case "open.mode":
-		return "open", nil
+	case "process.args":
 
-	case "open.overlay_numlower":
-		return "open", nil
+		return reflect.String, nil
 
-	case "open.retval":
-		return "open", nil
+	case "process.args_truncated":
+
+		return reflect.Bool, nil
 
 	case "process.basename":
-		return "*", nil
+
+		return reflect.String, nil
 
 	case "process.container_path":
-		return "*", nil
+
+		return reflect.String, nil
+
+	case "process.cwd":
+
+		return reflect.String, nil
 
 	case "process.filename":
-		return "*", nil
+
+		return reflect.String, nil
 
 	case "process.gid":
-		return "*", nil
+
+		return reflect.Int, nil
 
 	case "process.group":
-		return "*", nil
+
+		return reflect.String, nil
 
 	case "process.inode":
-		return "*", nil
 
-	case "process.name":
-		return "*", nil
+		return reflect.Int, nil
 
-	case "process.overlay_numlower":
-		return "*", nil
+	case "process.is_memfd":
 
-	case "process.pid":
-		return "*", nil
+		return reflect.Bool, nil
 
-	case "process.pidns":
-		return "*", nil
+	case "process.name":
 
-	case "process.tid":
-		return "*", nil
+		return reflect.String, nil
 
-	case "process.tty_name":
-		return "*", nil
+	case "process.overlay_numlower":
 
-	case "process.uid":
-		return "*", nil
+		return reflect.Int, nil
 
-	case "process.user":
-		return "*", nil
+	case "process.pid":
 
-	case "rename.new.basename":
-		return "rename", nil
+		return reflect.Int, nil
 
-	case "rename.new.container_path":
-		return "rename", nil
+	case "process.pidns":
 
-	case "rename.new.filename":
-		return "rename", nil
+		return reflect.Int, nil
 
-	case "rename.new.inode":
-		return "rename", nil
+	case "process.tid":
 
-	case "rename.new.overlay_numlower":
-		return "rename", nil
+		return reflect.Int, nil
 
-	case "rename.old.basename":
-		return "rename", nil
+	case "process.tty_name":
 
-	case "rename.old.container_path":
-		return "rename", nil
+		return reflect.String, nil
 
-	case "rename.old.filename":
-		return "rename", nil
+	case "process.uid":
 
-	case "rename.old.inode":
-		return "rename", nil
+		return reflect.Int, nil
 
-	case "rename.old.overlay_numlower":
-		return "rename", nil
+	case "process.umask":
 
-	case "rename.retval":
-		return "rename", nil
+		return reflect.Int, nil
 
-	case "rmdir.basename":
-		return "rmdir", nil
+	case "process.user":
 
-	case "rmdir.container_path":
-		return "rmdir", nil
+		return reflect.String, nil
 
-	case "rmdir.filename":
-		return "rmdir", nil
+	case "quotactl.cmd":
 
-	case "rmdir.inode":
-		return "rmdir", nil
+		return reflect.Int, nil
 
-	case "rmdir.overlay_numlower":
-		return "rmdir", nil
+	case "quotactl.id":
 
-	case "rmdir.retval":
-		return "rmdir", nil
+		return reflect.Int, nil
 
-	case "unlink.basename":
-		return "unlink", nil
+	case "quotactl.retval":
 
-	case "unlink.container_path":
-		return "unlink", nil
+		return reflect.Int, nil
 
-	case "unlink.filename":
-		return "unlink", nil
+	case "quotactl.special":
 
-	case "unlink.flags":
-		return "unlink", nil
+		return reflect.String, nil
 
-	case "unlink.inode":
-		return "unlink", nil
+	case "quotactl.type":
 
-	case "unlink.overlay_numlower":
-		return "unlink", nil
+		return reflect.Int, nil
 
-	case "unlink.retval":
-		return "unlink", nil
+	case "rename.new.basename":
 
-	case "utimes.basename":
-		return "utimes", nil
+		return reflect.String, nil
 
-	case "utimes.container_path":
-		return "utimes", nil
+	case "rename.new.container_path":
 
-	case "utimes.filename":
-		return "utimes", nil
+		return reflect.String, nil
 
-	case "utimes.inode":
-		return "utimes", nil
+	case "rename.new.filename":
 
-	case "utimes.overlay_numlower":
-		return "utimes", nil
+		return reflect.String, nil
 
-	case "utimes.retval":
-		return "utimes", nil
+	case "rename.new.inode":
 
-	}
+		return reflect.Int, nil
 
-	return "", &eval.ErrFieldNotFound{Field: field}
-}
+	case "rename.new.overlay_numlower":
 
-func (e *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
-	switch field {
+		return reflect.Int, nil
 
-	case "chmod.basename":
+	case "rename.old.basename":
 
 		return reflect.String, nil
 
-	case "chmod.container_path":
+	case "rename.old.container_path":
 
 		return reflect.String, nil
 
-	case "chmod.filename":
+	case "rename.old.filename":
 
 		return reflect.String, nil
 
-	case "chmod.inode":
-
-		return reflect.Int, nil
-
-	case "chmod.mode":
+	case "rename.old.inode":
 
 		return reflect.Int, nil
 
-	case "chmod.overlay_numlower":
+	case "rename.old.overlay_numlower":
 
 		return reflect.Int, nil
 
-	case "chmod.retval":
+	case "rename.retval":
 
 		return reflect.Int, nil
 
-	case "chown.basename":
+	case "rmdir.basename":
 
 		return reflect.String, nil
 
-	case "chown.container_path":
+	case "rmdir.container_path":
 
 		return reflect.String, nil
 
-	case "chown.filename":
+	case "rmdir.filename":
 
 		return reflect.String, nil
 
-	case "chown.gid":
+	case "rmdir.inode":
 
 		return reflect.Int, nil
 
-	case "chown.inode":
+	case "rmdir.overlay_numlower":
 
 		return reflect.Int, nil
 
-	case "chown.overlay_numlower":
+	case "rmdir.retval":
 
 		return reflect.Int, nil
 
-	case "chown.retval":
+	case "sendfile.count":
 
 		return reflect.Int, nil
 
-	case "chown.uid":
+	case "sendfile.in_fd":
 
 		return reflect.Int, nil
 
-	case "container.id":
-
-		return reflect.String, nil
-
-	case "link.retval":
+	case "sendfile.out_fd":
 
 		return reflect.Int, nil
 
-	case "link.source.basename":
-
-		return reflect.String, nil
-
-	case "link.source.container_path":
+	case "sendfile.retval":
 
-		return reflect.String, nil
+		return reflect.Int, nil
 
-	case "link.source.filename":
+	case "setns.fd":
 
-		return reflect.String, nil
+		return reflect.Int, nil
 
-	case "link.source.inode":
+	case "setns.nstype":
 
 		return reflect.Int, nil
 
-	case "link.source.overlay_numlower":
+	case "setns.retval":
 
 		return reflect.Int, nil
 
-	case "link.target.basename":
+	case "setrlimit.hard":
 
-		return reflect.String, nil
+		return reflect.Int, nil
 
-	case "link.target.container_path":
+	case "setrlimit.pid":
 
-		return reflect.String, nil
+		return reflect.Int, nil
 
-	case "link.target.filename":
+	case "setrlimit.resource":
 
-		return reflect.String, nil
+		return reflect.Int, nil
 
-	case "link.target.inode":
+	case "setrlimit.retval":
 
 		return reflect.Int, nil
 
-	case "link.target.overlay_numlower":
+	case "setrlimit.soft":
 
 		return reflect.Int, nil
 
-	case "mkdir.basename":
+	case "stat.basename":
 
 		return reflect.String, nil
 
-	case "mkdir.container_path":
+	case "stat.container_path":
 
 		return reflect.String, nil
 
-	case "mkdir.filename":
+	case "stat.filename":
 
 		return reflect.String, nil
 
-	case "mkdir.inode":
+	case "stat.inode":
+
+		return reflect.Int, nil
+
+	case "stat.overlay_numlower":
 
 		return reflect.Int, nil
 
-	case "mkdir.mode":
+	case "stat.retval":
 
 		return reflect.Int, nil
 
-	case "mkdir.overlay_numlower":
+	case "umask.mask":
 
 		return reflect.Int, nil
 
-	case "mkdir.retval":
+	case "umask.retval":
 
 		return reflect.Int, nil
 
-	case "open.basename":
+	case "unlink.basename":
 
 		return reflect.String, nil
 
-	case "open.container_path":
+	case "unlink.container_path":
 
 		return reflect.String, nil
 
-	case "open.filename":
+	case "unlink.filename":
 
 		return reflect.String, nil
 
-	case "open.flags":
-
-		return reflect.Int, nil
-
-	case "open.inode":
+	case "unlink.flags":
 
 		return reflect.Int, nil
 
-	case "open.mode":
+	case "unlink.inode":
 
 		return reflect.Int, nil
 
-	case "open.overlay_numlower":
+	case "unlink.overlay_numlower":
 
 		return reflect.Int, nil
 
-	case "open.retval":
+	case "unlink.retval":
 
 		return reflect.Int, nil
 
-	case "process.basename":
+	case "utimes.basename":
 
 		return reflect.String, nil
 
-	case "process.container_path":
+	case "utimes.container_path":
 
 		return reflect.String, nil
 
-	case "process.filename":
+	case "utimes.filename":
 
 		return reflect.String, nil
 
-	case "process.gid":
+	case "utimes.inode":
 
 		return reflect.Int, nil
 
-	case "process.group":
+	case "utimes.overlay_numlower":
 
-		return reflect.String, nil
+		return reflect.Int, nil
 
-	case "process.inode":
+	case "utimes.retval":
 
 		return reflect.Int, nil
 
-	case "process.name":
+	case "write.basename":
 
 		return reflect.String, nil
 
-	case "process.overlay_numlower":
+	case "write.container_path":
 
-		return reflect.Int, nil
+		return reflect.String, nil
 
-	case "process.pid":
+	case "write.count":
 
 		return reflect.Int, nil
 
-	case "process.pidns":
+	case "write.filename":
 
-		return reflect.Int, nil
+		return reflect.String, nil
 
-	case "process.tid":
+	case "write.inode":
 
 		return reflect.Int, nil
 
-	case "process.tty_name":
+	case "write.overlay_numlower":
 
-		return reflect.String, nil
+		return reflect.Int, nil
 
-	case "process.uid":
+	case "write.retval":
 
 		return reflect.Int, nil
 
-	case "process.user":
+	}
 
-		return reflect.String, nil
+	return reflect.Invalid, &eval.ErrFieldNotFound{Field: field}
+}
 
-	case "rename.new.basename":
+func (e *Event) SetFieldValue(field eval.Field, value interface{}) error {
+	var ok bool
+	switch field {
 
-		return reflect.String, nil
+	case "bind.addr":
 
-	case "rename.new.container_path":
+		if e.Bind.Addr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Bind.Addr"}
+		}
+		return nil
 
-		return reflect.String, nil
+	case "bind.family":
 
-	case "rename.new.filename":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Bind.Family"}
+		}
+		e.Bind.Family = uint16(v)
+		return nil
 
-		return reflect.String, nil
+	case "bind.port":
 
-	case "rename.new.inode":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Bind.Port"}
+		}
+		e.Bind.Port = uint16(v)
+		return nil
 
-		return reflect.Int, nil
+	case "chdir.basename":
 
-	case "rename.new.overlay_numlower":
+		if e.Chdir.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chdir.BasenameStr"}
+		}
+		return nil
 
-		return reflect.Int, nil
+	case "chdir.container_path":
 
-	case "rename.old.basename":
+		if e.Chdir.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chdir.ContainerPath"}
+		}
+		return nil
 
-		return reflect.String, nil
+	case "chdir.filename":
 
-	case "rename.old.container_path":
+		if e.Chdir.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chdir.PathnameStr"}
+		}
+		return nil
 
-		return reflect.String, nil
+	case "chdir.inode":
 
-	case "rename.old.filename":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chdir.Inode"}
+		}
+		e.Chdir.Inode = uint64(v)
+		return nil
 
-		return reflect.String, nil
+	case "chdir.overlay_numlower":
 
-	case "rename.old.inode":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chdir.OverlayNumLower"}
+		}
+		e.Chdir.OverlayNumLower = int32(v)
+		return nil
 
-		return reflect.Int, nil
+	case "chdir.retval":
 
-	case "rename.old.overlay_numlower":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chdir.Retval"}
+		}
+		e.Chdir.Retval = int64(v)
+		return nil
 
-		return reflect.Int, nil
+	case "chmod.basename":
 
-	case "rename.retval":
+		if e.Chmod.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.BasenameStr"}
+		}
+		return nil
 
-		return reflect.Int, nil
+	case "chmod.container_path":
 
-	case "rmdir.basename":
+		if e.Chmod.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.ContainerPath"}
+		}
+		return nil
 
-		return reflect.String, nil
+	case "chmod.filename":
 
-	case "rmdir.container_path":
+		if e.Chmod.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.PathnameStr"}
+		}
+		return nil
 
-		return reflect.String, nil
+	case "chmod.inode":
 
-	case "rmdir.filename":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.Inode"}
+		}
+		e.Chmod.Inode = uint64(v)
+		return nil
 
-		return reflect.String, nil
+	case "chmod.mode":
 
-	case "rmdir.inode":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.Mode"}
+		}
+		e.Chmod.Mode = uint32(v)
+		return nil
 
-		return reflect.Int, nil
+	case "chmod.overlay_numlower":
 
-	case "rmdir.overlay_numlower":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.OverlayNumLower"}
+		}
+		e.Chmod.OverlayNumLower = int32(v)
+		return nil
 
-		return reflect.Int, nil
+	case "chmod.retval":
 
-	case "rmdir.retval":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.Retval"}
+		}
+		e.Chmod.Retval = int64(v)
+		return nil
 
-		return reflect.Int, nil
+	case "chown.basename":
 
-	case "unlink.basename":
+		if e.Chown.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.BasenameStr"}
+		}
+		return nil
 
-		return reflect.String, nil
+	case "chown.container_path":
 
-	case "unlink.container_path":
+		if e.Chown.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.ContainerPath"}
+		}
+		return nil
 
-		return reflect.String, nil
+	case "chown.filename":
 
-	case "unlink.filename":
+		if e.Chown.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.PathnameStr"}
+		}
+		return nil
 
-		return reflect.String, nil
+	case "chown.gid":
 
-	case "unlink.flags":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.GID"}
+		}
+		e.Chown.GID = int32(v)
+		return nil
 
-		return reflect.Int, nil
+	case "chown.inode":
 
-	case "unlink.inode":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.Inode"}
+		}
+		e.Chown.Inode = uint64(v)
+		return nil
 
-		return reflect.Int, nil
+	case "chown.overlay_numlower":
 
-	case "unlink.overlay_numlower":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.OverlayNumLower"}
+		}
+		e.Chown.OverlayNumLower = int32(v)
+		return nil
 
-		return reflect.Int, nil
+	case "chown.retval":
 
-	case "unlink.retval":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.Retval"}
+		}
+		e.Chown.Retval = int64(v)
+		return nil
 
-		return reflect.Int, nil
+	case "chown.uid":
 
-	case "utimes.basename":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.UID"}
+		}
+		e.Chown.UID = int32(v)
+		return nil
 
-		return reflect.String, nil
+	case "clone.flags":
 
-	case "utimes.container_path":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Clone.Flags"}
+		}
+		e.Clone.Flags = uint64(v)
+		return nil
 
-		return reflect.String, nil
+	case "clone.retval":
 
-	case "utimes.filename":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Clone.Retval"}
+		}
+		e.Clone.Retval = int64(v)
+		return nil
 
-		return reflect.String, nil
+	case "container.id":
 
-	case "utimes.inode":
+		if e.Container.ID, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Container.ID"}
+		}
+		return nil
 
-		return reflect.Int, nil
+	case "dup.new_fd":
 
-	case "utimes.overlay_numlower":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Dup.NewFd"}
+		}
+		e.Dup.NewFd = int32(v)
+		return nil
+
+	case "dup.old_fd":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Dup.OldFd"}
+		}
+		e.Dup.OldFd = int32(v)
+		return nil
 
-		return reflect.Int, nil
+	case "dup.retval":
 
-	case "utimes.retval":
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Dup.Retval"}
+		}
+		e.Dup.Retval = int64(v)
+		return nil
 
-		return reflect.Int, nil
+	case "fallocate.basename":
 
-	}
+		if e.Fallocate.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Fallocate.BasenameStr"}
+		}
+		return nil
 
-	return reflect.Invalid, &eval.ErrFieldNotFound{Field: field}
-}
+	case "fallocate.container_path":
 
-func (e *Event) SetFieldValue(field eval.Field, value interface{}) error {
-	var ok bool
-	switch field {
+		if e.Fallocate.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Fallocate.ContainerPath"}
+		}
+		return nil
 
-	case "chmod.basename":
+	case "fallocate.filename":
 
-		if e.Chmod.BasenameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.BasenameStr"}
+		if e.Fallocate.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Fallocate.PathnameStr"}
 		}
 		return nil
 
-	case "chmod.container_path":
+	case "fallocate.inode":
 
-		if e.Chmod.ContainerPath, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.ContainerPath"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Fallocate.Inode"}
 		}
+		e.Fallocate.Inode = uint64(v)
 		return nil
 
-	case "chmod.filename":
+	case "fallocate.length":
 
-		if e.Chmod.PathnameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.PathnameStr"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Fallocate.Length"}
 		}
+		e.Fallocate.Length = uint64(v)
 		return nil
 
-	case "chmod.inode":
+	case "fallocate.mode":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.Inode"}
+			return &eval.ErrValueTypeMismatch{Field: "Fallocate.Mode"}
 		}
-		e.Chmod.Inode = uint64(v)
+		e.Fallocate.Mode = uint32(v)
 		return nil
 
-	case "chmod.mode":
+	case "fallocate.overlay_numlower":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "Fallocate.OverlayNumLower"}
 		}
-		e.Chmod.Mode = uint32(v)
+		e.Fallocate.OverlayNumLower = int32(v)
 		return nil
 
-	case "chmod.overlay_numlower":
+	case "fallocate.retval":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.OverlayNumLower"}
+			return &eval.ErrValueTypeMismatch{Field: "Fallocate.Retval"}
 		}
-		e.Chmod.OverlayNumLower = int32(v)
+		e.Fallocate.Retval = int64(v)
 		return nil
 
-	case "chmod.retval":
+	case "getxattr.basename":
+
+		if e.GetXattr.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "GetXattr.BasenameStr"}
+		}
+		return nil
+
+	case "getxattr.container_path":
+
+		if e.GetXattr.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "GetXattr.ContainerPath"}
+		}
+		return nil
+
+	case "getxattr.filename":
+
+		if e.GetXattr.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "GetXattr.PathnameStr"}
+		}
+		return nil
+
+	case "getxattr.inode":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.Retval"}
+			return &eval.ErrValueTypeMismatch{Field: "GetXattr.Inode"}
 		}
-		e.Chmod.Retval = int64(v)
+		e.GetXattr.Inode = uint64(v)
 		return nil
 
-	case "chown.basename":
+	case "getxattr.name":
 
-		if e.Chown.BasenameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.BasenameStr"}
+		if e.GetXattr.Name, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "GetXattr.Name"}
 		}
 		return nil
 
-	case "chown.container_path":
+	case "getxattr.overlay_numlower":
 
-		if e.Chown.ContainerPath, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.ContainerPath"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "GetXattr.OverlayNumLower"}
 		}
+		e.GetXattr.OverlayNumLower = int32(v)
 		return nil
 
-	case "chown.filename":
+	case "getxattr.retval":
 
-		if e.Chown.PathnameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.PathnameStr"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "GetXattr.Retval"}
 		}
+		e.GetXattr.Retval = int64(v)
 		return nil
 
-	case "chown.gid":
+	case "ioctl.fd":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.GID"}
+			return &eval.ErrValueTypeMismatch{Field: "Ioctl.Fd"}
 		}
-		e.Chown.GID = int32(v)
+		e.Ioctl.Fd = int32(v)
 		return nil
 
-	case "chown.inode":
+	case "ioctl.request":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.Inode"}
+			return &eval.ErrValueTypeMismatch{Field: "Ioctl.Request"}
 		}
-		e.Chown.Inode = uint64(v)
+		e.Ioctl.Request = uint32(v)
 		return nil
 
-	case "chown.overlay_numlower":
+	case "ioctl.retval":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.OverlayNumLower"}
+			return &eval.ErrValueTypeMismatch{Field: "Ioctl.Retval"}
 		}
-		e.Chown.OverlayNumLower = int32(v)
+		e.Ioctl.Retval = int64(v)
 		return nil
 
-	case "chown.retval":
+	case "keyctl.description":
+
+		if e.Keyctl.Description, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Keyctl.Description"}
+		}
+		return nil
+
+	case "keyctl.key_id":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.Retval"}
+			return &eval.ErrValueTypeMismatch{Field: "Keyctl.KeyID"}
 		}
-		e.Chown.Retval = int64(v)
+		e.Keyctl.KeyID = int32(v)
 		return nil
 
-	case "chown.uid":
+	case "keyctl.key_type":
+
+		if e.Keyctl.KeyType, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Keyctl.KeyType"}
+		}
+		return nil
+
+	case "keyctl.operation":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.UID"}
+			return &eval.ErrValueTypeMismatch{Field: "Keyctl.Operation"}
 		}
-		e.Chown.UID = int32(v)
+		e.Keyctl.Operation = int32(v)
 		return nil
 
-	case "container.id":
+	case "keyctl.retval":
 
-		if e.Container.ID, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Container.ID"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Keyctl.Retval"}
 		}
+		e.Keyctl.Retval = int64(v)
 		return nil
 
 	case "link.retval":
@@ -1979,6 +4233,86 @@ func (e *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		e.Link.Target.OverlayNumLower = int32(v)
 		return nil
 
+	case "load_module.basename":
+
+		if e.LoadModule.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "LoadModule.BasenameStr"}
+		}
+		return nil
+
+	case "load_module.container_path":
+
+		if e.LoadModule.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "LoadModule.ContainerPath"}
+		}
+		return nil
+
+	case "load_module.filename":
+
+		if e.LoadModule.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "LoadModule.PathnameStr"}
+		}
+		return nil
+
+	case "load_module.inode":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "LoadModule.Inode"}
+		}
+		e.LoadModule.Inode = uint64(v)
+		return nil
+
+	case "load_module.loaded_from_memory":
+
+		if e.LoadModule.LoadedFromMemory, ok = value.(bool); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "LoadModule.LoadedFromMemory"}
+		}
+		return nil
+
+	case "load_module.overlay_numlower":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "LoadModule.OverlayNumLower"}
+		}
+		e.LoadModule.OverlayNumLower = int32(v)
+		return nil
+
+	case "load_module.retval":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "LoadModule.Retval"}
+		}
+		e.LoadModule.Retval = int64(v)
+		return nil
+
+	case "memfd.flags":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Memfd.Flags"}
+		}
+		e.Memfd.Flags = uint32(v)
+		return nil
+
+	case "memfd.name":
+
+		if e.Memfd.Name, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Memfd.Name"}
+		}
+		return nil
+
+	case "memfd.retval":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Memfd.Retval"}
+		}
+		e.Memfd.Retval = int64(v)
+		return nil
+
 	case "mkdir.basename":
 
 		if e.Mkdir.BasenameStr, ok = value.(string); !ok {
@@ -1993,113 +4327,256 @@ func (e *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		return nil
 
-	case "mkdir.filename":
+	case "mkdir.filename":
+
+		if e.Mkdir.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.PathnameStr"}
+		}
+		return nil
+
+	case "mkdir.inode":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.Inode"}
+		}
+		e.Mkdir.Inode = uint64(v)
+		return nil
+
+	case "mkdir.mode":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.Mode"}
+		}
+		e.Mkdir.Mode = int32(v)
+		return nil
+
+	case "mkdir.overlay_numlower":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.OverlayNumLower"}
+		}
+		e.Mkdir.OverlayNumLower = int32(v)
+		return nil
+
+	case "mkdir.retval":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.Retval"}
+		}
+		e.Mkdir.Retval = int64(v)
+		return nil
+
+	case "mount.flags":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Mount.Flags"}
+		}
+		e.Mount.Flags = uint64(v)
+		return nil
+
+	case "mount.fs_type":
+
+		if e.Mount.FSType, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Mount.FSType"}
+		}
+		return nil
+
+	case "mount.mountpoint":
+
+		if e.Mount.MountPointStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Mount.MountPointStr"}
+		}
+		return nil
+
+	case "mount.retval":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Mount.Retval"}
+		}
+		e.Mount.Retval = int64(v)
+		return nil
+
+	case "open.basename":
+
+		if e.Open.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Open.BasenameStr"}
+		}
+		return nil
+
+	case "open.container_path":
+
+		if e.Open.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Open.ContainerPath"}
+		}
+		return nil
+
+	case "open.filename":
+
+		if e.Open.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Open.PathnameStr"}
+		}
+		return nil
+
+	case "open.flags":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Open.Flags"}
+		}
+		e.Open.Flags = uint32(v)
+		return nil
+
+	case "open.inode":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Open.Inode"}
+		}
+		e.Open.Inode = uint64(v)
+		return nil
+
+	case "open.mode":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Open.Mode"}
+		}
+		e.Open.Mode = uint32(v)
+		return nil
+
+	case "open.overlay_numlower":
 
-		if e.Mkdir.PathnameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.PathnameStr"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Open.OverlayNumLower"}
 		}
+		e.Open.OverlayNumLower = int32(v)
 		return nil
 
-	case "mkdir.inode":
+	case "open.retval":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.Inode"}
+			return &eval.ErrValueTypeMismatch{Field: "Open.Retval"}
 		}
-		e.Mkdir.Inode = uint64(v)
+		e.Open.Retval = int64(v)
 		return nil
 
-	case "mkdir.mode":
+	case "pipe.flags":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "Pipe.Flags"}
 		}
-		e.Mkdir.Mode = int32(v)
+		e.Pipe.Flags = uint32(v)
 		return nil
 
-	case "mkdir.overlay_numlower":
+	case "pipe.read_fd":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.OverlayNumLower"}
+			return &eval.ErrValueTypeMismatch{Field: "Pipe.ReadFd"}
 		}
-		e.Mkdir.OverlayNumLower = int32(v)
+		e.Pipe.ReadFd = int32(v)
 		return nil
 
-	case "mkdir.retval":
+	case "pipe.retval":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.Retval"}
+			return &eval.ErrValueTypeMismatch{Field: "Pipe.Retval"}
 		}
-		e.Mkdir.Retval = int64(v)
+		e.Pipe.Retval = int64(v)
 		return nil
 
-	case "open.basename":
+	case "pipe.write_fd":
 
-		if e.Open.BasenameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.BasenameStr"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Pipe.WriteFd"}
 		}
+		e.Pipe.WriteFd = int32(v)
 		return nil
 
-	case "open.container_path":
+	case "prctl.arg2":
 
-		if e.Open.ContainerPath, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.ContainerPath"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Prctl.Arg2"}
 		}
+		e.Prctl.Arg2 = uint64(v)
 		return nil
 
-	case "open.filename":
+	case "prctl.arg3":
 
-		if e.Open.PathnameStr, ok = value.(string); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.PathnameStr"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Prctl.Arg3"}
 		}
+		e.Prctl.Arg3 = uint64(v)
 		return nil
 
-	case "open.flags":
+	case "prctl.arg4":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.Flags"}
+			return &eval.ErrValueTypeMismatch{Field: "Prctl.Arg4"}
 		}
-		e.Open.Flags = uint32(v)
+		e.Prctl.Arg4 = uint64(v)
 		return nil
 
-	case "open.inode":
+	case "prctl.arg5":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.Inode"}
+			return &eval.ErrValueTypeMismatch{Field: "Prctl.Arg5"}
 		}
-		e.Open.Inode = uint64(v)
+		e.Prctl.Arg5 = uint64(v)
 		return nil
 
-	case "open.mode":
+	case "prctl.name":
 
-		v, ok := value.(int)
-		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.Mode"}
+		if e.Prctl.Name, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Prctl.Name"}
 		}
-		e.Open.Mode = uint32(v)
 		return nil
 
-	case "open.overlay_numlower":
+	case "prctl.option":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.OverlayNumLower"}
+			return &eval.ErrValueTypeMismatch{Field: "Prctl.Option"}
 		}
-		e.Open.OverlayNumLower = int32(v)
+		e.Prctl.Option = int32(v)
 		return nil
 
-	case "open.retval":
+	case "prctl.retval":
 
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.Retval"}
+			return &eval.ErrValueTypeMismatch{Field: "Prctl.Retval"}
+		}
+		e.Prctl.Retval = int64(v)
+		return nil
+
+	case "process.args":
+
+		if e.Process.Args, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Process.Args"}
+		}
+		return nil
+
+	case "process.args_truncated":
+
+		if e.Process.ArgsTruncated, ok = value.(bool); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Process.ArgsTruncated"}
 		}
-		e.Open.Retval = int64(v)
 		return nil
 
 	case "process.basename":
@@ -2116,6 +4593,13 @@ func (e *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		return nil
 
+	case "process.cwd":
+
+		if e.Process.Cwd, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Process.Cwd"}
+		}
+		return nil
+
 	case "process.filename":
 
 		if e.Process.PathnameStr, ok = value.(string); !ok {
@@ -2148,6 +4632,13 @@ func (e *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		e.Process.Inode = uint64(v)
 		return nil
 
+	case "process.is_memfd":
+
+		if e.Process.IsMemfd, ok = value.(bool); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Process.IsMemfd"}
+		}
+		return nil
+
 	case "process.name":
 
 		if e.Process.Comm, ok = value.(string); !ok {
@@ -2207,6 +4698,15 @@ func (e *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		e.Process.UID = uint32(v)
 		return nil
 
+	case "process.umask":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Process.Umask"}
+		}
+		e.Process.Umask = int(v)
+		return nil
+
 	case "process.user":
 
 		if e.Process.User, ok = value.(string); !ok {
@@ -2214,6 +4714,49 @@ func (e *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		return nil
 
+	case "quotactl.cmd":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Quotactl.Cmd"}
+		}
+		e.Quotactl.Cmd = uint32(v)
+		return nil
+
+	case "quotactl.id":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Quotactl.ID"}
+		}
+		e.Quotactl.ID = uint32(v)
+		return nil
+
+	case "quotactl.retval":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Quotactl.Retval"}
+		}
+		e.Quotactl.Retval = int64(v)
+		return nil
+
+	case "quotactl.special":
+
+		if e.Quotactl.Special, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Quotactl.Special"}
+		}
+		return nil
+
+	case "quotactl.type":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Quotactl.QuotaType"}
+		}
+		e.Quotactl.QuotaType = uint32(v)
+		return nil
+
 	case "rename.new.basename":
 
 		if e.Rename.New.BasenameStr, ok = value.(string); !ok {
@@ -2349,6 +4892,180 @@ func (e *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		e.Rmdir.Retval = int64(v)
 		return nil
 
+	case "sendfile.count":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Sendfile.Count"}
+		}
+		e.Sendfile.Count = uint64(v)
+		return nil
+
+	case "sendfile.in_fd":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Sendfile.InFd"}
+		}
+		e.Sendfile.InFd = int32(v)
+		return nil
+
+	case "sendfile.out_fd":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Sendfile.OutFd"}
+		}
+		e.Sendfile.OutFd = int32(v)
+		return nil
+
+	case "sendfile.retval":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Sendfile.Retval"}
+		}
+		e.Sendfile.Retval = int64(v)
+		return nil
+
+	case "setns.fd":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Setns.Fd"}
+		}
+		e.Setns.Fd = int32(v)
+		return nil
+
+	case "setns.nstype":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Setns.NSType"}
+		}
+		e.Setns.NSType = uint32(v)
+		return nil
+
+	case "setns.retval":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Setns.Retval"}
+		}
+		e.Setns.Retval = int64(v)
+		return nil
+
+	case "setrlimit.hard":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Setrlimit.Hard"}
+		}
+		e.Setrlimit.Hard = int64(v)
+		return nil
+
+	case "setrlimit.pid":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Setrlimit.Pid"}
+		}
+		e.Setrlimit.Pid = uint32(v)
+		return nil
+
+	case "setrlimit.resource":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Setrlimit.Resource"}
+		}
+		e.Setrlimit.Resource = uint32(v)
+		return nil
+
+	case "setrlimit.retval":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Setrlimit.Retval"}
+		}
+		e.Setrlimit.Retval = int64(v)
+		return nil
+
+	case "setrlimit.soft":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Setrlimit.Soft"}
+		}
+		e.Setrlimit.Soft = int64(v)
+		return nil
+
+	case "stat.basename":
+
+		if e.Stat.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Stat.BasenameStr"}
+		}
+		return nil
+
+	case "stat.container_path":
+
+		if e.Stat.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Stat.ContainerPath"}
+		}
+		return nil
+
+	case "stat.filename":
+
+		if e.Stat.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Stat.PathnameStr"}
+		}
+		return nil
+
+	case "stat.inode":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Stat.Inode"}
+		}
+		e.Stat.Inode = uint64(v)
+		return nil
+
+	case "stat.overlay_numlower":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Stat.OverlayNumLower"}
+		}
+		e.Stat.OverlayNumLower = int32(v)
+		return nil
+
+	case "stat.retval":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Stat.Retval"}
+		}
+		e.Stat.Retval = int64(v)
+		return nil
+
+	case "umask.mask":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Umask.Mask"}
+		}
+		e.Umask.Mask = uint32(v)
+		return nil
+
+	case "umask.retval":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Umask.Retval"}
+		}
+		e.Umask.Retval = int64(v)
+		return nil
+
 	case "unlink.basename":
 
 		if e.Unlink.BasenameStr, ok = value.(string); !ok {
@@ -2454,6 +5171,63 @@ func (e *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		e.Utimes.Retval = int64(v)
 		return nil
 
+	case "write.basename":
+
+		if e.Write.BasenameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Write.BasenameStr"}
+		}
+		return nil
+
+	case "write.container_path":
+
+		if e.Write.ContainerPath, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Write.ContainerPath"}
+		}
+		return nil
+
+	case "write.count":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Write.Count"}
+		}
+		e.Write.Count = uint64(v)
+		return nil
+
+	case "write.filename":
+
+		if e.Write.PathnameStr, ok = value.(string); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Write.PathnameStr"}
+		}
+		return nil
+
+	case "write.inode":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Write.Inode"}
+		}
+		e.Write.Inode = uint64(v)
+		return nil
+
+	case "write.overlay_numlower":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Write.OverlayNumLower"}
+		}
+		e.Write.OverlayNumLower = int32(v)
+		return nil
+
+	case "write.retval":
+
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Write.Retval"}
+		}
+		e.Write.Retval = int64(v)
+		return nil
+
 	}
 
 	return &eval.ErrFieldNotFound{Field: field}
@@ -10,7 +10,12 @@ package probe
 import (
 	"bytes"
 	"encoding/json"
+	"strings"
+	"syscall"
 	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/security/config"
+	"golang.org/x/sys/unix"
 )
 
 func TestMkdirJSON(t *testing.T) {
@@ -18,7 +23,16 @@ func TestMkdirJSON(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	e := NewEvent(&Resolvers{TimeResolver: tr})
+	ar := NewArgsResolver(&config.Config{ExecArgsEnabled: true, ExecArgsMaxCount: 32, ExecArgsMaxLength: 1024})
+	pr, err := NewProcessResolver(ar)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ur, err := NewUmaskResolver()
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := NewEvent(&Resolvers{TimeResolver: tr, ProcessResolver: pr, ArgsResolver: ar, UmaskResolver: ur})
 	e.Process = ProcessEvent{
 		Pidns:   333,
 		Comm:    "aaa",
@@ -50,3 +64,84 @@ func TestMkdirJSON(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestOpenFlagsJSON(t *testing.T) {
+	resolvers := &Resolvers{MountResolver: NewMountResolver()}
+
+	for _, flags := range []int{
+		syscall.O_RDONLY,
+		syscall.O_WRONLY | syscall.O_CREAT | syscall.O_TRUNC,
+		syscall.O_RDWR | syscall.O_CREAT | syscall.O_EXCL | syscall.O_CLOEXEC,
+		syscall.O_WRONLY | syscall.O_APPEND | syscall.O_SYNC,
+	} {
+		e := OpenEvent{
+			FileEvent: FileEvent{
+				PathnameStr:   "/etc/passwd",
+				ContainerPath: "/",
+			},
+			Flags: uint32(flags),
+		}
+
+		data, err := e.marshalJSON(resolvers)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var file struct {
+			Flags string `json:"flags"`
+		}
+		if err := json.Unmarshal(data, &file); err != nil {
+			t.Fatal(err)
+		}
+
+		decoded := 0
+		for _, flag := range strings.Split(file.Flags, " | ") {
+			value, found := openFlagsConstants[flag]
+			if !found {
+				t.Fatalf("unknown flag %s in %s", flag, file.Flags)
+			}
+			decoded |= value
+		}
+
+		if decoded != flags {
+			t.Errorf("expected flags %d, got %d (%s)", flags, decoded, file.Flags)
+		}
+	}
+}
+
+func TestUmountFlagsDecode(t *testing.T) {
+	data := make([]byte, 8)
+	byteOrder.PutUint32(data[0:4], 27)
+	byteOrder.PutUint32(data[4:8], uint32(unix.MNT_FORCE|unix.MNT_DETACH))
+
+	var e UmountEvent
+	read, err := e.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read != 8 {
+		t.Errorf("expected 8 bytes read, got %d", read)
+	}
+	if e.MountID != 27 {
+		t.Errorf("expected mount id 27, got %d", e.MountID)
+	}
+
+	if str := UmountFlags(e.Flags).String(); str != "MNT_DETACH | MNT_FORCE" {
+		t.Errorf("expected flags not found, got: %s", str)
+	}
+
+	data, err = e.marshalJSON(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var umount struct {
+		Flags string `json:"flags"`
+	}
+	if err := json.Unmarshal(data, &umount); err != nil {
+		t.Fatal(err)
+	}
+	if umount.Flags != "MNT_DETACH | MNT_FORCE" {
+		t.Errorf("expected flags not found, got: %s", umount.Flags)
+	}
+}
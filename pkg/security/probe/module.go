@@ -0,0 +1,42 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import (
+	"github.com/DataDog/datadog-agent/pkg/security/ebpf"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/eval"
+)
+
+// moduleHookPoints holds the list of the kProbes of the module loading syscalls. The file finit_module
+// reads the module from is resolved by security_kernel_read_file, which appeared in kernel 4.6.
+var moduleHookPoints = []*HookPoint{
+	{
+		Name:    "sys_init_module",
+		KProbes: syscallKprobe("init_module"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"load_module": {},
+		},
+	},
+	{
+		Name:    "sys_finit_module",
+		KProbes: syscallKprobe("finit_module"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"load_module": {},
+		},
+	},
+	{
+		Name: "security_kernel_read_file",
+		KProbes: []*ebpf.KProbe{{
+			EntryFunc: "kprobe/security_kernel_read_file",
+		}},
+		EventTypes: map[eval.EventType]Capabilities{
+			"load_module": {},
+		},
+		Optional: true,
+	},
+}
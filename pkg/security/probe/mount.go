@@ -53,6 +53,16 @@ var mountHookPoints = []*HookPoint{
 			"*": {},
 		},
 	},
+	{
+		// the mount point being remounted is only known once the path given to mount is resolved
+		Name: "security_sb_mount",
+		KProbes: []*ebpf.KProbe{{
+			EntryFunc: "kprobe/security_sb_mount",
+		}},
+		EventTypes: map[string]Capabilities{
+			"*": {},
+		},
+	},
 	{
 		Name: "security_sb_umount",
 		KProbes: []*ebpf.KProbe{{
@@ -71,6 +81,17 @@ var mountHookPoints = []*HookPoint{
 			"*": {},
 		},
 	},
+	{
+		// umount2 is implemented by the kernel umount syscall function, the entry of this function is
+		// traced to capture the flags, the event is still sent by the sys_umount kretprobe
+		Name: "sys_umount2",
+		KProbes: []*ebpf.KProbe{{
+			EntryFunc: "kprobe/" + getSyscallFnName("umount"),
+		}},
+		EventTypes: map[string]Capabilities{
+			"*": {},
+		},
+	},
 }
 
 // newMountEventFromMountInfo - Creates a new MountEvent from parsed MountInfo data
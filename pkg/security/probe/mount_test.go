@@ -40,12 +40,14 @@ func TestMountResolver(t *testing.T) {
 				[]event{
 					{
 						mount: &MountEvent{
+							BaseEvent{},
 							127,
 							71,
 							52,
 							27,
 							0,
 							"overlay",
+							0,
 							"/var/lib/docker/overlay2/f44b5a1fe134f57a31da79fa2e76ea09f8659a34edfa0fa2c3b4f52adbd91963/merged",
 							0,
 							0,
@@ -92,7 +94,7 @@ func TestMountResolver(t *testing.T) {
 				[]event{
 					{
 						umount: &UmountEvent{
-							127,
+							MountID: 127,
 						},
 					},
 				},
@@ -113,12 +115,14 @@ func TestMountResolver(t *testing.T) {
 				[]event{
 					{
 						mount: &MountEvent{
+							BaseEvent{},
 							27,
 							0,
 							1,
 							1,
 							0,
 							"ext4",
+							0,
 							"/",
 							0,
 							0,
@@ -128,12 +132,14 @@ func TestMountResolver(t *testing.T) {
 					},
 					{
 						mount: &MountEvent{
+							BaseEvent{},
 							22,
 							0,
 							21,
 							27,
 							0,
 							"sysfs",
+							0,
 							"/sys",
 							0,
 							0,
@@ -143,12 +149,14 @@ func TestMountResolver(t *testing.T) {
 					},
 					{
 						mount: &MountEvent{
+							BaseEvent{},
 							31,
 							0,
 							26,
 							22,
 							0,
 							"tmpfs",
+							0,
 							"/fs/cgroup",
 							0,
 							0,
@@ -188,7 +196,7 @@ func TestMountResolver(t *testing.T) {
 				[]event{
 					{
 						umount: &UmountEvent{
-							27,
+							MountID: 27,
 						},
 					},
 				},
@@ -223,12 +231,14 @@ func TestMountResolver(t *testing.T) {
 				[]event{
 					{
 						mount: &MountEvent{
+							BaseEvent{},
 							27,
 							0,
 							1,
 							1,
 							0,
 							"ext4",
+							0,
 							"/",
 							0,
 							0,
@@ -238,12 +248,14 @@ func TestMountResolver(t *testing.T) {
 					},
 					{
 						mount: &MountEvent{
+							BaseEvent{},
 							176,
 							71,
 							52,
 							27,
 							0,
 							"overlay",
+							0,
 							"/var/lib/docker/overlay2/f44b5a1fe134f57a31da79fa2e76ea09f8659a34edfa0fa2c3b4f52adbd91963/merged",
 							0,
 							0,
@@ -253,12 +265,14 @@ func TestMountResolver(t *testing.T) {
 					},
 					{
 						mount: &MountEvent{
+							BaseEvent{},
 							638,
 							0,
 							52,
 							635,
 							0,
 							"bind",
+							0,
 							"/",
 							0,
 							0,
@@ -268,12 +282,14 @@ func TestMountResolver(t *testing.T) {
 					},
 					{
 						mount: &MountEvent{
+							BaseEvent{},
 							639,
 							0,
 							54,
 							638,
 							0,
 							"proc",
+							0,
 							"proc",
 							0,
 							0,
@@ -299,7 +315,7 @@ func TestMountResolver(t *testing.T) {
 				[]event{
 					{
 						umount: &UmountEvent{
-							176,
+							MountID: 176,
 						},
 					},
 				},
@@ -68,7 +68,9 @@ var openHookPoints = []*HookPoint{
 				},
 			},
 		},
-		PolicyTable: "open_policy",
+		PolicyTable:  "open_policy",
+		PinPath:      "open",
+		PinnedTables: openTables,
 		OnNewApprovers: func(probe *Probe, approvers rules.Approvers) error {
 			stringValues := func(fvs rules.FilterValues) []string {
 				var values []string
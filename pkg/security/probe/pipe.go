@@ -0,0 +1,30 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import "github.com/DataDog/datadog-agent/pkg/security/secl/eval"
+
+// pipeHookPoints holds the list of pipe's kProbes. pipe isn't available on the architectures
+// that only provide pipe2, such as arm64, the hook point is optional.
+var pipeHookPoints = []*HookPoint{
+	{
+		Name:    "sys_pipe",
+		KProbes: syscallKprobe("pipe"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"pipe": {},
+		},
+		Optional: true,
+	},
+	{
+		Name:    "sys_pipe2",
+		KProbes: syscallKprobe("pipe2"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"pipe": {},
+		},
+	},
+}
@@ -0,0 +1,22 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import "github.com/DataDog/datadog-agent/pkg/security/secl/eval"
+
+// prctlHookPoints holds the list of prctl's kProbes. The new name of the thread is captured for PR_SET_NAME,
+// the process context of the event being read on return it already carries the new name.
+var prctlHookPoints = []*HookPoint{
+	{
+		Name:    "sys_prctl",
+		KProbes: syscallKprobe("prctl"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"prctl": {},
+		},
+	},
+}
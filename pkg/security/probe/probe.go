@@ -9,7 +9,10 @@ package probe
 
 import (
 	"fmt"
+	"io/ioutil"
 	"math"
+	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/DataDog/datadog-go/statsd"
@@ -50,10 +53,14 @@ type Probe struct {
 	handler          EventHandler
 	resolvers        *Resolvers
 	onDiscardersFncs map[eval.EventType][]onDiscarderFnc
+	activeHookPoints map[*HookPoint]bool
 	enableFilters    bool
 	tables           map[string]*ebpf.Table
 	eventsStats      EventsStats
 	syscallMonitor   *SyscallMonitor
+	// ruleSetFingerprint is the fingerprint of the rule set the policies, approvers and discarders held by
+	// the PinnedTables of the hook points were computed for
+	ruleSetFingerprint string
 }
 
 // Capability represents the type of values we are able to filter kernel side
@@ -75,6 +82,11 @@ type HookPoint struct {
 	OnNewApprovers  onApproversFnc
 	OnNewDiscarders onDiscarderFnc
 	PolicyTable     string
+	// PinPath is the directory, relative to the map pinning path, where PinnedTables are pinned when map
+	// pinning is enabled. PinnedTables hold the policy, approvers and discarders of the hook point, they are
+	// cleared whenever the rule set changes.
+	PinPath      string
+	PinnedTables []string
 }
 
 // cache of the syscall prefix depending on kernel version
@@ -166,12 +178,13 @@ var allHookPoints = []*HookPoint{
 			EntryFunc: "kprobe/mnt_want_write",
 		}},
 		EventTypes: map[eval.EventType]Capabilities{
-			"utimes": {},
-			"chmod":  {},
-			"chown":  {},
-			"rmdir":  {},
-			"unlink": {},
-			"rename": {},
+			"utimes":    {},
+			"chmod":     {},
+			"chown":     {},
+			"rmdir":     {},
+			"unlink":    {},
+			"rename":    {},
+			"fallocate": {},
 		},
 	},
 	{
@@ -316,6 +329,113 @@ var allHookPoints = []*HookPoint{
 	},
 }
 
+// ErrHookPointExists is returned when registering a hook point whose name is already used
+type ErrHookPointExists struct {
+	Name string
+}
+
+func (e ErrHookPointExists) Error() string {
+	return fmt.Sprintf("hook point `%s` already registered", e.Name)
+}
+
+// RegisterHookPoints adds the given hook points to the set of hook points of the probe. Hook point names
+// have to be unique, none of the hook points are added if one of the names is already used.
+// The registration has to be done before the probe is loaded, the hook points registered afterwards
+// won't be attached.
+func RegisterHookPoints(hps ...*HookPoint) error {
+	names := make(map[string]bool)
+	for _, hookPoint := range allHookPoints {
+		names[hookPoint.Name] = true
+	}
+
+	for _, hookPoint := range hps {
+		if names[hookPoint.Name] {
+			return ErrHookPointExists{Name: hookPoint.Name}
+		}
+		names[hookPoint.Name] = true
+	}
+
+	allHookPoints = append(allHookPoints, hps...)
+
+	return nil
+}
+
+// HookPointsForEventType returns the hook points declaring the given event type
+func HookPointsForEventType(eventType eval.EventType) []*HookPoint {
+	var hookPoints []*HookPoint
+	for _, hookPoint := range allHookPoints {
+		if _, ok := hookPoint.EventTypes[eventType]; ok {
+			hookPoints = append(hookPoints, hookPoint)
+		}
+	}
+
+	return hookPoints
+}
+
+// HookPointsForEventTypes returns the hook points required to generate the given event types, the hook
+// points declaring the `*` event type are always part of the list
+func HookPointsForEventTypes(eventTypes []eval.EventType) []*HookPoint {
+	required := make(map[*HookPoint]bool)
+	for _, eventType := range append([]eval.EventType{"*"}, eventTypes...) {
+		for _, hookPoint := range HookPointsForEventType(eventType) {
+			required[hookPoint] = true
+		}
+	}
+
+	var hookPoints []*HookPoint
+	for _, hookPoint := range allHookPoints {
+		if required[hookPoint] {
+			hookPoints = append(hookPoints, hookPoint)
+		}
+	}
+
+	return hookPoints
+}
+
+// ErrNoHookPoint is returned when an event type isn't backed by any hook point
+type ErrNoHookPoint struct {
+	EventType eval.EventType
+}
+
+func (e ErrNoHookPoint) Error() string {
+	return fmt.Sprintf("no hook point available for event type `%s`", e.EventType)
+}
+
+// AllEventTypes returns the distinct set of event types declared across all the hook points, the "*"
+// wildcard of the hook points used by every event type excluded
+func AllEventTypes() []eval.EventType {
+	var eventTypes []eval.EventType
+
+	seen := make(map[eval.EventType]bool)
+	for _, hookPoint := range allHookPoints {
+		for eventType := range hookPoint.EventTypes {
+			if eventType != "*" && !seen[eventType] {
+				seen[eventType] = true
+				eventTypes = append(eventTypes, eventType)
+			}
+		}
+	}
+	sort.Strings(eventTypes)
+
+	return eventTypes
+}
+
+// ValidateRuleSet checks that every event type referenced by the rules of the ruleset is backed by at least one hook point
+func ValidateRuleSet(rs *rules.RuleSet) error {
+	eventTypes := make(map[eval.EventType]bool)
+	for _, eventType := range AllEventTypes() {
+		eventTypes[eventType] = true
+	}
+
+	for _, eventType := range rs.GetEventTypes() {
+		if !eventTypes[eventType] {
+			return ErrNoHookPoint{EventType: eventType}
+		}
+	}
+
+	return nil
+}
+
 // GetFlags returns the policy flags for the set of capabilities
 func (caps Capabilities) GetFlags() PolicyFlag {
 	var flags PolicyFlag
@@ -370,6 +490,8 @@ func (p *Probe) getTableNames() []string {
 	tables = append(tables, openTables...)
 	tables = append(tables, execTables...)
 	tables = append(tables, unlinkTables...)
+	tables = append(tables, ioctlTables...)
+	tables = append(tables, writeTables...)
 
 	return tables
 }
@@ -420,10 +542,18 @@ func (p *Probe) Start() error {
 		return err
 	}
 
+	if p.config.MapPinning {
+		p.pinHookPointTables()
+	}
+
 	if err := p.resolvers.Start(); err != nil {
 		return err
 	}
 
+	if err := setIoctlRequests(p, p.config.IoctlRequests); err != nil {
+		return err
+	}
+
 	if p.config.SyscallMonitor {
 		p.syscallMonitor, err = NewSyscallMonitor(
 			p.Module,
@@ -453,6 +583,70 @@ func (p *Probe) Start() error {
 	return p.Probe.Start()
 }
 
+// pinHookPointTables pins the tables of the hook points under the map pinning path so that their content,
+// approvers and discarders for instance, is kept across restarts. A table that can't be pinned is only
+// reported, the probe keeps using the table it loaded. The reused content is only kept if the rule set
+// applied afterwards is the one it was computed for, see clearHookPointTables.
+func (p *Probe) pinHookPointTables() {
+	if p.config.MapPinningReuse {
+		if fingerprint, err := ioutil.ReadFile(p.pinnedRuleSetPath()); err == nil {
+			p.ruleSetFingerprint = strings.TrimSpace(string(fingerprint))
+		}
+	}
+
+	for _, hookPoint := range allHookPoints {
+		if hookPoint.PinPath == "" {
+			continue
+		}
+
+		for _, name := range hookPoint.PinnedTables {
+			table := p.Table(name)
+			if table == nil {
+				log.Warnf("Unable to pin table %s of hook point %s: table not found", name, hookPoint.Name)
+				continue
+			}
+
+			path := filepath.Join(p.config.MapPinningPath, hookPoint.PinPath, name)
+			if err := table.Pin(path, p.config.MapPinningReuse); err != nil {
+				log.Warnf("Unable to pin table %s of hook point %s: %s", name, hookPoint.Name, err)
+				continue
+			}
+			log.Debugf("Pinned table %s of hook point %s at %s", name, hookPoint.Name, path)
+		}
+	}
+}
+
+// pinnedRuleSetPath returns the path of the file holding the fingerprint of the rule set of the pinned tables
+func (p *Probe) pinnedRuleSetPath() string {
+	return filepath.Join(p.config.RunPath, "runtime-security-pinned-ruleset")
+}
+
+// clearHookPointTables clears the policies, approvers and discarders of the hook points when they were computed
+// for another rule set than rs, the discarders of a previous rule set could otherwise drop events matching rs
+func (p *Probe) clearHookPointTables(rs *rules.RuleSet) {
+	fingerprint := rs.Fingerprint()
+	if fingerprint == p.ruleSetFingerprint {
+		return
+	}
+
+	for _, hookPoint := range allHookPoints {
+		for _, name := range hookPoint.PinnedTables {
+			if table := p.Table(name); table != nil {
+				if err := table.Clear(); err != nil {
+					log.Warnf("Unable to clear table %s of hook point %s: %s", name, hookPoint.Name, err)
+				}
+			}
+		}
+	}
+	p.ruleSetFingerprint = fingerprint
+
+	if p.config.MapPinning {
+		if err := ioutil.WriteFile(p.pinnedRuleSetPath(), []byte(fingerprint), 0600); err != nil {
+			log.Warnf("Unable to save the fingerprint of the rule set of the pinned tables: %s", err)
+		}
+	}
+}
+
 // SetEventHandler set the probe event handler
 func (p *Probe) SetEventHandler(handler EventHandler) {
 	p.handler = handler
@@ -601,6 +795,15 @@ func (p *Probe) handleEvent(data []byte) {
 			log.Errorf("failed to decode mount event: %s (offset %d, len %d)", err, offset, len(data))
 			return
 		}
+		if event.Mount.IsRemount() {
+			// A remount doesn't create any mount point, the one remounted is already in cache
+			_, mountPath, rootStr, err := p.resolvers.MountResolver.GetMountPath(event.Mount.NewMountID, 0)
+			if err != nil {
+				log.Debugf("failed to resolve remounted mount point %d: %s", event.Mount.NewMountID, err)
+			}
+			event.Mount.MountPointStr, event.Mount.RootStr = mountPath, rootStr
+			break
+		}
 		// Resolve mount point
 		event.Mount.ResolveMountPoint(p.resolvers)
 		// Resolve root
@@ -616,6 +819,127 @@ func (p *Probe) handleEvent(data []byte) {
 		if err := p.resolvers.MountResolver.Delete(event.Umount.MountID); err != nil {
 			log.Errorf("failed to delete mount point %d from cache: %s", event.Umount.MountID, err)
 		}
+	case FileGetXattrEventType:
+		if _, err := event.GetXattr.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode getxattr event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case FileChdirEventType:
+		if _, err := event.Chdir.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode chdir event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+		// Update the working directory cache so that the next events of the process resolve it
+		if event.Chdir.Retval >= 0 {
+			p.resolvers.ProcessResolver.SetCwd(event.Process.Pid, event.Process.ExecTimestamp, event.Chdir.ResolveInode(p.resolvers))
+		}
+	case FileMemfdEventType:
+		if _, err := event.Memfd.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode memfd event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case FileFallocateEventType:
+		if _, err := event.Fallocate.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode fallocate event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case FileDupEventType:
+		if _, err := event.Dup.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode dup event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case FileSetrlimitEventType:
+		if _, err := event.Setrlimit.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode setrlimit event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case FileSetnsEventType:
+		if _, err := event.Setns.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode setns event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case FileQuotactlEventType:
+		if _, err := event.Quotactl.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode quotactl event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case FilePipeEventType:
+		if _, err := event.Pipe.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode pipe event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case FileIoctlEventType:
+		if _, err := event.Ioctl.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode ioctl event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case FileUmaskEventType:
+		if _, err := event.Umask.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode umask event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+		p.resolvers.UmaskResolver.SetUmask(event.Process.Pid, event.Umask.Mask)
+	case FileStatEventType:
+		if _, err := event.Stat.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode stat event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case FileWriteEventType:
+		if _, err := event.Write.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode write event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case FileCloneEventType:
+		if _, err := event.Clone.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode clone event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case FileSendfileEventType:
+		if _, err := event.Sendfile.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode sendfile event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case FileKeyctlEventType:
+		if _, err := event.Keyctl.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode keyctl event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case FilePrctlEventType:
+		if _, err := event.Prctl.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode prctl event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case FileLoadModuleEventType:
+		if _, err := event.LoadModule.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode load_module event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case BindEventType:
+		if _, err := event.Bind.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode bind event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case ExecEventType:
+		// the exec, fork and exit events only keep the process resolver up to date, they are not dispatched
+		p.resolvers.ProcessResolver.AddExecEntry(event.Process.Pid, event.Process.ExecTimestamp)
+		p.eventsStats.CountEventType(eventType, 1)
+		return
+	case ForkEventType:
+		if len(data) < offset+4 {
+			log.Errorf("failed to decode fork event: %s (offset %d, len %d)", ErrNotEnoughData, offset, len(data))
+			return
+		}
+		childPid := byteOrder.Uint32(data[offset : offset+4])
+		p.resolvers.ProcessResolver.AddForkEntry(event.Process.Pid, event.Process.ExecTimestamp, childPid)
+		// the child inherits the umask of its parent, an entry left for its pid belongs to a previous process
+		p.resolvers.UmaskResolver.DeleteEntry(childPid)
+		p.eventsStats.CountEventType(eventType, 1)
+		return
+	case ExitEventType:
+		p.resolvers.ProcessResolver.DeleteEntry(event.Process.Pid, event.Process.ExecTimestamp)
+		p.resolvers.UmaskResolver.DeleteEntry(event.Process.Pid)
+		p.eventsStats.CountEventType(eventType, 1)
+		return
 	default:
 		log.Errorf("unsupported event type %d", eventType)
 		return
@@ -709,24 +1033,27 @@ func (p *Probe) setKProbePolicy(hookPoint *HookPoint, rs *rules.RuleSet, eventTy
 // ApplyRuleSet applies the loaded set of rules and returns a report
 // of the applied approvers for it. If dryRun is set to true,
 // the rules won't be applied but the report will still be returned.
+// Only the hook points of the event types used by the rules are attached,
+// applying a new rule set attaches and detaches hook points accordingly.
 func (p *Probe) ApplyRuleSet(rs *rules.RuleSet, dryRun bool) (*Report, error) {
 	var applier Applier = NewReporter()
 	if !dryRun {
 		applier = &KFilterApplier{probe: p, reporter: applier}
 	}
 
-	already := make(map[*HookPoint]bool)
-
 	if !p.enableFilters {
 		log.Warn("Forcing in-kernel filter policy to `pass`: filtering not enabled")
 	}
 
+	if !dryRun {
+		p.clearHookPointTables(rs)
+	}
+
 	for _, hookPoint := range allHookPoints {
 		if hookPoint.EventTypes == nil {
 			continue
 		}
 
-		// first set policies
 		for eventType, capabilities := range hookPoint.EventTypes {
 			if rs.HasRulesForEventType(eventType) {
 				if hookPoint.PolicyTable == "" {
@@ -738,59 +1065,104 @@ func (p *Probe) ApplyRuleSet(rs *rules.RuleSet, dryRun bool) (*Report, error) {
 				}
 			}
 		}
+	}
+
+	if dryRun {
+		return applier.GetReport(), nil
+	}
 
-		if dryRun {
+	if err := p.attachHookPoints(HookPointsForEventTypes(rs.GetEventTypes())); err != nil {
+		return nil, err
+	}
+
+	return applier.GetReport(), nil
+}
+
+// attachHookPoints registers the given hook points and unregisters the previously registered ones that are not
+// part of the list anymore, so that a new rule set can be applied without restarting the probe
+func (p *Probe) attachHookPoints(hookPoints []*HookPoint) error {
+	required := make(map[*HookPoint]bool)
+	for _, hookPoint := range hookPoints {
+		required[hookPoint] = true
+		if p.activeHookPoints[hookPoint] {
 			continue
 		}
 
-		// then register kprobes
-		for eventType := range hookPoint.EventTypes {
-			if eventType == "*" || rs.HasRulesForEventType(eventType) {
-				if _, ok := already[hookPoint]; ok {
-					continue
-				}
+		if err := p.registerHookPoint(hookPoint); err != nil {
+			return err
+		}
+		p.activeHookPoints[hookPoint] = true
+	}
 
-				var active int
-				var err error
-
-				log.Infof("Registering Hook Point `%s`", hookPoint.Name)
-				for _, kprobe := range hookPoint.KProbes {
-					// use hook point name if kprobe name not provided
-					if len(kprobe.Name) == 0 {
-						kprobe.Name = hookPoint.Name
-					}
-
-					if err = p.Module.RegisterKprobe(kprobe); err == nil {
-						log.Infof("kProbe `%s` registered", kprobe.Name)
-						active++
-					} else {
-						log.Debugf("failed to register kProbe `%s`", kprobe.Name)
-					}
-				}
-				if len(hookPoint.Tracepoint) > 0 && err == nil {
-					if err = p.Module.RegisterTracepoint(hookPoint.Tracepoint); err == nil {
-						log.Infof("tracepoint `%s` registered", hookPoint.Tracepoint)
-						active++
-					} else {
-						log.Debugf("failed to register tracepoint `%s`", hookPoint.Tracepoint)
-					}
-				}
+	for hookPoint := range p.activeHookPoints {
+		if required[hookPoint] {
+			continue
+		}
 
-				if err != nil {
-					if !hookPoint.Optional {
-						return nil, err
-					}
-				}
+		if err := p.unregisterHookPoint(hookPoint); err != nil {
+			log.Warnf("failed to unregister Hook Point `%s`: %s", hookPoint.Name, err)
+			continue
+		}
+		delete(p.activeHookPoints, hookPoint)
+	}
 
-				if active > 0 {
-					log.Infof("Hook Point `%s` registered with %d active kProbes", hookPoint.Name, active)
-				}
-				already[hookPoint] = true
-			}
+	return nil
+}
+
+func (p *Probe) registerHookPoint(hookPoint *HookPoint) error {
+	var active int
+	var err error
+
+	log.Infof("Registering Hook Point `%s`", hookPoint.Name)
+	for _, kprobe := range hookPoint.KProbes {
+		// use hook point name if kprobe name not provided
+		if len(kprobe.Name) == 0 {
+			kprobe.Name = hookPoint.Name
+		}
+
+		if err = p.Module.RegisterKprobe(kprobe); err == nil {
+			log.Infof("kProbe `%s` registered", kprobe.Name)
+			active++
+		} else {
+			log.Debugf("failed to register kProbe `%s`", kprobe.Name)
+		}
+	}
+	if len(hookPoint.Tracepoint) > 0 && err == nil {
+		if err = p.Module.RegisterTracepoint(hookPoint.Tracepoint); err == nil {
+			log.Infof("tracepoint `%s` registered", hookPoint.Tracepoint)
+			active++
+		} else {
+			log.Debugf("failed to register tracepoint `%s`", hookPoint.Tracepoint)
 		}
 	}
 
-	return applier.GetReport(), nil
+	if err != nil {
+		if !hookPoint.Optional {
+			return err
+		}
+		// an optional hook point not supported by the kernel doesn't prevent the others from being registered
+		log.Warnf("optional Hook Point `%s` couldn't be registered: %s", hookPoint.Name, err)
+	}
+
+	if active > 0 {
+		log.Infof("Hook Point `%s` registered with %d active kProbes", hookPoint.Name, active)
+	}
+
+	return nil
+}
+
+// unregisterHookPoint detaches the kprobes of a hook point, tracepoints are only used by hook points
+// required whatever the rules and stay attached
+func (p *Probe) unregisterHookPoint(hookPoint *HookPoint) error {
+	log.Infof("Unregistering Hook Point `%s`", hookPoint.Name)
+	for _, kprobe := range hookPoint.KProbes {
+		if err := p.Module.UnregisterKprobe(kprobe); err != nil {
+			return err
+		}
+		log.Infof("kProbe `%s` unregistered", kprobe.Name)
+	}
+
+	return nil
 }
 
 // Snapshot runs the different snapshot functions of the resolvers that
@@ -804,6 +1176,7 @@ func NewProbe(config *config.Config) (*Probe, error) {
 	p := &Probe{
 		config:           config,
 		onDiscardersFncs: make(map[eval.EventType][]onDiscarderFnc),
+		activeHookPoints: make(map[*HookPoint]bool),
 		enableFilters:    config.EnableKernelFilters,
 		tables:           make(map[string]*ebpf.Table),
 	}
@@ -813,7 +1186,7 @@ func NewProbe(config *config.Config) (*Probe, error) {
 		PerfMaps: p.getPerfMaps(),
 	}
 
-	resolvers, err := NewResolvers(p.Probe)
+	resolvers, err := NewResolvers(p.Probe, config)
 	if err != nil {
 		return nil, err
 	}
@@ -828,4 +1201,23 @@ func init() {
 	allHookPoints = append(allHookPoints, mountHookPoints...)
 	allHookPoints = append(allHookPoints, execHookPoints...)
 	allHookPoints = append(allHookPoints, UnlinkHookPoints...)
+	allHookPoints = append(allHookPoints, getxattrHookPoints...)
+	allHookPoints = append(allHookPoints, chdirHookPoints...)
+	allHookPoints = append(allHookPoints, memfdHookPoints...)
+	allHookPoints = append(allHookPoints, fallocateHookPoints...)
+	allHookPoints = append(allHookPoints, dupHookPoints...)
+	allHookPoints = append(allHookPoints, setrlimitHookPoints...)
+	allHookPoints = append(allHookPoints, setnsHookPoints...)
+	allHookPoints = append(allHookPoints, quotactlHookPoints...)
+	allHookPoints = append(allHookPoints, pipeHookPoints...)
+	allHookPoints = append(allHookPoints, ioctlHookPoints...)
+	allHookPoints = append(allHookPoints, umaskHookPoints...)
+	allHookPoints = append(allHookPoints, statHookPoints...)
+	allHookPoints = append(allHookPoints, writeHookPoints...)
+	allHookPoints = append(allHookPoints, cloneHookPoints...)
+	allHookPoints = append(allHookPoints, sendfileHookPoints...)
+	allHookPoints = append(allHookPoints, keyctlHookPoints...)
+	allHookPoints = append(allHookPoints, prctlHookPoints...)
+	allHookPoints = append(allHookPoints, moduleHookPoints...)
+	allHookPoints = append(allHookPoints, bindHookPoints...)
 }
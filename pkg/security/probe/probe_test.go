@@ -0,0 +1,105 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import (
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/eval"
+)
+
+func TestAllEventTypes(t *testing.T) {
+	eventTypes := make(map[eval.EventType]bool)
+	for _, eventType := range AllEventTypes() {
+		if eventType == "*" {
+			t.Error("the `*` wildcard isn't an event type")
+		}
+		if eventTypes[eventType] {
+			t.Errorf("duplicate event type `%s`", eventType)
+		}
+		eventTypes[eventType] = true
+	}
+
+	for eventType := FileOpenEventType; eventType < maxEventType; eventType++ {
+		// mount hook points are always enabled to keep the mount resolver up to date, umount events
+		// are only used internally and can't be referenced by a rule, neither can the exec, fork and exit
+		// events that keep the process resolver up to date
+		switch eventType {
+		case FileMountEventType, FileUmountEventType, ExecEventType, ForkEventType, ExitEventType:
+			continue
+		}
+
+		if !eventTypes[eventType.String()] {
+			t.Errorf("no hook point for event type `%s`", eventType)
+		}
+	}
+}
+
+func TestRegisterHookPoints(t *testing.T) {
+	defer func(hookPoints []*HookPoint) {
+		allHookPoints = hookPoints
+	}(allHookPoints)
+
+	hookPoint := &HookPoint{
+		Name: "test_hook_point",
+		EventTypes: map[eval.EventType]Capabilities{
+			"test": {},
+		},
+	}
+
+	if err := RegisterHookPoints(hookPoint); err != nil {
+		t.Fatal(err)
+	}
+
+	found := false
+	for _, eventType := range AllEventTypes() {
+		if eventType == "test" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("event type of the registered hook point not found")
+	}
+
+	if err := RegisterHookPoints(&HookPoint{Name: "test_hook_point"}); err == nil {
+		t.Error("expected an error when registering a hook point twice")
+	}
+
+	count := len(allHookPoints)
+	if err := RegisterHookPoints(&HookPoint{Name: "test_other_hook_point"}, &HookPoint{Name: "sys_open"}); err == nil {
+		t.Error("expected an error when registering an existing hook point")
+	}
+	if len(allHookPoints) != count {
+		t.Error("hook points shouldn't be registered on error")
+	}
+}
+
+func TestHookPointsForEventTypes(t *testing.T) {
+	defer func(hookPoints []*HookPoint) {
+		allHookPoints = hookPoints
+	}(allHookPoints)
+
+	always := &HookPoint{Name: "test_always", EventTypes: map[eval.EventType]Capabilities{"*": {}}}
+	first := &HookPoint{Name: "test_first", EventTypes: map[eval.EventType]Capabilities{"test_first": {}}}
+	second := &HookPoint{Name: "test_second", EventTypes: map[eval.EventType]Capabilities{"test_second": {}, "test_first": {}}}
+	allHookPoints = []*HookPoint{always, first, second, {Name: "test_none"}}
+
+	if hookPoints := HookPointsForEventType("test_second"); len(hookPoints) != 1 || hookPoints[0] != second {
+		t.Errorf("expected only `test_second` for event type `test_second`, got %v", hookPoints)
+	}
+
+	hookPoints := HookPointsForEventTypes([]eval.EventType{"test_first"})
+	if len(hookPoints) != 3 || hookPoints[0] != always || hookPoints[1] != first || hookPoints[2] != second {
+		t.Errorf("expected `test_always`, `test_first` and `test_second` for event type `test_first`, got %v", hookPoints)
+	}
+
+	hookPoints = HookPointsForEventTypes(nil)
+	if len(hookPoints) != 1 || hookPoints[0] != always {
+		t.Errorf("expected only `test_always` without any event type, got %v", hookPoints)
+	}
+}
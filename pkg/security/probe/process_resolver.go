@@ -0,0 +1,139 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import (
+	"os"
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/DataDog/datadog-agent/pkg/security/utils"
+)
+
+const processCacheSize = 4096
+
+// processCacheKey identifies a process image: the pid alone is reused by the kernel and kept across executions
+type processCacheKey struct {
+	pid           uint32
+	execTimestamp uint64
+}
+
+// ProcessCacheEntry holds the context of a process that is resolved once, when the process is executed or forked
+type ProcessCacheEntry struct {
+	Cwd           string
+	Args          []string
+	ArgsTruncated bool
+}
+
+// ProcessResolver keeps a user space process cache entry for every process, keyed on its pid and the timestamp of
+// its execution. The entries are created by the exec and fork events, updated by the chdir events and dropped by the
+// exit events. The processes that started before the probe, whose exec timestamp is 0, or whose exec event was lost
+// are resolved from the proc fs on their first event. The arguments of a process that exited before its exec event
+// was handled can't be captured.
+type ProcessResolver struct {
+	sync.Mutex
+	entries      *lru.Cache
+	argsResolver *ArgsResolver
+}
+
+// NewProcessResolver returns a new process resolver
+func NewProcessResolver(argsResolver *ArgsResolver) (*ProcessResolver, error) {
+	entries, err := lru.New(processCacheSize)
+	if err != nil {
+		return nil, err
+	}
+	return &ProcessResolver{entries: entries, argsResolver: argsResolver}, nil
+}
+
+// AddExecEntry resolves the process cache entry of a process that was just executed, the arguments of the new
+// process image are captured at this point
+func (pr *ProcessResolver) AddExecEntry(pid uint32, execTimestamp uint64) {
+	pr.Lock()
+	defer pr.Unlock()
+
+	pr.entries.Add(processCacheKey{pid: pid, execTimestamp: execTimestamp}, pr.resolveFromProc(pid))
+}
+
+// AddForkEntry creates the process cache entry of a forked process from the one of its parent, the child shares
+// the exec timestamp of its parent until it executes another file
+func (pr *ProcessResolver) AddForkEntry(ppid uint32, execTimestamp uint64, pid uint32) {
+	pr.Lock()
+	defer pr.Unlock()
+
+	parent := pr.getOrResolve(ppid, execTimestamp)
+	child := *parent
+	pr.entries.Add(processCacheKey{pid: pid, execTimestamp: execTimestamp}, &child)
+}
+
+// DeleteEntry drops the process cache entry of a process that exited
+func (pr *ProcessResolver) DeleteEntry(pid uint32, execTimestamp uint64) {
+	pr.Lock()
+	defer pr.Unlock()
+
+	pr.entries.Remove(processCacheKey{pid: pid, execTimestamp: execTimestamp})
+}
+
+// SetCwd updates the working directory of a process
+func (pr *ProcessResolver) SetCwd(pid uint32, execTimestamp uint64, cwd string) {
+	if len(cwd) == 0 {
+		return
+	}
+
+	pr.Lock()
+	defer pr.Unlock()
+
+	key := processCacheKey{pid: pid, execTimestamp: execTimestamp}
+	if entry, ok := pr.entries.Get(key); ok {
+		entry.(*ProcessCacheEntry).Cwd = cwd
+		return
+	}
+	pr.entries.Add(key, &ProcessCacheEntry{Cwd: cwd})
+}
+
+// ResolveCwd returns the working directory of a process, an empty string is returned if it couldn't be resolved
+func (pr *ProcessResolver) ResolveCwd(pid uint32, execTimestamp uint64) string {
+	pr.Lock()
+	defer pr.Unlock()
+
+	return pr.getOrResolve(pid, execTimestamp).Cwd
+}
+
+// ResolveArgs returns the arguments captured when the process was executed and whether they were truncated
+func (pr *ProcessResolver) ResolveArgs(pid uint32, execTimestamp uint64) ([]string, bool) {
+	pr.Lock()
+	defer pr.Unlock()
+
+	entry := pr.getOrResolve(pid, execTimestamp)
+	return entry.Args, entry.ArgsTruncated
+}
+
+// getOrResolve returns the process cache entry of a process, it is resolved from the proc fs if it isn't cached
+func (pr *ProcessResolver) getOrResolve(pid uint32, execTimestamp uint64) *ProcessCacheEntry {
+	key := processCacheKey{pid: pid, execTimestamp: execTimestamp}
+	if entry, ok := pr.entries.Get(key); ok {
+		return entry.(*ProcessCacheEntry)
+	}
+
+	entry := pr.resolveFromProc(pid)
+	pr.entries.Add(key, entry)
+	return entry
+}
+
+// resolveFromProc returns a process cache entry resolved from the proc fs, the fields that couldn't be resolved are
+// left empty
+func (pr *ProcessResolver) resolveFromProc(pid uint32) *ProcessCacheEntry {
+	entry := &ProcessCacheEntry{}
+	if cwd, err := os.Readlink(utils.ProcCwdPath(pid)); err == nil {
+		entry.Cwd = cwd
+	}
+	if pr.argsResolver != nil {
+		entry.Args, entry.ArgsTruncated = pr.argsResolver.Resolve(pid)
+	}
+	return entry
+}
@@ -0,0 +1,56 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import (
+	"os"
+	"testing"
+)
+
+func TestProcessResolverCwd(t *testing.T) {
+	pr, err := NewProcessResolver(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid := uint32(os.Getpid())
+
+	// the entry is resolved once at exec time
+	pr.AddExecEntry(pid, 1)
+	if cwd := pr.ResolveCwd(pid, 1); cwd != wd {
+		t.Errorf("expected cwd `%s`, got `%s`", wd, cwd)
+	}
+
+	// a chdir updates the entry of the process image only
+	pr.SetCwd(pid, 1, "/tmp")
+	if cwd := pr.ResolveCwd(pid, 1); cwd != "/tmp" {
+		t.Errorf("expected cwd `/tmp`, got `%s`", cwd)
+	}
+
+	// a forked process inherits the entry of its parent
+	pr.AddForkEntry(pid, 1, 1<<30)
+	if cwd := pr.ResolveCwd(1<<30, 1); cwd != "/tmp" {
+		t.Errorf("expected inherited cwd `/tmp`, got `%s`", cwd)
+	}
+
+	// a new execution of the same pid gets a new entry
+	pr.AddExecEntry(pid, 2)
+	if cwd := pr.ResolveCwd(pid, 2); cwd != wd {
+		t.Errorf("expected cwd `%s`, got `%s`", wd, cwd)
+	}
+
+	// the entry is dropped on exit, the pid doesn't exist anymore in the proc fs
+	pr.DeleteEntry(1<<30, 1)
+	if cwd := pr.ResolveCwd(1<<30, 1); cwd != "" {
+		t.Errorf("expected an empty cwd for an exited process, got `%s`", cwd)
+	}
+}
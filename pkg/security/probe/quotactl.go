@@ -0,0 +1,23 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import "github.com/DataDog/datadog-agent/pkg/security/secl/eval"
+
+// quotactlHookPoints holds the list of quotactl's kProbes. quotactl isn't available on the
+// kernels built without quota support (CONFIG_QUOTACTL), the hook point is optional.
+var quotactlHookPoints = []*HookPoint{
+	{
+		Name:    "sys_quotactl",
+		KProbes: syscallKprobe("quotactl"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"quotactl": {},
+		},
+		Optional: true,
+	},
+}
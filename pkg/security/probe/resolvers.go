@@ -14,6 +14,7 @@ import (
 	"github.com/DataDog/gopsutil/process"
 	"github.com/pkg/errors"
 
+	"github.com/DataDog/datadog-agent/pkg/security/config"
 	"github.com/DataDog/datadog-agent/pkg/security/ebpf"
 	"github.com/DataDog/datadog-agent/pkg/security/utils"
 	"github.com/DataDog/datadog-agent/pkg/util/log"
@@ -34,18 +35,20 @@ var SnapshotProbes = []*ebpf.KProbe{
 
 // ProcCache this structure holds the container context that we keep in kernel for each process
 type ProcCache struct {
-	Inode    uint64
-	Numlower uint32
-	Padding  uint32
-	ID       [utils.ContainerIDLen]byte
+	Inode         uint64
+	Numlower      uint32
+	Padding       uint32
+	ID            [utils.ContainerIDLen]byte
+	ExecTimestamp uint64
 }
 
 // Bytes returns the bytes representation of process cache entry
 func (pc ProcCache) Bytes() []byte {
-	b := make([]byte, 16+utils.ContainerIDLen)
+	b := make([]byte, 16+utils.ContainerIDLen+8)
 	byteOrder.PutUint64(b[0:8], pc.Inode)
 	byteOrder.PutUint32(b[8:12], pc.Numlower)
 	copy(b[16:16+utils.ContainerIDLen], pc.ID[:])
+	byteOrder.PutUint64(b[16+utils.ContainerIDLen:24+utils.ContainerIDLen], pc.ExecTimestamp)
 	return b
 }
 
@@ -60,6 +63,9 @@ type Resolvers struct {
 	MountResolver     *MountResolver
 	ContainerResolver *ContainerResolver
 	TimeResolver      *TimeResolver
+	ProcessResolver   *ProcessResolver
+	ArgsResolver      *ArgsResolver
+	UmaskResolver     *UmaskResolver
 }
 
 // Start the resolvers
@@ -217,7 +223,7 @@ func (r *Resolvers) snapshotProcess(pid uint32) bool {
 }
 
 // NewResolvers creates a new instance of Resolvers
-func NewResolvers(probe *ebpf.Probe) (*Resolvers, error) {
+func NewResolvers(probe *ebpf.Probe, config *config.Config) (*Resolvers, error) {
 	dentryResolver, err := NewDentryResolver(probe)
 	if err != nil {
 		return nil, err
@@ -226,10 +232,22 @@ func NewResolvers(probe *ebpf.Probe) (*Resolvers, error) {
 	if err != nil {
 		return nil, err
 	}
+	argsResolver := NewArgsResolver(config)
+	processResolver, err := NewProcessResolver(argsResolver)
+	if err != nil {
+		return nil, err
+	}
+	umaskResolver, err := NewUmaskResolver()
+	if err != nil {
+		return nil, err
+	}
 	return &Resolvers{
-		probe:          probe,
-		DentryResolver: dentryResolver,
-		MountResolver:  NewMountResolver(),
-		TimeResolver:   timeResolver,
+		probe:           probe,
+		DentryResolver:  dentryResolver,
+		MountResolver:   NewMountResolver(),
+		TimeResolver:    timeResolver,
+		ProcessResolver: processResolver,
+		ArgsResolver:    argsResolver,
+		UmaskResolver:   umaskResolver,
 	}, nil
 }
@@ -0,0 +1,31 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import "github.com/DataDog/datadog-agent/pkg/security/secl/eval"
+
+// setrlimitHookPoints holds the list of setrlimit's kProbes. prlimit64 can change the limits of
+// another process, the event then reports the targeted pid. setrlimit isn't available on the
+// architectures that only provide prlimit64, such as arm64, the hook point is optional.
+var setrlimitHookPoints = []*HookPoint{
+	{
+		Name:    "sys_setrlimit",
+		KProbes: syscallKprobe("setrlimit"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"setrlimit": {},
+		},
+		Optional: true,
+	},
+	{
+		Name:    "sys_prlimit64",
+		KProbes: syscallKprobe("prlimit64"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"setrlimit": {},
+		},
+	},
+}
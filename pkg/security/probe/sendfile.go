@@ -0,0 +1,39 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import "github.com/DataDog/datadog-agent/pkg/security/secl/eval"
+
+// sendfileHookPoints holds the list of sendfile's kProbes, splice being reported as a sendfile event.
+// The events only carry the source and destination file descriptors: the probe doesn't track the
+// file descriptors, resolving the paths they point to requires a file descriptor tracker. sendfile
+// with 32 bits offsets is only used by the 32 bits ABIs, the hook point is optional.
+var sendfileHookPoints = []*HookPoint{
+	{
+		Name:    "sys_sendfile",
+		KProbes: syscallKprobe("sendfile"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"sendfile": {},
+		},
+		Optional: true,
+	},
+	{
+		Name:    "sys_sendfile64",
+		KProbes: syscallKprobe("sendfile64"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"sendfile": {},
+		},
+	},
+	{
+		Name:    "sys_splice",
+		KProbes: syscallKprobe("splice"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"sendfile": {},
+		},
+	},
+}
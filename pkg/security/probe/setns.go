@@ -0,0 +1,21 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import "github.com/DataDog/datadog-agent/pkg/security/secl/eval"
+
+// setnsHookPoints holds the list of setns's kProbes
+var setnsHookPoints = []*HookPoint{
+	{
+		Name:    "sys_setns",
+		KProbes: syscallKprobe("setns"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"setns": {},
+		},
+	},
+}
@@ -0,0 +1,66 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import (
+	"github.com/DataDog/datadog-agent/pkg/security/ebpf"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/eval"
+)
+
+// statHookPoints holds the list of stat's kProbes. The legacy stat and lstat syscalls as well as
+// newstat and newlstat only exist on some architectures, newfstatat is available everywhere.
+var statHookPoints = []*HookPoint{
+	{
+		Name:    "sys_stat",
+		KProbes: syscallKprobe("stat"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"stat": {},
+		},
+		Optional: true,
+	},
+	{
+		Name:    "sys_lstat",
+		KProbes: syscallKprobe("lstat"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"stat": {},
+		},
+		Optional: true,
+	},
+	{
+		Name:    "sys_newstat",
+		KProbes: syscallKprobe("newstat"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"stat": {},
+		},
+		Optional: true,
+	},
+	{
+		Name:    "sys_newlstat",
+		KProbes: syscallKprobe("newlstat"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"stat": {},
+		},
+		Optional: true,
+	},
+	{
+		Name:    "sys_newfstatat",
+		KProbes: syscallKprobe("newfstatat"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"stat": {},
+		},
+	},
+	{
+		Name: "security_inode_getattr",
+		KProbes: []*ebpf.KProbe{{
+			EntryFunc: "kprobe/security_inode_getattr",
+		}},
+		EventTypes: map[eval.EventType]Capabilities{
+			"stat": {},
+		},
+	},
+}
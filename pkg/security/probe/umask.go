@@ -0,0 +1,23 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import "github.com/DataDog/datadog-agent/pkg/security/secl/eval"
+
+// umaskHookPoints holds the list of umask's kProbes. The umask events keep the umask resolver up to
+// date, the hook point is then always registered.
+var umaskHookPoints = []*HookPoint{
+	{
+		Name:    "sys_umask",
+		KProbes: syscallKprobe("umask"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"*":     {},
+			"umask": {},
+		},
+	},
+}
@@ -0,0 +1,90 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/DataDog/datadog-agent/pkg/security/utils"
+)
+
+const umaskCacheSize = 4096
+
+// UmaskResolver keeps track of the umask of the processes. It is fed by the umask events and falls back
+// to the proc fs for the processes that didn't change their umask since the probe was started. The entry
+// of a process is dropped when it exits, so that a process reusing its pid doesn't get its umask.
+type UmaskResolver struct {
+	cache *lru.Cache
+}
+
+// NewUmaskResolver returns a new umask resolver
+func NewUmaskResolver() (*UmaskResolver, error) {
+	cache, err := lru.New(umaskCacheSize)
+	if err != nil {
+		return nil, err
+	}
+	return &UmaskResolver{cache: cache}, nil
+}
+
+// SetUmask updates the umask of a process
+func (ur *UmaskResolver) SetUmask(pid uint32, umask uint32) {
+	ur.cache.Add(pid, umask)
+}
+
+// DeleteEntry drops the umask of a process, either because it exited or because its pid was given to a
+// new process
+func (ur *UmaskResolver) DeleteEntry(pid uint32) {
+	ur.cache.Remove(pid)
+}
+
+// Resolve returns the umask of a process, -1 is returned if it couldn't be resolved
+func (ur *UmaskResolver) Resolve(pid uint32) int {
+	if umask, ok := ur.cache.Get(pid); ok {
+		return int(umask.(uint32))
+	}
+
+	umask, err := readProcUmask(pid)
+	if err != nil {
+		return -1
+	}
+	ur.cache.Add(pid, umask)
+
+	return int(umask)
+}
+
+// readProcUmask reads the umask of a process from its status file, available since kernel 4.7
+func readProcUmask(pid uint32) (uint32, error) {
+	f, err := os.Open(utils.ProcStatusPath(pid))
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "Umask:") {
+			continue
+		}
+		umask, err := strconv.ParseUint(strings.TrimSpace(strings.TrimPrefix(line, "Umask:")), 8, 32)
+		if err != nil {
+			return 0, err
+		}
+		return uint32(umask), nil
+	}
+
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	return 0, os.ErrNotExist
+}
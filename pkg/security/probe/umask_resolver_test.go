@@ -0,0 +1,36 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import (
+	"os"
+	"syscall"
+	"testing"
+)
+
+func TestUmaskResolver(t *testing.T) {
+	ur, err := NewUmaskResolver()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mask := syscall.Umask(0)
+	syscall.Umask(mask)
+	pid := uint32(os.Getpid())
+
+	ur.SetUmask(pid, 0077)
+	if umask := ur.Resolve(pid); umask != 0077 {
+		t.Errorf("expected umask %o, got %o", 0077, umask)
+	}
+
+	// once the process exited, a process reusing its pid is resolved from the proc fs
+	ur.DeleteEntry(pid)
+	if umask := ur.Resolve(pid); umask != mask {
+		t.Errorf("expected umask %o, got %o", mask, umask)
+	}
+}
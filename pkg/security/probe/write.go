@@ -0,0 +1,107 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux_bpf
+
+package probe
+
+import (
+	"path"
+
+	"github.com/pkg/errors"
+
+	"github.com/DataDog/datadog-agent/pkg/security/ebpf"
+	"github.com/DataDog/datadog-agent/pkg/security/rules"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/eval"
+)
+
+// writeTables is the list of eBPF tables used by write's kProbes
+var writeTables = []string{
+	"write_policy",
+	"write_basename_approvers",
+	"write_path_inode_discarders",
+}
+
+// writeHookPoints holds the list of write's kProbes. The probe doesn't track the file descriptors:
+// the file written is resolved in vfs_write from the file the descriptor points to, its path is
+// only known once the write is done. Without approvers on write.filename or write.basename every
+// write of the host is sent to user space, the rules on write events should always have some.
+var writeHookPoints = []*HookPoint{
+	{
+		Name:    "sys_write",
+		KProbes: syscallKprobe("write"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"write": {},
+		},
+		Optional: true,
+	},
+	{
+		Name:    "sys_pwrite64",
+		KProbes: syscallKprobe("pwrite64"),
+		EventTypes: map[eval.EventType]Capabilities{
+			"write": {},
+		},
+		Optional: true,
+	},
+	{
+		Name: "vfs_write",
+		KProbes: []*ebpf.KProbe{{
+			EntryFunc: "kprobe/vfs_write",
+		}},
+		EventTypes: map[eval.EventType]Capabilities{
+			"write": {
+				"write.filename": {
+					PolicyFlags:     PolicyFlagBasename,
+					FieldValueTypes: eval.ScalarValueType,
+				},
+				"write.basename": {
+					PolicyFlags:     PolicyFlagBasename,
+					FieldValueTypes: eval.ScalarValueType,
+				},
+			},
+		},
+		PolicyTable:  "write_policy",
+		PinPath:      "write",
+		PinnedTables: writeTables,
+		OnNewApprovers: func(probe *Probe, approvers rules.Approvers) error {
+			for field, values := range approvers {
+				for _, value := range values {
+					basename := value.Value.(string)
+					switch field {
+					case "write.filename":
+						basename = path.Base(basename)
+					case "write.basename":
+					default:
+						return errors.New("field unknown")
+					}
+
+					if err := approveBasename(probe, "write_basename_approvers", basename); err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		},
+		OnNewDiscarders: func(rs *rules.RuleSet, event *Event, probe *Probe, discarder Discarder) error {
+			field := discarder.Field
+
+			switch field {
+			case "write.filename":
+				fsEvent := event.Write
+				table := "write_path_inode_discarders"
+
+				isDiscarded, err := discardParentInode(probe, rs, "write", discarder.Value.(string), fsEvent.MountID, fsEvent.Inode, table)
+				if !isDiscarded || err != nil {
+					// not able to discard the parent then only discard the filename
+					_, err = discardInode(probe, fsEvent.MountID, fsEvent.Inode, table)
+				}
+
+				return err
+			}
+			return &ErrDiscarderNotSupported{Field: field}
+		},
+	},
+}
@@ -6,7 +6,10 @@
 package rules
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/pkg/errors"
@@ -83,6 +86,26 @@ func (rs *RuleSet) ListRuleIDs() []string {
 	return ids
 }
 
+// Fingerprint returns a digest of the rules and macros of the ruleset, two rule sets with the same
+// fingerprint produce the same approvers and discarders
+func (rs *RuleSet) Fingerprint() string {
+	var definitions []string
+	for id, rule := range rs.rules {
+		definitions = append(definitions, "rule:"+id+":"+rule.Expression)
+	}
+	for id, macro := range rs.opts.Macros {
+		definitions = append(definitions, "macro:"+id+":"+macro.Expression)
+	}
+	sort.Strings(definitions)
+
+	h := sha256.New()
+	for _, definition := range definitions {
+		h.Write([]byte(definition))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
 // AddMacros parses the macros AST and adds them to the list of macros of the ruleset
 func (rs *RuleSet) AddMacros(macros []*policy.MacroDefinition) error {
 	var result *multierror.Error
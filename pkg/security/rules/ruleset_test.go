@@ -404,3 +404,22 @@ func TestRuleSetInvalidDiscarders(t *testing.T) {
 		t.Errorf("shouldn't be an invalid discarder")
 	}
 }
+
+func TestRuleSetFingerprint(t *testing.T) {
+	newRuleSet := func(exprs ...string) *RuleSet {
+		rs := NewRuleSet(&testModel{}, func() eval.Event { return &testEvent{} }, NewOptsWithParams(true, testConstants, nil))
+		addRuleExpr(t, rs, exprs...)
+		return rs
+	}
+
+	rs1 := newRuleSet(`open.filename == "/etc/passwd"`, `open.filename == "/etc/shadow"`)
+	rs2 := newRuleSet(`open.filename == "/etc/passwd"`, `open.filename == "/etc/shadow"`)
+	rs3 := newRuleSet(`open.filename == "/etc/passwd"`, `open.filename == "/etc/group"`)
+
+	if rs1.Fingerprint() != rs2.Fingerprint() {
+		t.Error("rule sets with the same rules should have the same fingerprint")
+	}
+	if rs1.Fingerprint() == rs3.Fingerprint() {
+		t.Error("rule sets with different rules should have different fingerprints")
+	}
+}
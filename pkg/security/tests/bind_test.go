@@ -0,0 +1,54 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"testing"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/DataDog/datadog-agent/pkg/security/policy"
+)
+
+func TestBind(t *testing.T) {
+	rules := []*policy.RuleDefinition{
+		{
+			ID:         "test_rule",
+			Expression: `bind.family == AF_INET && bind.addr == "127.0.0.1" && bind.port == 4242`,
+		},
+	}
+
+	test, err := newTestModule(nil, rules, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	fd, err := unix.Socket(unix.AF_INET, unix.SOCK_STREAM, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(fd)
+
+	if err := unix.Bind(fd, &unix.SockaddrInet4{Port: 4242, Addr: [4]byte{127, 0, 0, 1}}); err != nil {
+		t.Fatal(err)
+	}
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "bind" {
+			t.Errorf("expected bind event, got %s", event.GetType())
+		}
+
+		if retval := event.Bind.Retval; retval != 0 {
+			t.Errorf("expected return value 0, got %d", retval)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/security/policy"
+)
+
+func TestChdir(t *testing.T) {
+	rule := &policy.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `chdir.filename == "{{.Root}}/test-chdir"`,
+	}
+
+	test, err := newTestModule(nil, []*policy.RuleDefinition{rule}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	testFolder, testFolderPtr, err := test.Path("test-chdir")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Mkdir(testFolder, 0777); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(testFolder)
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(cwd)
+
+	if _, _, errno := syscall.Syscall(syscall.SYS_CHDIR, uintptr(testFolderPtr), 0, 0); errno != 0 {
+		t.Fatal(errno)
+	}
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "chdir" {
+			t.Errorf("expected chdir event, got %s", event.GetType())
+		}
+	}
+
+	if err := os.Chdir(cwd); err != nil {
+		t.Fatal(err)
+	}
+
+	// fchdir syscall
+	f, err := os.Open(testFolder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if _, _, errno := syscall.Syscall(syscall.SYS_FCHDIR, f.Fd(), 0, 0); errno != 0 {
+		t.Fatal(errno)
+	}
+
+	event, _, err = test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "chdir" {
+			t.Errorf("expected chdir event, got %s", event.GetType())
+		}
+	}
+}
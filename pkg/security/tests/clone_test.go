@@ -0,0 +1,54 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"os/exec"
+	"syscall"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/security/policy"
+)
+
+func TestClone(t *testing.T) {
+	rule := &policy.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `clone.flags & CLONE_NEWUTS > 0 && clone.flags & CLONE_THREAD == 0`,
+	}
+
+	test, err := newTestModule(nil, []*policy.RuleDefinition{rule}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	cmd := exec.Command("/bin/true")
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Cloneflags: syscall.CLONE_NEWUTS,
+	}
+	if err := cmd.Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "clone" {
+			t.Errorf("expected clone event, got %s", event.GetType())
+		}
+
+		if event.Clone.IsThread() {
+			t.Error("expected a process creation, got a thread creation")
+		}
+
+		if pid := event.Clone.Retval; pid != int64(cmd.Process.Pid) {
+			t.Errorf("expected pid %d, got %d", cmd.Process.Pid, pid)
+		}
+	}
+}
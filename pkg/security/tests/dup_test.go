@@ -0,0 +1,77 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/security/policy"
+)
+
+func TestDup(t *testing.T) {
+	rule := &policy.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `dup.new_fd == 123`,
+	}
+
+	test, err := newTestModule(nil, []*policy.RuleDefinition{rule}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	testFile, _, err := test.Path("test-dup")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Create(testFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(testFile)
+	defer f.Close()
+
+	if err := syscall.Dup2(int(f.Fd()), 123); err != nil {
+		t.Fatal(err)
+	}
+	defer syscall.Close(123)
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "dup" {
+			t.Errorf("expected dup event, got %s", event.GetType())
+		}
+
+		if oldFd := event.Dup.OldFd; oldFd != int32(f.Fd()) {
+			t.Errorf("expected old fd %d, got %d", f.Fd(), oldFd)
+		}
+	}
+
+	// dup3 syscall
+	if err := syscall.Close(123); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := syscall.Dup3(int(f.Fd()), 123, syscall.O_CLOEXEC); err != nil {
+		t.Fatal(err)
+	}
+
+	event, _, err = test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "dup" {
+			t.Errorf("expected dup event, got %s", event.GetType())
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"os"
+	"syscall"
+	"testing"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/DataDog/datadog-agent/pkg/security/policy"
+)
+
+func TestFallocate(t *testing.T) {
+	rule := &policy.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `fallocate.filename == "{{.Root}}/test-fallocate"`,
+	}
+
+	test, err := newTestModule(nil, []*policy.RuleDefinition{rule}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	testFile, _, err := test.Path("test-fallocate")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Create(testFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(testFile)
+	defer f.Close()
+
+	if err := syscall.Fallocate(int(f.Fd()), unix.FALLOC_FL_KEEP_SIZE, 0, 4096); err != nil {
+		if err == syscall.EOPNOTSUPP {
+			t.Skip("fallocate not supported")
+		}
+		t.Fatal(err)
+	}
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "fallocate" {
+			t.Errorf("expected fallocate event, got %s", event.GetType())
+		}
+
+		if mode := event.Fallocate.Mode; mode != unix.FALLOC_FL_KEEP_SIZE {
+			t.Errorf("expected fallocate mode %d, got %d", unix.FALLOC_FL_KEEP_SIZE, mode)
+		}
+
+		if length := event.Fallocate.Length; length != 4096 {
+			t.Errorf("expected fallocate length 4096, got %d", length)
+		}
+	}
+}
@@ -222,3 +222,68 @@ func TestOpenProcessInodeApproverFilter(t *testing.T) {
 		t.Fatalf("shouldn't get an event: %+v", event)
 	}
 }
+
+func waitForWriteEvent(test *testProbe, filename string) (*probe.Event, error) {
+	timeout := time.After(3 * time.Second)
+	exhaust := time.After(time.Second)
+
+	var event *probe.Event
+	for {
+		select {
+		case e := <-test.events:
+			if value, _ := e.GetFieldValue("write.filename"); value == filename {
+				event = e
+			}
+		case <-test.discarders:
+		case <-exhaust:
+			if event != nil {
+				return event, nil
+			}
+		case <-timeout:
+			return nil, errors.New("timeout")
+		}
+	}
+}
+
+func TestWriteBasenameApproverFilter(t *testing.T) {
+	rule := &policy.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `write.filename == "{{.Root}}/test-wba-1"`,
+	}
+
+	test, err := newTestProbe(nil, []*policy.RuleDefinition{rule}, testOpts{enableFilters: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	fd1, testFile1, err := openTestFile(test, "test-wba-1", syscall.O_CREAT|syscall.O_WRONLY)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer syscall.Close(fd1)
+	defer os.Remove(testFile1)
+
+	if _, err := syscall.Write(fd1, []byte("approved")); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := waitForWriteEvent(test, testFile1); err != nil {
+		t.Fatal(err)
+	}
+
+	fd2, testFile2, err := openTestFile(test, "test-wba-2", syscall.O_CREAT|syscall.O_WRONLY)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer syscall.Close(fd2)
+	defer os.Remove(testFile2)
+
+	if _, err := syscall.Write(fd2, []byte("not approved")); err != nil {
+		t.Fatal(err)
+	}
+
+	if event, err := waitForWriteEvent(test, testFile2); err == nil {
+		t.Fatalf("shouldn't get an event: %+v", event)
+	}
+}
@@ -0,0 +1,84 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/security/policy"
+)
+
+func TestGetXattr(t *testing.T) {
+	rule := &policy.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `getxattr.filename == "{{.Root}}/test-getxattr"`,
+	}
+
+	test, err := newTestModule(nil, []*policy.RuleDefinition{rule}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	testFile, _, err := test.Path("test-getxattr")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Create(testFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(testFile)
+	defer f.Close()
+
+	if err := syscall.Setxattr(testFile, "user.test", []byte("value"), 0); err != nil {
+		if err == syscall.ENOTSUP {
+			t.Skip("extended attributes not supported")
+		}
+		t.Fatal(err)
+	}
+
+	buf := make([]byte, 64)
+	if _, err := syscall.Getxattr(testFile, "user.test", buf); err != nil {
+		t.Fatal(err)
+	}
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "getxattr" {
+			t.Errorf("expected getxattr event, got %s", event.GetType())
+		}
+
+		if name := event.GetXattr.GetName(); name != "user.test" {
+			t.Errorf("expected getxattr name user.test, got %s", name)
+		}
+	}
+
+	// listxattr syscall
+	if _, err := syscall.Listxattr(testFile, buf); err != nil {
+		t.Fatal(err)
+	}
+
+	event, _, err = test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "getxattr" {
+			t.Errorf("expected getxattr event, got %s", event.GetType())
+		}
+
+		if name := event.GetXattr.GetName(); name != "" {
+			t.Errorf("expected empty getxattr name, got %s", name)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/DataDog/datadog-agent/pkg/security/policy"
+)
+
+func TestKeyctl(t *testing.T) {
+	rules := []*policy.RuleDefinition{
+		{
+			ID:         "test_rule",
+			Expression: `keyctl.operation == ADD_KEY && keyctl.key_type == "user" && keyctl.description == "test-keyctl"`,
+		},
+		{
+			ID:         "test_rule2",
+			Expression: fmt.Sprintf(`keyctl.operation == KEYCTL_REVOKE && process.pid == %d`, unix.Getpid()),
+		},
+	}
+
+	test, err := newTestModule(nil, rules, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	id, err := unix.AddKey("user", "test-keyctl", []byte("secret"), unix.KEY_SPEC_PROCESS_KEYRING)
+	if err != nil {
+		if err == unix.ENOSYS {
+			t.Skip("kernel keyring not supported by the kernel")
+		}
+		t.Fatal(err)
+	}
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "keyctl" {
+			t.Errorf("expected keyctl event, got %s", event.GetType())
+		}
+
+		if keyring := event.Keyctl.KeyID; keyring != unix.KEY_SPEC_PROCESS_KEYRING {
+			t.Errorf("expected keyring %d, got %d", unix.KEY_SPEC_PROCESS_KEYRING, keyring)
+		}
+
+		if retval := event.Keyctl.Retval; retval != int64(id) {
+			t.Errorf("expected key id %d, got %d", id, retval)
+		}
+	}
+
+	if _, err := unix.KeyctlInt(unix.KEYCTL_REVOKE, id, 0, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	event, _, err = test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "keyctl" {
+			t.Errorf("expected keyctl event, got %s", event.GetType())
+		}
+
+		if keyID := event.Keyctl.KeyID; keyID != int32(id) {
+			t.Errorf("expected key id %d, got %d", id, keyID)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"syscall"
+	"testing"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/DataDog/datadog-agent/pkg/security/policy"
+)
+
+func TestMemfd(t *testing.T) {
+	rule := &policy.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `memfd.name == "test-memfd"`,
+	}
+
+	test, err := newTestModule(nil, []*policy.RuleDefinition{rule}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	fd, err := unix.MemfdCreate("test-memfd", unix.MFD_CLOEXEC)
+	if err != nil {
+		if err == syscall.ENOSYS {
+			t.Skip("memfd_create not supported")
+		}
+		t.Fatal(err)
+	}
+	defer syscall.Close(fd)
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "memfd" {
+			t.Errorf("expected memfd event, got %s", event.GetType())
+		}
+
+		if flags := event.Memfd.Flags; flags != unix.MFD_CLOEXEC {
+			t.Errorf("expected memfd flags %d, got %d", unix.MFD_CLOEXEC, flags)
+		}
+
+		if retval := event.Memfd.Retval; retval != int64(fd) {
+			t.Errorf("expected memfd retval %d, got %d", fd, retval)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/DataDog/datadog-agent/pkg/security/policy"
+)
+
+func TestLoadModule(t *testing.T) {
+	rule := &policy.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `load_module.filename == "{{.Root}}/test-module.ko" && load_module.loaded_from_memory == false`,
+	}
+
+	test, err := newTestModule(nil, []*policy.RuleDefinition{rule}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	testFile, _, err := test.Path("test-module.ko")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// not a valid module, the kernel reads it and then rejects it
+	if err := ioutil.WriteFile(testFile, []byte("not a kernel module"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(testFile)
+
+	f, err := os.Open(testFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if err := unix.FinitModule(int(f.Fd()), "", 0); err == nil {
+		t.Fatal("expected the module to be rejected")
+	}
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "load_module" {
+			t.Errorf("expected load_module event, got %s", event.GetType())
+		}
+
+		if event.LoadModule.Retval >= 0 {
+			t.Errorf("expected an error retval, got %d", event.LoadModule.Retval)
+		}
+	}
+}
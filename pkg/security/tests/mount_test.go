@@ -8,6 +8,7 @@
 package tests
 
 import (
+	"fmt"
 	"os"
 	"strings"
 	"syscall"
@@ -64,9 +65,38 @@ func TestMount(t *testing.T) {
 		if fs := event.Mount.FSType; fs != "bind" {
 			t.Errorf("expected a bind mount, got %v", fs)
 		}
+		if event.Mount.Flags&syscall.MS_BIND == 0 {
+			t.Errorf("expected MS_BIND in mount flags, got %v", event.Mount.Flags)
+		}
 		mntID = event.Mount.NewMountID
 	}
 
+	// Test remount
+	if err := syscall.Mount("", dstMntPath, "", syscall.MS_REMOUNT|syscall.MS_BIND|syscall.MS_RDONLY, ""); err != nil {
+		t.Fatalf("could not remount test-dest-mount: %s", err)
+	}
+
+	event, err = test.GetEvent(3 * time.Second)
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "mount" {
+			t.Errorf("expected mount event, got %s", event.GetType())
+		}
+
+		if !event.Mount.IsRemount() {
+			t.Errorf("expected MS_REMOUNT in mount flags, got %v", event.Mount.Flags)
+		}
+
+		if event.Mount.Flags&syscall.MS_RDONLY == 0 {
+			t.Errorf("expected MS_RDONLY in mount flags, got %v", event.Mount.Flags)
+		}
+
+		if rMntID := event.Mount.NewMountID; rMntID != mntID {
+			t.Errorf("expected mount_id %v, got %v", mntID, rMntID)
+		}
+	}
+
 	// Test umount
 	if err := syscall.Unmount(dstMntPath, syscall.MNT_DETACH); err != nil {
 		t.Fatalf("could not unmount test-mount: %s", err)
@@ -83,5 +113,55 @@ func TestMount(t *testing.T) {
 		if uMntID := event.Umount.MountID; uMntID != mntID {
 			t.Errorf("expected mount_id %v, got %v", mntID, uMntID)
 		}
+
+		if flags := event.Umount.Flags; flags != syscall.MNT_DETACH {
+			t.Errorf("expected umount flags %v, got %v", syscall.MNT_DETACH, flags)
+		}
+	}
+}
+
+func TestMountOverlay(t *testing.T) {
+	rule := &policy.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `mount.fs_type == "overlay"`,
+	}
+
+	test, err := newTestProbe(nil, []*policy.RuleDefinition{rule}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	var dirs []string
+	for _, name := range []string{"lower", "upper", "work", "merged"} {
+		dir, _, err := test.Path("test-overlay-" + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		os.MkdirAll(dir, 0755)
+		dirs = append(dirs, dir)
+	}
+
+	options := fmt.Sprintf("lowerdir=%s,upperdir=%s,workdir=%s", dirs[0], dirs[1], dirs[2])
+	if err := syscall.Mount("overlay", dirs[3], "overlay", 0, options); err != nil {
+		t.Fatalf("could not create overlay mount: %s", err)
+	}
+	defer syscall.Unmount(dirs[3], syscall.MNT_DETACH)
+
+	event, err := test.GetEvent(3 * time.Second)
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "mount" {
+			t.Errorf("expected mount event, got %s", event.GetType())
+		}
+
+		if fs := event.Mount.FSType; fs != "overlay" {
+			t.Errorf("expected an overlay mount, got %v", fs)
+		}
+
+		if event.Mount.Flags&(syscall.MS_BIND|syscall.MS_REMOUNT) != 0 {
+			t.Errorf("expected neither MS_BIND nor MS_REMOUNT in mount flags, got %v", event.Mount.Flags)
+		}
 	}
 }
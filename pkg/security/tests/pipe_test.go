@@ -0,0 +1,52 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"syscall"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/security/policy"
+)
+
+func TestPipe(t *testing.T) {
+	rule := &policy.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `pipe.flags & O_CLOEXEC > 0`,
+	}
+
+	test, err := newTestModule(nil, []*policy.RuleDefinition{rule}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	var fds [2]int
+	if err := syscall.Pipe2(fds[:], syscall.O_CLOEXEC); err != nil {
+		t.Fatal(err)
+	}
+	defer syscall.Close(fds[0])
+	defer syscall.Close(fds[1])
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "pipe" {
+			t.Errorf("expected pipe event, got %s", event.GetType())
+		}
+
+		if readFd := event.Pipe.ReadFd; readFd != int32(fds[0]) {
+			t.Errorf("expected read fd %d, got %d", fds[0], readFd)
+		}
+
+		if writeFd := event.Pipe.WriteFd; writeFd != int32(fds[1]) {
+			t.Errorf("expected write fd %d, got %d", fds[1], writeFd)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/DataDog/datadog-agent/pkg/security/policy"
+)
+
+func TestPrctl(t *testing.T) {
+	rules := []*policy.RuleDefinition{
+		{
+			ID:         "test_rule",
+			Expression: `prctl.option == PR_SET_NAME && prctl.name == "test-prctl"`,
+		},
+	}
+
+	test, err := newTestModule(nil, rules, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	// PR_SET_NAME renames the calling thread, keep the goroutine on it to restore its name
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	var prevName [16]byte
+	if err := unix.Prctl(unix.PR_GET_NAME, uintptr(unsafe.Pointer(&prevName[0])), 0, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Prctl(unix.PR_SET_NAME, uintptr(unsafe.Pointer(&prevName[0])), 0, 0, 0)
+
+	name, err := unix.BytePtrFromString("test-prctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := unix.Prctl(unix.PR_SET_NAME, uintptr(unsafe.Pointer(name)), 0, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "prctl" {
+			t.Errorf("expected prctl event, got %s", event.GetType())
+		}
+
+		if comm := event.Process.GetComm(); comm != "test-prctl" {
+			t.Errorf("expected process name test-prctl, got %s", comm)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"syscall"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/security/policy"
+)
+
+const (
+	qSync    = 0x800001
+	usrQuota = 0
+)
+
+func TestQuotactl(t *testing.T) {
+	rule := &policy.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `quotactl.cmd == Q_SYNC && quotactl.type == USRQUOTA`,
+	}
+
+	test, err := newTestModule(nil, []*policy.RuleDefinition{rule}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	// syncing the quotas of all the filesystems doesn't require a block device
+	if _, _, errno := syscall.Syscall6(syscall.SYS_QUOTACTL, qSync<<8|usrQuota, 0, 0, 0, 0, 0); errno != 0 {
+		if errno == syscall.ENOSYS {
+			t.Skip("quotactl not supported by the kernel")
+		}
+		t.Fatal(errno)
+	}
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "quotactl" {
+			t.Errorf("expected quotactl event, got %s", event.GetType())
+		}
+
+		if special := event.Quotactl.GetSpecial(); special != "" {
+			t.Errorf("expected no special file, got %s", special)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/DataDog/datadog-agent/pkg/security/policy"
+)
+
+func TestSendfile(t *testing.T) {
+	rule := &policy.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `sendfile.count == 4242`,
+	}
+
+	test, err := newTestModule(nil, []*policy.RuleDefinition{rule}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	inFile, _, err := test.Path("test-sendfile-in")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(inFile, make([]byte, 4242), 0644); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(inFile)
+
+	outFile, _, err := test.Path("test-sendfile-out")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	in, err := os.Open(inFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer in.Close()
+
+	out, err := os.Create(outFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer out.Close()
+	defer os.Remove(outFile)
+
+	// sendfile syscall
+	if _, err := unix.Sendfile(int(out.Fd()), int(in.Fd()), nil, 4242); err != nil {
+		t.Fatal(err)
+	}
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "sendfile" {
+			t.Errorf("expected sendfile event, got %s", event.GetType())
+		}
+
+		if fd := event.Sendfile.InFd; fd != int32(in.Fd()) {
+			t.Errorf("expected in fd %d, got %d", in.Fd(), fd)
+		}
+
+		if fd := event.Sendfile.OutFd; fd != int32(out.Fd()) {
+			t.Errorf("expected out fd %d, got %d", out.Fd(), fd)
+		}
+
+		if retval := event.Sendfile.Retval; retval != 4242 {
+			t.Errorf("expected retval 4242, got %d", retval)
+		}
+	}
+
+	// splice syscall, one of the file descriptors has to be a pipe
+	var fds [2]int
+	if err := unix.Pipe(fds[:]); err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(fds[0])
+	defer unix.Close(fds[1])
+
+	if _, err := unix.Write(fds[1], make([]byte, 4242)); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := unix.Splice(fds[0], nil, int(out.Fd()), nil, 4242, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	event, _, err = test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "sendfile" {
+			t.Errorf("expected sendfile event, got %s", event.GetType())
+		}
+
+		if fd := event.Sendfile.InFd; fd != int32(fds[0]) {
+			t.Errorf("expected in fd %d, got %d", fds[0], fd)
+		}
+	}
+}
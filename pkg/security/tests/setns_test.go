@@ -0,0 +1,59 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"testing"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/DataDog/datadog-agent/pkg/security/policy"
+)
+
+func TestSetns(t *testing.T) {
+	rule := &policy.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `setns.nstype == CLONE_NEWUTS`,
+	}
+
+	test, err := newTestModule(nil, []*policy.RuleDefinition{rule}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	// joining the current namespace of the thread is enough to trigger the syscall
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	f, err := os.Open(fmt.Sprintf("/proc/self/task/%d/ns/uts", unix.Gettid()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if err := unix.Setns(int(f.Fd()), unix.CLONE_NEWUTS); err != nil {
+		t.Fatal(err)
+	}
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "setns" {
+			t.Errorf("expected setns event, got %s", event.GetType())
+		}
+
+		if fd := event.Setns.Fd; fd != int32(f.Fd()) {
+			t.Errorf("expected fd %d, got %d", f.Fd(), fd)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"os"
+	"syscall"
+	"testing"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/DataDog/datadog-agent/pkg/security/policy"
+)
+
+func TestSetrlimit(t *testing.T) {
+	rule := &policy.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `setrlimit.resource == RLIMIT_CORE && setrlimit.soft == 0`,
+	}
+
+	test, err := newTestModule(nil, []*policy.RuleDefinition{rule}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	var current unix.Rlimit
+	if err := unix.Getrlimit(unix.RLIMIT_CORE, &current); err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Setrlimit(unix.RLIMIT_CORE, &current)
+
+	limit := unix.Rlimit{Cur: 0, Max: current.Max}
+	if _, _, errno := syscall.Syscall(syscall.SYS_SETRLIMIT, unix.RLIMIT_CORE, uintptr(unsafe.Pointer(&limit)), 0); errno != 0 {
+		t.Fatal(errno)
+	}
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "setrlimit" {
+			t.Errorf("expected setrlimit event, got %s", event.GetType())
+		}
+
+		if pid := event.Setrlimit.Pid; pid != uint32(os.Getpid()) {
+			t.Errorf("expected pid %d, got %d", os.Getpid(), pid)
+		}
+	}
+
+	// prlimit64 syscall
+	if err := unix.Prlimit(0, unix.RLIMIT_CORE, &limit, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	event, _, err = test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "setrlimit" {
+			t.Errorf("expected setrlimit event, got %s", event.GetType())
+		}
+
+		if hard := event.Setrlimit.Hard; hard != int64(current.Max) {
+			t.Errorf("expected hard limit %d, got %d", int64(current.Max), hard)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"os"
+	"syscall"
+	"testing"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/DataDog/datadog-agent/pkg/security/policy"
+)
+
+func TestStat(t *testing.T) {
+	rule := &policy.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `stat.filename == "{{.Root}}/test-stat"`,
+	}
+
+	test, err := newTestModule(nil, []*policy.RuleDefinition{rule}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	testFile, testFilePtr, err := test.Path("test-stat")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Create(testFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	defer os.Remove(testFile)
+
+	var stat syscall.Stat_t
+	if _, _, errno := syscall.Syscall(syscall.SYS_STAT, uintptr(testFilePtr), uintptr(unsafe.Pointer(&stat)), 0); errno != 0 {
+		t.Fatal(errno)
+	}
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "stat" {
+			t.Errorf("expected stat event, got %s", event.GetType())
+		}
+
+		if inode := event.Stat.Inode; inode != stat.Ino {
+			t.Errorf("expected inode %d, got %d", stat.Ino, inode)
+		}
+	}
+
+	// lstat syscall
+	if _, _, errno := syscall.Syscall(syscall.SYS_LSTAT, uintptr(testFilePtr), uintptr(unsafe.Pointer(&stat)), 0); errno != 0 {
+		t.Fatal(errno)
+	}
+
+	event, _, err = test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "stat" {
+			t.Errorf("expected stat event, got %s", event.GetType())
+		}
+	}
+
+	// newfstatat syscall
+	var fstat unix.Stat_t
+	if err := unix.Fstatat(unix.AT_FDCWD, testFile, &fstat, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	event, _, err = test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "stat" {
+			t.Errorf("expected stat event, got %s", event.GetType())
+		}
+	}
+}
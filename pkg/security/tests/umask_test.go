@@ -0,0 +1,48 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"syscall"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/security/policy"
+)
+
+func TestUmask(t *testing.T) {
+	rule := &policy.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `umask.mask == 63`, // 0077
+	}
+
+	test, err := newTestModule(nil, []*policy.RuleDefinition{rule}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	old := syscall.Umask(0077)
+	defer syscall.Umask(old)
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "umask" {
+			t.Errorf("expected umask event, got %s", event.GetType())
+		}
+
+		if mask := event.Umask.Mask; mask != 0077 {
+			t.Errorf("expected mask %#o, got %#o", 0077, mask)
+		}
+
+		if retval := event.Umask.Retval; retval != int64(old) {
+			t.Errorf("expected retval %#o, got %#o", old, retval)
+		}
+	}
+}
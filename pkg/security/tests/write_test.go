@@ -0,0 +1,90 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/security/policy"
+)
+
+func TestWrite(t *testing.T) {
+	rule := &policy.RuleDefinition{
+		ID:         "test_rule",
+		Expression: `write.filename == "{{.Root}}/test-write"`,
+	}
+
+	test, err := newTestModule(nil, []*policy.RuleDefinition{rule}, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	testFile, _, err := test.Path("test-write")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Create(testFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	defer os.Remove(testFile)
+
+	var stat syscall.Stat_t
+	if err := syscall.Stat(testFile, &stat); err != nil {
+		t.Fatal(err)
+	}
+
+	// write syscall
+	if _, err := syscall.Write(int(f.Fd()), []byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+
+	event, _, err := test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "write" {
+			t.Errorf("expected write event, got %s", event.GetType())
+		}
+
+		if inode := event.Write.Inode; inode != stat.Ino {
+			t.Errorf("expected inode %d, got %d", stat.Ino, inode)
+		}
+
+		if count := event.Write.Count; count != 5 {
+			t.Errorf("expected count 5, got %d", count)
+		}
+
+		if retval := event.Write.Retval; retval != 5 {
+			t.Errorf("expected retval 5, got %d", retval)
+		}
+	}
+
+	// pwrite64 syscall
+	if _, err := syscall.Pwrite(int(f.Fd()), []byte("hi"), 10); err != nil {
+		t.Fatal(err)
+	}
+
+	event, _, err = test.GetEvent()
+	if err != nil {
+		t.Error(err)
+	} else {
+		if event.GetType() != "write" {
+			t.Errorf("expected write event, got %s", event.GetType())
+		}
+
+		if count := event.Write.Count; count != 2 {
+			t.Errorf("expected count 2, got %d", count)
+		}
+	}
+}
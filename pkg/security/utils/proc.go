@@ -33,3 +33,18 @@ func CgroupTaskPath(tgid, pid uint32) string {
 func ProcExePath(pid uint32) string {
 	return filepath.Join(util.HostProc(), fmt.Sprintf("%d/exe", pid))
 }
+
+// ProcCwdPath returns the path to the cwd link of a pid in /proc
+func ProcCwdPath(pid uint32) string {
+	return filepath.Join(util.HostProc(), fmt.Sprintf("%d/cwd", pid))
+}
+
+// ProcCmdlinePath returns the path to the cmdline file of a pid in /proc
+func ProcCmdlinePath(pid uint32) string {
+	return filepath.Join(util.HostProc(), fmt.Sprintf("%d/cmdline", pid))
+}
+
+// ProcStatusPath returns the path to the status file of a pid in /proc
+func ProcStatusPath(pid uint32) string {
+	return filepath.Join(util.HostProc(), fmt.Sprintf("%d/status", pid))
+}
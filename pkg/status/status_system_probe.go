@@ -35,3 +35,23 @@ func GetSystemProbeStats(socketPath string) map[string]interface{} {
 
 	return systemProbeDetails
 }
+
+// GetSystemProbeTracerInfo returns everything the network tracer of the system probe knows, for troubleshooting
+func GetSystemProbeTracerInfo(socketPath string) map[string]interface{} {
+	net.SetSystemProbePath(socketPath)
+	probeUtil, err := net.GetRemoteSystemProbeUtil()
+	if err != nil {
+		return map[string]interface{}{
+			"Errors": fmt.Sprintf("%v", err),
+		}
+	}
+
+	info, err := probeUtil.GetTracerInfo()
+	if err != nil {
+		return map[string]interface{}{
+			"Errors": fmt.Sprintf("issue querying tracer info from system probe: %v", err),
+		}
+	}
+
+	return info
+}
@@ -0,0 +1,28 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux
+
+package ec2
+
+import (
+	"bytes"
+
+	"golang.org/x/sys/unix"
+)
+
+// hostArchitecture returns the machine hardware name reported by the kernel, which unlike runtime.GOARCH
+// isn't the architecture the agent was built for (a 386 agent can run on a x86_64 host)
+func hostArchitecture() (string, error) {
+	var uname unix.Utsname
+	if err := unix.Uname(&uname); err != nil {
+		return "", err
+	}
+	machine := uname.Machine[:]
+	if i := bytes.IndexByte(machine, 0); i >= 0 {
+		machine = machine[:i]
+	}
+	return string(machine), nil
+}
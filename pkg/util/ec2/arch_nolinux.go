@@ -0,0 +1,16 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build !linux
+
+package ec2
+
+import "runtime"
+
+// hostArchitecture returns the architecture the agent was built for, the host architecture is only read
+// from the kernel on Linux
+func hostArchitecture() (string, error) {
+	return runtime.GOARCH, nil
+}
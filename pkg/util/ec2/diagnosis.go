@@ -6,6 +6,7 @@
 package ec2
 
 import (
+	"github.com/DataDog/datadog-agent/pkg/config"
 	"github.com/DataDog/datadog-agent/pkg/diagnose/diagnosis"
 	"github.com/DataDog/datadog-agent/pkg/util/log"
 )
@@ -20,5 +21,17 @@ func diagnose() error {
 	if err != nil {
 		log.Error(err)
 	}
+
+	if config.Datadog.GetBool("ec2_prefer_imdsv2") {
+		if valid, expiresIn := TokenStatus(); valid {
+			log.Infof("IMDSv2 token is valid, expires in %s", expiresIn)
+		} else {
+			log.Warn("No valid IMDSv2 token is cached")
+		}
+	}
+
+	for endpoint, latency := range LastRequestLatencies() {
+		log.Infof("Last request to EC2 metadata endpoint %s took %s", endpoint, latency)
+	}
 	return err
 }
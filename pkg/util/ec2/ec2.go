@@ -6,10 +6,15 @@
 package ec2
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"net/http"
+	"regexp"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -26,29 +31,85 @@ type ec2Token struct {
 	sync.RWMutex
 }
 
+// notOnEC2Marker records that the connections to the metadata API were refused or had no route, so that
+// the following requests fail fast instead of each waiting for ec2_metadata_timeout
+type notOnEC2Marker struct {
+	expirationDate time.Time
+	sync.RWMutex
+}
+
+// requestLatencies records how long the last request to each metadata endpoint took
+type requestLatencies struct {
+	values map[string]time.Duration
+	sync.RWMutex
+}
+
+// ErrNotOnEC2 is returned without querying the metadata API while a previous request failed to reach it
+var ErrNotOnEC2 = errors.New("EC2 metadata API was unreachable on a previous request, the host is assumed not to run on EC2")
+
+// hostname sources selectable with ec2_hostname_source
+const (
+	hostnameSourceInstanceID = "instance-id"
+	hostnameSourcePrivateDNS = "private-dns"
+)
+
+// tokenEndpoint is the name the IMDSv2 token requests are recorded under in the request latencies
+const tokenEndpoint = "/api/token"
+
+// tokenRefreshMargin is how long before its expiration the background refresher renews the IMDSv2 token,
+// it's larger than the margin of getToken so that callers don't have to renew it themselves
+const tokenRefreshMargin = 30 * time.Second
+
 // declare these as vars not const to ease testing
 var (
-	metadataURL        = "http://169.254.169.254/latest/meta-data"
-	tokenURL           = "http://169.254.169.254/latest/api/token"
-	oldDefaultPrefixes = []string{"ip-", "domu"}
-	defaultPrefixes    = []string{"ip-", "domu", "ec2amaz-"}
-	tokenLifetime      = time.Duration(config.Datadog.GetInt("ec2_metadata_token_lifetime")) * time.Second
-	token              = ec2Token{}
+	metadataURL              = "http://169.254.169.254/latest/meta-data"
+	tokenURL                 = "http://169.254.169.254/latest/api/token"
+	instanceIdentityURL      = "http://169.254.169.254/latest/dynamic/instance-identity/document/"
+	instanceIdentityPKCS7URL = "http://169.254.169.254/latest/dynamic/instance-identity/pkcs7"
+	oldDefaultPrefixes       = []string{"ip-", "domu"}
+	defaultPrefixes          = []string{"ip-", "domu", "ec2amaz-"}
+	tokenLifetime            = time.Duration(config.Datadog.GetInt("ec2_metadata_token_lifetime")) * time.Second
+	token                    = ec2Token{}
+	// tokenRefresher tells whether the background IMDSv2 token refresher is running
+	tokenRefresher = struct {
+		sync.Mutex
+		running bool
+	}{}
+	// tokenRefreshRetryInterval is how long the background refresher waits after failing to renew the token
+	tokenRefreshRetryInterval = 30 * time.Second
+	// notOnEC2TTL is how long the metadata API is considered unreachable after a failed request
+	notOnEC2TTL = 5 * time.Minute
+	// notOnEC2 remembers until when the metadata API is considered unreachable
+	notOnEC2 = notOnEC2Marker{}
+	// lastLatencies holds the latency of the last request to each endpoint, see LastRequestLatencies
+	lastLatencies = requestLatencies{}
 	// CloudProviderName contains the inventory name of for EC2
 	CloudProviderName = "AWS"
+	// regionPrefixRegexp matches the region (e.g. us-east-1, us-gov-west-1) an availability zone name starts with
+	regionPrefixRegexp = regexp.MustCompile(`^[a-z]+(-[a-z]+)+-[0-9]+`)
 
 	// cache keys
-	instanceIDCacheKey = cache.BuildAgentKey("ec2", "GetInstanceID")
-	hostnameCacheKey   = cache.BuildAgentKey("ec2", "GetHostname")
+	instanceIDCacheKey   = cache.BuildAgentKey("ec2", "GetInstanceID")
+	hostnameCacheKey     = cache.BuildAgentKey("ec2", "GetHostname")
+	regionCacheKey       = cache.BuildAgentKey("ec2", "GetRegion")
+	zoneCacheKey         = cache.BuildAgentKey("ec2", "GetAvailabilityZone")
+	instanceTypeCacheKey = cache.BuildAgentKey("ec2", "GetInstanceType")
+	identityCacheKey     = cache.BuildAgentKey("ec2", "GetInstanceIdentityDocument")
 )
 
 // GetInstanceID fetches the instance id for current host from the EC2 metadata API
 func GetInstanceID() (string, error) {
+	return GetInstanceIDWithContext(context.Background())
+}
+
+// GetInstanceIDWithContext fetches the instance id for current host from the EC2 metadata API, the
+// requests are aborted when ctx is done
+func GetInstanceIDWithContext(ctx context.Context) (string, error) {
 	if !config.IsCloudProviderEnabled(CloudProviderName) {
 		return "", fmt.Errorf("cloud provider is disabled by configuration")
 	}
 
-	instanceID, err := getMetadataItemWithMaxLength("/instance-id", config.Datadog.GetInt("metadata_endpoints_max_hostname_size"))
+	instanceID, err := getMetadataItemWithMaxLengthWithContext(ctx, "/instance-id", config.Datadog.GetInt("metadata_endpoints_max_hostname_size"))
 	if err != nil {
 		if instanceID, found := cache.Cache.Get(instanceIDCacheKey); found {
 			log.Debugf("Unable to get ec2 instanceID from aws metadata, returning cached instanceID '%s': %s", instanceID, err)
@@ -57,12 +118,21 @@ func GetInstanceID() (string, error) {
 		return "", err
 	}
 
+	if err := verifyIMDSIfEnabled(); err != nil {
+		return "", err
+	}
+
+	if err := verifyInstanceIdentityIfEnabled(ctx, instanceID); err != nil {
+		return "", err
+	}
+
 	cache.Cache.Set(instanceIDCacheKey, instanceID, cache.NoExpiration)
 
 	return instanceID, nil
 }
 
-// GetLocalIPv4 gets the local IPv4 for the currently running host using the EC2 metadata API.
+// GetLocalIPv4 gets the local IPv4 addresses for the currently running host using the EC2 metadata API.
+// The primary private IP comes first, followed by the private IPs of all the network interfaces.
 // Returns a []string to implement the HostIPProvider interface expected in pkg/process/util
 func GetLocalIPv4() ([]string, error) {
 	if !config.IsCloudProviderEnabled(CloudProviderName) {
@@ -72,7 +142,66 @@ func GetLocalIPv4() ([]string, error) {
 	if err != nil {
 		return nil, err
 	}
-	return []string{ip}, nil
+
+	ips := []string{strings.TrimSpace(ip)}
+	seen := map[string]bool{ips[0]: true}
+
+	macs, err := getMACAddresses()
+	if err != nil {
+		log.Debugf("unable to list the EC2 network interfaces, only reporting the primary IP: %s", err)
+		return ips, nil
+	}
+
+	for _, mac := range macs {
+		interfaceIPs, err := getMetadataList(fmt.Sprintf("/network/interfaces/macs/%s/local-ipv4s", mac))
+		if err != nil {
+			log.Debugf("unable to fetch the local IPs of the EC2 network interface %s: %s", mac, err)
+			continue
+		}
+		for _, interfaceIP := range interfaceIPs {
+			if !seen[interfaceIP] {
+				seen[interfaceIP] = true
+				ips = append(ips, interfaceIP)
+			}
+		}
+	}
+
+	return ips, nil
+}
+
+// GetLocalIPv6 gets the IPv6 addresses of all the network interfaces of the currently running host
+// using the EC2 metadata API. An empty list is returned when no interface has an IPv6 address.
+// Returns a []string to implement the HostIPProvider interface expected in pkg/process/util
+func GetLocalIPv6() ([]string, error) {
+	if !config.IsCloudProviderEnabled(CloudProviderName) {
+		return nil, fmt.Errorf("cloud provider is disabled by configuration")
+	}
+
+	macs, err := getMACAddresses()
+	if err != nil {
+		return nil, err
+	}
+
+	ips := []string{}
+	seen := map[string]bool{}
+	for _, mac := range macs {
+		interfaceIPs, err := getMetadataList(fmt.Sprintf("/network/interfaces/macs/%s/ipv6s", mac))
+		if err != nil {
+			// the endpoint doesn't exist for interfaces without IPv6 address
+			if !isNotFound(err) {
+				log.Debugf("unable to fetch the IPv6 addresses of the EC2 network interface %s: %s", mac, err)
+			}
+			continue
+		}
+		for _, interfaceIP := range interfaceIPs {
+			if !seen[interfaceIP] {
+				seen[interfaceIP] = true
+				ips = append(ips, interfaceIP)
+			}
+		}
+	}
+
+	return ips, nil
 }
 
 // IsRunningOn returns true if the agent is running on AWS
@@ -85,11 +214,17 @@ func IsRunningOn() bool {
 
 // GetHostname fetches the hostname for current host from the EC2 metadata API
 func GetHostname() (string, error) {
+	return GetHostnameWithContext(context.Background())
+}
+
+// GetHostnameWithContext fetches the hostname for current host from the EC2 metadata API, the requests
+// are aborted when ctx is done
+func GetHostnameWithContext(ctx context.Context) (string, error) {
 	if !config.IsCloudProviderEnabled(CloudProviderName) {
 		return "", fmt.Errorf("cloud provider is disabled by configuration")
 	}
 
-	hostname, err := getMetadataItemWithMaxLength("/hostname", config.Datadog.GetInt("metadata_endpoints_max_hostname_size"))
+	hostname, err := getMetadataItemWithMaxLengthWithContext(ctx, "/hostname", config.Datadog.GetInt("metadata_endpoints_max_hostname_size"))
 	if err != nil {
 		if hostname, found := cache.Cache.Get(hostnameCacheKey); found {
 			log.Debugf("Unable to get ec2 hostname from aws metadata, returning cached hostname '%s': %s", hostname, err)
@@ -98,67 +233,359 @@ func GetHostname() (string, error) {
 		return "", err
 	}
 
+	if err := verifyIMDSIfEnabled(); err != nil {
+		return "", err
+	}
+
 	cache.Cache.Set(hostnameCacheKey, hostname, cache.NoExpiration)
 
 	return hostname, nil
 }
 
+// IsOutpost returns whether the current host runs on AWS Outposts capacity,
+// detected from its availability zone ID. Hosts without an availability zone
+// ID are not considered to be on an Outpost.
+func IsOutpost() (bool, error) {
+	if !config.IsCloudProviderEnabled(CloudProviderName) {
+		return false, fmt.Errorf("cloud provider is disabled by configuration")
+	}
+
+	zoneID, err := getMetadataItem("/placement/availability-zone-id")
+	if err != nil {
+		if isNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return strings.HasPrefix(strings.TrimSpace(zoneID), "op-"), nil
+}
+
+// GetRegion returns the region of the current instance. When the metadata API
+// doesn't expose the region, it's derived from the availability zone. The last
+// region fetched is returned when the metadata API can't be queried.
+func GetRegion() (string, error) {
+	if !config.IsCloudProviderEnabled(CloudProviderName) {
+		return "", fmt.Errorf("cloud provider is disabled by configuration")
+	}
+
+	region, err := fetchRegion()
+	if err != nil {
+		if region, found := cache.Cache.Get(regionCacheKey); found {
+			log.Debugf("Unable to get ec2 region from aws metadata, returning cached region '%s': %s", region, err)
+			return region.(string), nil
+		}
+		return "", err
+	}
+
+	cache.Cache.Set(regionCacheKey, region, cache.NoExpiration)
+
+	return region, nil
+}
+
+func fetchRegion() (string, error) {
+	region, err := getMetadataItem("/placement/region")
+	if err == nil && strings.TrimSpace(region) != "" {
+		return strings.TrimSpace(region), nil
+	}
+	// older metadata API versions don't expose the region, other errors are not worth another request
+	if err != nil && !isNotFound(err) {
+		return "", err
+	}
+	log.Debugf("unable to get ec2 region from aws metadata, deriving it from the availability zone: %v", err)
+
+	zone, err := getMetadataItem("/placement/availability-zone")
+	if err != nil {
+		return "", err
+	}
+	return regionFromAvailabilityZone(strings.TrimSpace(zone))
+}
+
+// GetAvailabilityZone returns the availability zone of the current instance. The
+// last availability zone fetched is returned when the metadata API can't be
+// queried.
+func GetAvailabilityZone() (string, error) {
+	if !config.IsCloudProviderEnabled(CloudProviderName) {
+		return "", fmt.Errorf("cloud provider is disabled by configuration")
+	}
+
+	zone, err := getMetadataItem("/placement/availability-zone")
+	if err != nil {
+		if zone, found := cache.Cache.Get(zoneCacheKey); found {
+			log.Debugf("Unable to get ec2 availability zone from aws metadata, returning cached availability zone '%s': %s", zone, err)
+			return zone.(string), nil
+		}
+		return "", err
+	}
+
+	zone = strings.TrimSpace(zone)
+	cache.Cache.Set(zoneCacheKey, zone, cache.NoExpiration)
+
+	return zone, nil
+}
+
+// GetInstanceType returns the instance type of the current instance, such as
+// m5.large. The last instance type fetched is returned when the metadata API
+// can't be queried.
+func GetInstanceType() (string, error) {
+	if !config.IsCloudProviderEnabled(CloudProviderName) {
+		return "", fmt.Errorf("cloud provider is disabled by configuration")
+	}
+
+	instanceType, err := fetchInstanceType()
+	if err != nil {
+		if instanceType, found := cache.Cache.Get(instanceTypeCacheKey); found {
+			log.Debugf("Unable to get ec2 instance type from aws metadata, returning cached instance type '%s': %s", instanceType, err)
+			return instanceType.(string), nil
+		}
+		return "", err
+	}
+
+	cache.Cache.Set(instanceTypeCacheKey, instanceType, cache.NoExpiration)
+
+	return instanceType, nil
+}
+
+func fetchInstanceType() (string, error) {
+	instanceType, err := getMetadataItem("/instance-type")
+	if err != nil {
+		return "", err
+	}
+	// an empty value comes from a partial read, it's not worth caching
+	instanceType = strings.TrimSpace(instanceType)
+	if instanceType == "" {
+		return "", fmt.Errorf("empty instance type returned by the metadata API")
+	}
+	return instanceType, nil
+}
+
+// regionFromAvailabilityZone extracts the region from an availability zone name.
+// Besides regional zones (us-east-1a), it handles Local Zones (us-east-1-bos-1a)
+// and Wavelength Zones (us-east-1-wl1-bos-wlz-1) whose names extend the region.
+func regionFromAvailabilityZone(zone string) (string, error) {
+	region := regionPrefixRegexp.FindString(zone)
+	if region == "" {
+		return "", fmt.Errorf("unable to extract region from availability zone %q", zone)
+	}
+	return region, nil
+}
+
+// GetArchitecture returns the CPU architecture of the current instance, named
+// after the EC2 API architectures (i386, x86_64, arm64). The metadata API
+// doesn't expose it, so it's read from the kernel of the host.
+func GetArchitecture() (string, error) {
+	if !config.IsCloudProviderEnabled(CloudProviderName) {
+		return "", fmt.Errorf("cloud provider is disabled by configuration")
+	}
+	arch, err := hostArchitecture()
+	if err != nil {
+		return "", fmt.Errorf("unable to get the host architecture: %s", err)
+	}
+	return normalizeArchitecture(arch)
+}
+
+// normalizeArchitecture converts a machine hardware name (uname -m) or a Go
+// architecture to the corresponding EC2 API architecture
+func normalizeArchitecture(arch string) (string, error) {
+	switch arch {
+	case "386", "i386", "i686":
+		return "i386", nil
+	case "amd64", "x86_64":
+		return "x86_64", nil
+	case "arm64", "aarch64":
+		return "arm64", nil
+	default:
+		return "", fmt.Errorf("unsupported EC2 architecture %s", arch)
+	}
+}
+
 // GetNetworkID retrieves the network ID using the EC2 metadata endpoint. For
 // EC2 instances, the the network ID is the VPC ID, if the instance is found to
 // be a part of exactly one VPC.
 func GetNetworkID() (string, error) {
+	vpcIDs, err := GetNetworkIDs()
+	if err != nil {
+		return "", err
+	}
+
+	switch len(vpcIDs) {
+	case 0:
+		return "", fmt.Errorf("EC2: GetNetworkID no mac addresses returned")
+	case 1:
+		return vpcIDs[0], nil
+	default:
+		return "", fmt.Errorf("EC2: GetNetworkID too many mac addresses returned")
+	}
+}
+
+// GetNetworkIDs retrieves the IDs of all the VPCs the network interfaces of the
+// current host are attached to, sorted
+func GetNetworkIDs() ([]string, error) {
 	if !config.IsCloudProviderEnabled(CloudProviderName) {
-		return "", fmt.Errorf("cloud provider is disabled by configuration")
+		return nil, fmt.Errorf("cloud provider is disabled by configuration")
 	}
-	resp, err := getMetadataItem("/network/interfaces/macs")
+	macs, err := getMACAddresses()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	macs := strings.Split(strings.TrimSpace(resp), "\n")
 	vpcIDs := common.NewStringSet()
 
 	for _, mac := range macs {
-		if mac == "" {
-			continue
-		}
-		mac = strings.TrimSuffix(mac, "/")
 		id, err := getMetadataItem(fmt.Sprintf("/network/interfaces/macs/%s/vpc-id", mac))
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 		vpcIDs.Add(id)
 	}
 
-	switch len(vpcIDs) {
-	case 0:
-		return "", fmt.Errorf("EC2: GetNetworkID no mac addresses returned")
-	case 1:
-		return vpcIDs.GetAll()[0], nil
+	ids := vpcIDs.GetAll()
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// GetSubnetID retrieves the ID of the subnet the network interfaces of the
+// current host are attached to. When they are in several subnets, the first
+// one in alphabetical order is returned if ec2_allow_multiple_subnets is set.
+func GetSubnetID() (string, error) {
+	if !config.IsCloudProviderEnabled(CloudProviderName) {
+		return "", fmt.Errorf("cloud provider is disabled by configuration")
+	}
+	macs, err := getMACAddresses()
+	if err != nil {
+		return "", err
+	}
+
+	subnetIDs := common.NewStringSet()
+
+	for _, mac := range macs {
+		id, err := getMetadataItem(fmt.Sprintf("/network/interfaces/macs/%s/subnet-id", mac))
+		if err != nil {
+			return "", err
+		}
+		subnetIDs.Add(strings.TrimSpace(id))
+	}
+
+	ids := subnetIDs.GetAll()
+	sort.Strings(ids)
+
+	switch {
+	case len(ids) == 0:
+		return "", fmt.Errorf("EC2: GetSubnetID no mac addresses returned")
+	case len(ids) > 1 && !config.Datadog.GetBool("ec2_allow_multiple_subnets"):
+		return "", fmt.Errorf("EC2: GetSubnetID the network interfaces are in several subnets: %s", strings.Join(ids, ", "))
 	default:
-		return "", fmt.Errorf("EC2: GetNetworkID too many mac addresses returned")
+		return ids[0], nil
+	}
+}
+
+// GetSecurityGroups retrieves the IDs of the security groups of all the network
+// interfaces of the current host, sorted
+func GetSecurityGroups() ([]string, error) {
+	if !config.IsCloudProviderEnabled(CloudProviderName) {
+		return nil, fmt.Errorf("cloud provider is disabled by configuration")
+	}
+	macs, err := getMACAddresses()
+	if err != nil {
+		return nil, err
+	}
+
+	groupIDs := common.NewStringSet()
+
+	for _, mac := range macs {
+		ids, err := getMetadataList(fmt.Sprintf("/network/interfaces/macs/%s/security-group-ids", mac))
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			groupIDs.Add(id)
+		}
 	}
+
+	ids := groupIDs.GetAll()
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// GetBlockDeviceMappings returns the block device mappings of the current host,
+// mapping each virtual device name (ami, root, ebsN, ephemeralN...) to the
+// device it is exposed as
+func GetBlockDeviceMappings() (map[string]string, error) {
+	if !config.IsCloudProviderEnabled(CloudProviderName) {
+		return nil, fmt.Errorf("cloud provider is disabled by configuration")
+	}
+
+	mappings := make(map[string]string)
+	names, err := getMetadataList("/block-device-mapping")
+	if err != nil {
+		if isNotFound(err) {
+			return mappings, nil
+		}
+		return nil, err
+	}
+
+	for _, name := range names {
+		device, err := getMetadataItem(fmt.Sprintf("/block-device-mapping/%s", name))
+		if err != nil {
+			return nil, err
+		}
+		mappings[name] = strings.TrimSpace(device)
+	}
+
+	return mappings, nil
+}
+
+// getMACAddresses returns the MAC addresses of the network interfaces attached to the instance
+func getMACAddresses() ([]string, error) {
+	return getMetadataList("/network/interfaces/macs")
+}
+
+// getMetadataList returns the entries listed by a metadata endpoint, stripped
+// from their trailing slash
+func getMetadataList(endpoint string) ([]string, error) {
+	resp, err := getMetadataItem(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []string
+	for _, entry := range strings.Split(strings.TrimSpace(resp), "\n") {
+		entry = strings.TrimSuffix(strings.TrimSpace(entry), "/")
+		if entry == "" {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
 }
 
 func getMetadataItemWithMaxLength(endpoint string, maxLength int) (string, error) {
-	result, err := getMetadataItem(endpoint)
+	return getMetadataItemWithMaxLengthWithContext(context.Background(), endpoint, maxLength)
+}
+
+func getMetadataItemWithMaxLengthWithContext(ctx context.Context, endpoint string, maxLength int) (string, error) {
+	result, err := getMetadataItemWithContext(ctx, endpoint)
 	if err != nil {
 		return result, err
 	}
-	if len(result) > maxLength {
-		return "", fmt.Errorf("%v gave a response with length > to %v", endpoint, maxLength)
-	}
-	return result, err
+	return config.EnforceMaxHostnameSize(endpoint, result, maxLength)
 }
 
 func getMetadataItem(endpoint string) (string, error) {
-	res, err := doHTTPRequest(metadataURL+endpoint, http.MethodGet, map[string]string{}, config.Datadog.GetBool("ec2_prefer_imdsv2"))
+	return getMetadataItemWithContext(context.Background(), endpoint)
+}
+
+func getMetadataItemWithContext(ctx context.Context, endpoint string) (string, error) {
+	start := time.Now()
+	res, err := doHTTPRequestWithRetries(ctx, metadataURL+endpoint, http.MethodGet, map[string]string{}, config.Datadog.GetBool("ec2_prefer_imdsv2"), config.Datadog.GetInt("ec2_metadata_max_retries"))
 	if err != nil {
-		return "", fmt.Errorf("unable to fetch EC2 API, %s", err)
+		recordRequest(endpoint, start, statusCodeOf(err), 0)
+		return "", fmt.Errorf("unable to fetch EC2 API, %w", err)
 	}
 
 	defer res.Body.Close()
 	all, err := ioutil.ReadAll(res.Body)
+	recordRequest(endpoint, start, res.StatusCode, len(all))
 	if err != nil {
 		return "", fmt.Errorf("unable to read response body, %s", err)
 	}
@@ -166,12 +593,58 @@ func getMetadataItem(endpoint string) (string, error) {
 	return string(all), nil
 }
 
+// recordRequest logs how a request to a metadata endpoint went and records its latency. A zero
+// status code means that no response was received.
+func recordRequest(endpoint string, start time.Time, statusCode int, size int) {
+	latency := time.Since(start)
+	log.Debugf("EC2 metadata request to %s took %s, status code %d, %d bytes received", endpoint, latency, statusCode, size)
+
+	lastLatencies.Lock()
+	defer lastLatencies.Unlock()
+	if lastLatencies.values == nil {
+		lastLatencies.values = make(map[string]time.Duration)
+	}
+	lastLatencies.values[endpoint] = latency
+}
+
+// statusCodeOf returns the status code the metadata API answered with when err was caused by a
+// non-200 response, 0 otherwise
+func statusCodeOf(err error) int {
+	var statusErr *statusCodeError
+	if errors.As(err, &statusErr) {
+		return statusErr.code
+	}
+	return 0
+}
+
+// LastRequestLatencies returns the latency of the last request to each of the metadata endpoints
+// queried so far, the IMDSv2 token requests being recorded under /api/token
+func LastRequestLatencies() map[string]time.Duration {
+	lastLatencies.RLock()
+	defer lastLatencies.RUnlock()
+
+	latencies := make(map[string]time.Duration, len(lastLatencies.values))
+	for endpoint, latency := range lastLatencies.values {
+		latencies[endpoint] = latency
+	}
+	return latencies
+}
+
 // GetClusterName returns the name of the cluster containing the current EC2 instance
 func GetClusterName() (string, error) {
+	return GetClusterNameWithContext(context.Background())
+}
+
+// GetClusterNameWithContext returns the name of the cluster containing the current EC2 instance,
+// giving up as soon as ctx is done
+func GetClusterNameWithContext(ctx context.Context) (string, error) {
 	if !config.IsCloudProviderEnabled(CloudProviderName) {
 		return "", fmt.Errorf("cloud provider is disabled by configuration")
 	}
-	tags, err := GetTags()
+	if err := ctx.Err(); err != nil {
+		return "", fmt.Errorf("unable to retrieve clustername from EC2: %s", err)
+	}
+	tags, err := GetTagsWithContext(ctx)
 	if err != nil {
 		return "", fmt.Errorf("unable to retrieve clustername from EC2: %s", err)
 	}
@@ -196,18 +669,86 @@ func extractClusterName(tags []string) (string, error) {
 	return clusterName, nil
 }
 
+// GetTagsWithPrefix returns the EC2 tags whose key starts with prefix, keyed
+// by the remainder of the key once the prefix has been stripped
+func GetTagsWithPrefix(prefix string) (map[string]string, error) {
+	if !config.IsCloudProviderEnabled(CloudProviderName) {
+		return nil, fmt.Errorf("cloud provider is disabled by configuration")
+	}
+	tags, err := GetTags()
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve tags from EC2: %s", err)
+	}
+
+	return extractTagsWithPrefix(tags, prefix), nil
+}
+
+func extractTagsWithPrefix(tags []string, prefix string) map[string]string {
+	matching := make(map[string]string)
+	for _, tag := range tags {
+		if !strings.HasPrefix(tag, prefix) {
+			continue
+		}
+		// tag format: key:value, the value being possibly empty
+		kv := strings.SplitN(strings.TrimPrefix(tag, prefix), ":", 2)
+		if len(kv) == 2 {
+			matching[kv[0]] = kv[1]
+		} else {
+			matching[kv[0]] = ""
+		}
+	}
+	return matching
+}
+
 func doHTTPRequest(url string, method string, headers map[string]string, useToken bool) (*http.Response, error) {
+	return doHTTPRequestWithContext(context.Background(), url, method, headers, useToken)
+}
+
+func doHTTPRequestWithContext(ctx context.Context, url string, method string, headers map[string]string, useToken bool) (*http.Response, error) {
+	return doHTTPRequestWithRetries(ctx, url, method, headers, useToken, 0)
+}
+
+// doHTTPRequestWithRetries sends a request to the metadata API, retrying it up to maxRetries times with
+// an exponential backoff on transient errors. A 404 means that the endpoint doesn't exist, it's not retried.
+func doHTTPRequestWithRetries(ctx context.Context, url string, method string, headers map[string]string, useToken bool, maxRetries int) (*http.Response, error) {
+	if isNotOnEC2() {
+		return nil, ErrNotOnEC2
+	}
+
+	backoff := time.Duration(config.Datadog.GetInt("ec2_metadata_retry_backoff_ms")) * time.Millisecond
+	for attempt := 0; ; attempt++ {
+		res, err := sendHTTPRequest(ctx, url, method, headers, useToken)
+		if err == nil || attempt >= maxRetries || !isTransientError(ctx, err) {
+			// only give up on the metadata API once the retries are exhausted
+			if err != nil && ctx.Err() == nil && isUnreachable(err) {
+				setNotOnEC2()
+			}
+			return res, err
+		}
+
+		log.Debugf("EC2 metadata request to %s failed, retrying in %s: %s", url, backoff, err)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// sendHTTPRequest sends a single request to the metadata API
+func sendHTTPRequest(ctx context.Context, url string, method string, headers map[string]string, useToken bool) (*http.Response, error) {
 	client := http.Client{
 		Timeout: time.Duration(config.Datadog.GetInt("ec2_metadata_timeout")) * time.Millisecond,
 	}
 
-	req, err := http.NewRequest(method, url, nil)
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	if useToken {
-		token, err := getToken()
+		token, err := getTokenWithContext(ctx)
 		if err != nil {
 			log.Warnf("ec2_prefer_imdsv2 is set to true in configuration but the agent was unable to get a token: %s", err)
 		} else {
@@ -222,13 +763,63 @@ func doHTTPRequest(url string, method string, headers map[string]string, useToke
 	res, err := client.Do(req)
 	if err != nil {
 		return nil, err
-	} else if res.StatusCode != 200 {
-		return nil, fmt.Errorf("status code %d trying to fetch %s", res.StatusCode, url)
+	}
+	clearNotOnEC2()
+	if res.StatusCode != 200 {
+		res.Body.Close()
+		return nil, &statusCodeError{code: res.StatusCode, url: url}
 	}
 	return res, nil
 }
 
+// statusCodeError is returned when the metadata API answers with a non-200 status code
+type statusCodeError struct {
+	code int
+	url  string
+}
+
+func (e *statusCodeError) Error() string {
+	return fmt.Sprintf("status code %d trying to fetch %s", e.code, e.url)
+}
+
+// isTransientError returns whether a failed request is worth retrying: connection errors, timeouts and
+// 5xx responses are, unlike other status codes or a cancelled caller context.
+func isTransientError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var statusErr *statusCodeError
+	if errors.As(err, &statusErr) {
+		return statusErr.code >= http.StatusInternalServerError
+	}
+	return true
+}
+
+// isUnreachable returns whether a request failed because the connection to the metadata API was refused or
+// had no route, which tells that the host doesn't run on EC2. A timeout doesn't, the API may just be slow.
+func isUnreachable(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// isNotFound returns whether err was caused by the metadata endpoint not existing
+func isNotFound(err error) bool {
+	var statusErr *statusCodeError
+	return errors.As(err, &statusErr) && statusErr.code == http.StatusNotFound
+}
+
 func getToken() (string, error) {
+	return getTokenWithContext(context.Background())
+}
+
+func getTokenWithContext(ctx context.Context) (string, error) {
 	token.RLock()
 	// Will refresh token 15 seconds before expiration
 	if time.Now().Before(token.expirationDate.Add(-15 * time.Second)) {
@@ -244,30 +835,39 @@ func getToken() (string, error) {
 		return token.value, nil
 	}
 
+	return fetchToken(ctx)
+}
+
+// fetchToken requests a new IMDSv2 token, the caller must hold the token lock
+func fetchToken(ctx context.Context) (string, error) {
 	client := http.Client{
 		Timeout: time.Duration(config.Datadog.GetInt("ec2_metadata_timeout")) * time.Millisecond,
 	}
 
-	req, err := http.NewRequest(http.MethodPut, tokenURL, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, tokenURL, nil)
 	if err != nil {
 		return "", err
 	}
 
 	req.Header.Add("X-aws-ec2-metadata-token-ttl-seconds", fmt.Sprintf("%d", int(tokenLifetime.Seconds())))
 	token.expirationDate = time.Now().Add(tokenLifetime)
+	start := time.Now()
 	res, err := client.Do(req)
 	if err != nil {
+		recordRequest(tokenEndpoint, start, 0, 0)
 		token.expirationDate = time.Now()
 		return "", err
 	}
 
 	if res.StatusCode != 200 {
+		recordRequest(tokenEndpoint, start, res.StatusCode, 0)
 		token.expirationDate = time.Now()
 		return "", fmt.Errorf("status code %d trying to fetch %s", res.StatusCode, tokenURL)
 	}
 
 	defer res.Body.Close()
 	all, err := ioutil.ReadAll(res.Body)
+	recordRequest(tokenEndpoint, start, res.StatusCode, len(all))
 	if err != nil {
 		token.expirationDate = time.Now()
 		return "", fmt.Errorf("unable to read response body, %s", err)
@@ -276,6 +876,117 @@ func getToken() (string, error) {
 	return token.value, nil
 }
 
+// StartTokenRefresher starts a goroutine renewing the IMDSv2 token shortly before it expires, so that
+// callers always find a valid token, until ctx is cancelled. It does nothing unless the AWS cloud provider
+// and ec2_imdsv2_background_token_refresh are enabled or if the refresher is already running.
+func StartTokenRefresher(ctx context.Context) {
+	if !config.IsCloudProviderEnabled(CloudProviderName) || !config.Datadog.GetBool("ec2_imdsv2_background_token_refresh") {
+		return
+	}
+
+	tokenRefresher.Lock()
+	defer tokenRefresher.Unlock()
+	if tokenRefresher.running {
+		return
+	}
+	tokenRefresher.running = true
+
+	go runTokenRefresher(ctx)
+}
+
+func runTokenRefresher(ctx context.Context) {
+	defer func() {
+		tokenRefresher.Lock()
+		tokenRefresher.running = false
+		tokenRefresher.Unlock()
+	}()
+
+	for {
+		wait := tokenRefreshRetryInterval
+		if expirationDate, err := refreshToken(ctx); err != nil {
+			log.Debugf("Unable to refresh the IMDSv2 token, retrying in %s: %s", wait, err)
+		} else {
+			wait = time.Until(expirationDate.Add(-tokenRefreshMargin))
+		}
+		// don't spin when the token lifetime is shorter than the refresh margin
+		if wait < time.Second {
+			wait = time.Second
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// refreshToken renews the IMDSv2 token unless it's valid for longer than tokenRefreshMargin and returns
+// its expiration date
+func refreshToken(ctx context.Context) (time.Time, error) {
+	token.Lock()
+	defer token.Unlock()
+
+	if time.Now().Before(token.expirationDate.Add(-tokenRefreshMargin)) {
+		return token.expirationDate, nil
+	}
+	if _, err := fetchToken(ctx); err != nil {
+		return time.Time{}, err
+	}
+	return token.expirationDate, nil
+}
+
+// TokenStatus returns whether a valid IMDSv2 token is cached and how long until it expires,
+// it doesn't trigger a token refresh
+func TokenStatus() (valid bool, expiresIn time.Duration) {
+	token.RLock()
+	defer token.RUnlock()
+
+	if token.value == "" {
+		return false, 0
+	}
+	expiresIn = time.Until(token.expirationDate)
+	if expiresIn <= 0 {
+		return false, 0
+	}
+	return true, expiresIn
+}
+
+// isNotOnEC2 returns whether a previous request failed to reach the metadata API less than notOnEC2TTL ago
+func isNotOnEC2() bool {
+	notOnEC2.RLock()
+	defer notOnEC2.RUnlock()
+	return time.Now().Before(notOnEC2.expirationDate)
+}
+
+func setNotOnEC2() {
+	notOnEC2.Lock()
+	defer notOnEC2.Unlock()
+	log.Debugf("EC2 metadata API unreachable, not querying it again for %s", notOnEC2TTL)
+	notOnEC2.expirationDate = time.Now().Add(notOnEC2TTL)
+}
+
+// clearNotOnEC2 forgets that the metadata API was unreachable once a request reached it, the write lock
+// is only taken when the marker is set
+func clearNotOnEC2() {
+	notOnEC2.RLock()
+	set := !notOnEC2.expirationDate.IsZero()
+	notOnEC2.RUnlock()
+	if set {
+		ResetNotOnEC2()
+	}
+}
+
+// ResetNotOnEC2 forgets that the metadata API was unreachable, so that the next request queries it
+// again. It's called when the configuration is (re)loaded as the endpoint may have become reachable.
+func ResetNotOnEC2() {
+	notOnEC2.Lock()
+	defer notOnEC2.Unlock()
+	notOnEC2.expirationDate = time.Time{}
+}
+
 // IsDefaultHostname returns whether the given hostname is a default one for EC2
 func IsDefaultHostname(hostname string) bool {
 	return isDefaultHostname(hostname, config.Datadog.GetBool("ec2_use_windows_prefix_detection"))
@@ -309,8 +1020,86 @@ func isDefaultHostname(hostname string, useWindowsPrefix bool) bool {
 	return isDefault
 }
 
-// HostnameProvider gets the hostname
+// HostnameProvider gets the hostname from the source selected by ec2_hostname_source: the instance ID
+// (default) or the private DNS name. An empty private DNS name falls back to the instance ID.
 func HostnameProvider() (string, error) {
+	switch source := config.Datadog.GetString("ec2_hostname_source"); source {
+	case hostnameSourceInstanceID:
+	case hostnameSourcePrivateDNS:
+		log.Debug("GetHostname trying EC2 metadata private DNS name...")
+		hostname, err := GetHostname()
+		if err == nil && strings.TrimSpace(hostname) != "" {
+			return strings.TrimSpace(hostname), nil
+		}
+		log.Warnf("Unable to get the EC2 private DNS name, falling back to the instance ID: %v", err)
+	default:
+		log.Warnf("Invalid ec2_hostname_source %q, expected %q or %q, falling back to the instance ID", source, hostnameSourceInstanceID, hostnameSourcePrivateDNS)
+	}
+
 	log.Debug("GetHostname trying EC2 metadata...")
 	return GetInstanceID()
 }
+
+// InstanceIdentity holds the fields of the instance identity document used by the agent
+type InstanceIdentity struct {
+	AccountID        string `json:"accountId"`
+	Region           string `json:"region"`
+	InstanceID       string `json:"instanceId"`
+	ImageID          string `json:"imageId"`
+	InstanceType     string `json:"instanceType"`
+	AvailabilityZone string `json:"availabilityZone"`
+	PrivateIP        string `json:"privateIp"`
+}
+
+// GetInstanceIdentityDocument returns the instance identity document of the
+// current instance, which exposes the account and image IDs. The last document
+// fetched is returned when the metadata API can't be queried.
+func GetInstanceIdentityDocument() (*InstanceIdentity, error) {
+	if !config.IsCloudProviderEnabled(CloudProviderName) {
+		return nil, fmt.Errorf("cloud provider is disabled by configuration")
+	}
+
+	identity, err := fetchInstanceIdentity(context.Background(), config.Datadog.GetBool("ec2_prefer_imdsv2"))
+	if err != nil {
+		if identity, found := cache.Cache.Get(identityCacheKey); found {
+			log.Debugf("Unable to get ec2 instance identity document from aws metadata, returning cached document: %s", err)
+			// return a copy so callers can't alter the cached document
+			cached := *identity.(*InstanceIdentity)
+			return &cached, nil
+		}
+		return nil, err
+	}
+
+	cached := *identity
+	cache.Cache.Set(identityCacheKey, &cached, cache.NoExpiration)
+
+	return identity, nil
+}
+
+func getInstanceIdentity(ctx context.Context) (*InstanceIdentity, error) {
+	return fetchInstanceIdentity(ctx, true)
+}
+
+// fetchInstanceIdentity fetches the instance identity document, which isn't
+// served under the metadataURL prefix
+func fetchInstanceIdentity(ctx context.Context, useToken bool) (*InstanceIdentity, error) {
+	instanceIdentity := &InstanceIdentity{}
+
+	res, err := doHTTPRequestWithContext(ctx, instanceIdentityURL, http.MethodGet, map[string]string{}, useToken)
+	if err != nil {
+		return instanceIdentity, fmt.Errorf("unable to fetch EC2 API, %s", err)
+	}
+
+	defer res.Body.Close()
+	all, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return instanceIdentity, fmt.Errorf("unable to read identity body, %s", err)
+	}
+
+	err = json.Unmarshal(all, &instanceIdentity)
+	if err != nil {
+		return instanceIdentity, fmt.Errorf("unable to unmarshall json, %s", err)
+	}
+
+	return instanceIdentity, nil
+}
@@ -7,7 +7,22 @@
 
 package ec2
 
+import (
+	"context"
+	"fmt"
+)
+
 // GetTags grabs the host tags from the EC2 api
 func GetTags() ([]string, error) {
 	return []string{}, nil
 }
+
+// GetTagsWithContext grabs the host tags from the EC2 api
+func GetTagsWithContext(ctx context.Context) ([]string, error) {
+	return []string{}, nil
+}
+
+// GetAccountID returns the ID of the AWS account owning the current EC2 instance
+func GetAccountID() (string, error) {
+	return "", fmt.Errorf("the account ID requires the agent to be built with the ec2 tag")
+}
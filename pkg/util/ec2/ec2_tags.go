@@ -8,10 +8,13 @@
 package ec2
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"regexp"
+	"strings"
 
 	"github.com/DataDog/datadog-agent/pkg/config"
 	"github.com/DataDog/datadog-agent/pkg/util/cache"
@@ -24,17 +27,21 @@ import (
 
 // declare these as vars not const to ease testing
 var (
-	instanceIdentityURL = "http://169.254.169.254/latest/dynamic/instance-identity/document/"
-	tagsCacheKey        = cache.BuildAgentKey("ec2", "GetTags")
+	tagsCacheKey = cache.BuildAgentKey("ec2", "GetTags")
+
+	// arnAccountIDRegexp matches the account ID of an ARN found in a tag value (e.g. aws:cloudformation:stack-id)
+	arnAccountIDRegexp = regexp.MustCompile(`arn:aws[a-z-]*:[a-z0-9-]+:[a-z0-9-]*:([0-9]{12}):`)
+	// accountIDRegexp matches a bare account ID
+	accountIDRegexp = regexp.MustCompile(`^[0-9]{12}$`)
 )
 
-func fetchEc2Tags() ([]string, error) {
-	instanceIdentity, err := getInstanceIdentity()
+func fetchEc2Tags(ctx context.Context) ([]string, error) {
+	instanceIdentity, err := getInstanceIdentity(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	iamParams, err := getSecurityCreds()
+	iamParams, err := getSecurityCreds(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -52,7 +59,7 @@ func fetchEc2Tags() ([]string, error) {
 	}
 
 	connection := ec2.New(awsSess)
-	ec2Tags, err := connection.DescribeTags(&ec2.DescribeTagsInput{
+	ec2Tags, err := connection.DescribeTagsWithContext(ctx, &ec2.DescribeTagsInput{
 		Filters: []*ec2.Filter{{
 			Name: aws.String("resource-id"),
 			Values: []*string{
@@ -77,11 +84,16 @@ var fetchTags = fetchEc2Tags
 
 // GetTags grabs the host tags from the EC2 api
 func GetTags() ([]string, error) {
+	return GetTagsWithContext(context.Background())
+}
+
+// GetTagsWithContext grabs the host tags from the EC2 api, the requests are aborted when ctx is done
+func GetTagsWithContext(ctx context.Context) ([]string, error) {
 	if !config.IsCloudProviderEnabled(CloudProviderName) {
 		return nil, fmt.Errorf("cloud provider is disabled by configuration")
 	}
 
-	tags, err := fetchTags()
+	tags, err := fetchTags(ctx)
 	if err != nil {
 		if ec2Tags, found := cache.Cache.Get(tagsCacheKey); found {
 			log.Infof("unable to get tags from aws, returning cached tags: %s", err)
@@ -96,31 +108,58 @@ func GetTags() ([]string, error) {
 	return tags, nil
 }
 
-type ec2Identity struct {
-	Region     string
-	InstanceID string
-}
+// GetAccountID returns the ID of the AWS account owning the current EC2 instance. The instance
+// identity document, the last one fetched when it can't be, is the preferred source. Otherwise
+// the cached tags are scanned for an account ID: the tags can't be fetched either as fetching
+// them requires the instance identity document.
+func GetAccountID() (string, error) {
+	if !config.IsCloudProviderEnabled(CloudProviderName) {
+		return "", fmt.Errorf("cloud provider is disabled by configuration")
+	}
 
-func getInstanceIdentity() (*ec2Identity, error) {
-	instanceIdentity := &ec2Identity{}
+	instanceIdentity, err := GetInstanceIdentityDocument()
+	if err == nil && instanceIdentity.AccountID != "" {
+		return instanceIdentity.AccountID, nil
+	}
+	if err == nil {
+		err = fmt.Errorf("no account ID in the instance identity document")
+	}
 
-	res, err := doHTTPRequest(instanceIdentityURL, http.MethodGet, map[string]string{}, true)
-	if err != nil {
-		return instanceIdentity, fmt.Errorf("unable to fetch EC2 API, %s", err)
+	tags, found := cache.Cache.Get(tagsCacheKey)
+	if !found {
+		return "", fmt.Errorf("unable to retrieve the account ID from EC2: %s, and no tags cached", err)
 	}
 
-	defer res.Body.Close()
-	all, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return instanceIdentity, fmt.Errorf("unable to read identity body, %s", err)
+	accountID, found := getAccountIDFromTags(tags.([]string))
+	if !found {
+		return "", fmt.Errorf("unable to retrieve the account ID from EC2: %s, and none found in the tags", err)
 	}
 
-	err = json.Unmarshal(all, &instanceIdentity)
-	if err != nil {
-		return instanceIdentity, fmt.Errorf("unable to unmarshall json, %s", err)
+	log.Infof("unable to get the account ID from the instance identity document (%s), using the less authoritative EC2 tags", err)
+	return accountID, nil
+}
+
+// getAccountIDFromTags looks for an account ID in the ARNs found in the tag values and then in the
+// values of the tags whose key mentions an account
+func getAccountIDFromTags(tags []string) (string, bool) {
+	for _, tag := range tags {
+		if match := arnAccountIDRegexp.FindStringSubmatch(tag); match != nil {
+			return match[1], true
+		}
+	}
+
+	for _, tag := range tags {
+		// tag format: key:value
+		kv := strings.SplitN(tag, ":", 2)
+		if len(kv) != 2 || !strings.Contains(strings.ToLower(kv[0]), "account") {
+			continue
+		}
+		if value := strings.TrimSpace(kv[1]); accountIDRegexp.MatchString(value) {
+			return value, true
+		}
 	}
 
-	return instanceIdentity, nil
+	return "", false
 }
 
 type ec2SecurityCred struct {
@@ -129,15 +168,15 @@ type ec2SecurityCred struct {
 	Token           string
 }
 
-func getSecurityCreds() (*ec2SecurityCred, error) {
+func getSecurityCreds(ctx context.Context) (*ec2SecurityCred, error) {
 	iamParams := &ec2SecurityCred{}
 
-	iamRole, err := getIAMRole()
+	iamRole, err := getIAMRole(ctx)
 	if err != nil {
 		return iamParams, err
 	}
 
-	res, err := doHTTPRequest(metadataURL+"/iam/security-credentials/"+iamRole, http.MethodGet, map[string]string{}, true)
+	res, err := doHTTPRequestWithContext(ctx, metadataURL+"/iam/security-credentials/"+iamRole, http.MethodGet, map[string]string{}, true)
 	if err != nil {
 		return iamParams, fmt.Errorf("unable to fetch EC2 API, %s", err)
 	}
@@ -155,8 +194,8 @@ func getSecurityCreds() (*ec2SecurityCred, error) {
 	return iamParams, nil
 }
 
-func getIAMRole() (string, error) {
-	res, err := doHTTPRequest(metadataURL+"/iam/security-credentials/", http.MethodGet, map[string]string{}, true)
+func getIAMRole(ctx context.Context) (string, error) {
+	res, err := doHTTPRequestWithContext(ctx, metadataURL+"/iam/security-credentials/", http.MethodGet, map[string]string{}, true)
 	if err != nil {
 		return "", fmt.Errorf("unable to fetch EC2 API, %s", err)
 	}
@@ -8,12 +8,14 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/DataDog/datadog-agent/pkg/config"
 	"github.com/DataDog/datadog-agent/pkg/util/cache"
@@ -37,7 +39,7 @@ func TestGetIAMRole(t *testing.T) {
 	config.Datadog.Set("ec2_metadata_timeout", 1000)
 	defer resetPackageVars()
 
-	val, err := getIAMRole()
+	val, err := getIAMRole(context.Background())
 	require.Nil(t, err)
 	assert.Equal(t, expected, val)
 }
@@ -61,7 +63,7 @@ func TestGetSecurityCreds(t *testing.T) {
 	config.Datadog.Set("ec2_metadata_timeout", 1000)
 	defer resetPackageVars()
 
-	cred, err := getSecurityCreds()
+	cred, err := getSecurityCreds(context.Background())
 	require.Nil(t, err)
 	assert.Equal(t, "123456", cred.AccessKeyID)
 	assert.Equal(t, "secret access key", cred.SecretAccessKey)
@@ -80,18 +82,91 @@ func TestGetInstanceIdentity(t *testing.T) {
 	config.Datadog.Set("ec2_metadata_timeout", 1000)
 	defer resetPackageVars()
 
-	val, err := getInstanceIdentity()
+	val, err := getInstanceIdentity(context.Background())
 	require.Nil(t, err)
 	assert.Equal(t, "us-east-1", val.Region)
 	assert.Equal(t, "i-aaaaaaaaaaaaaaaaa", val.InstanceID)
+	assert.Equal(t, "REMOVED", val.AccountID)
 }
 
-func mockFetchTagsSuccess() ([]string, error) {
+func TestGetAccountIDFromTags(t *testing.T) {
+	for _, tc := range []struct {
+		tags     []string
+		expected string
+		found    bool
+	}{
+		{
+			tags:     []string{"aws:cloudformation:stack-id:arn:aws:cloudformation:us-east-1:123456789012:stack/my-stack/abcd"},
+			expected: "123456789012",
+			found:    true,
+		},
+		{
+			tags:     []string{"env:prod", "AccountId:210987654321"},
+			expected: "210987654321",
+			found:    true,
+		},
+		{
+			tags:  []string{"env:prod", "account:prod", "build:123456789012"},
+			found: false,
+		},
+	} {
+		accountID, found := getAccountIDFromTags(tc.tags)
+		assert.Equal(t, tc.found, found)
+		assert.Equal(t, tc.expected, accountID)
+	}
+}
+
+func TestGetAccountIDFallbackToTags(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+	initialInstanceIdentityURL := instanceIdentityURL
+	instanceIdentityURL = ts.URL
+	tokenURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	defer func() {
+		instanceIdentityURL = initialInstanceIdentityURL
+		fetchTags = fetchEc2Tags
+		cache.Cache.Delete(tagsCacheKey)
+		resetPackageVars()
+	}()
+	cache.Cache.Delete(identityCacheKey)
+	fetchTags = mockFetchTagsFailure
+
+	// the tags can't be fetched without the instance identity document
+	_, err := GetAccountID()
+	require.Error(t, err)
+
+	cache.Cache.Set(tagsCacheKey, []string{"AccountId:123456789012"}, cache.NoExpiration)
+	accountID, err := GetAccountID()
+	require.Nil(t, err)
+	assert.Equal(t, "123456789012", accountID)
+}
+
+func TestGetAccountIDCachedIdentity(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+	instanceIdentityURL = ts.URL
+	tokenURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	defer resetPackageVars()
+	defer cache.Cache.Delete(identityCacheKey)
+	cache.Cache.Set(identityCacheKey, &InstanceIdentity{AccountID: "123456789012"}, cache.NoExpiration)
+
+	accountID, err := GetAccountID()
+	require.Nil(t, err)
+	assert.Equal(t, "123456789012", accountID)
+}
+
+func mockFetchTagsSuccess(ctx context.Context) ([]string, error) {
 	fmt.Printf("mockFetchTagsSuccess !!!!!!!!\n")
 	return []string{"tag1", "tag2"}, nil
 }
 
-func mockFetchTagsFailure() ([]string, error) {
+func mockFetchTagsFailure(ctx context.Context) ([]string, error) {
 	fmt.Printf("mockFetchTagsFailure !!!!!!!!\n")
 	return nil, fmt.Errorf("could not fetch tags")
 }
@@ -152,3 +227,31 @@ func TestGetTagsFullWorkflow(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, []string{"tag1", "tag2"}, tags)
 }
+
+func TestGetClusterNameWithContextCancelled(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+	initialInstanceIdentityURL := instanceIdentityURL
+	instanceIdentityURL = ts.URL
+	tokenURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 10000)
+	defer func() {
+		instanceIdentityURL = initialInstanceIdentityURL
+		resetPackageVars()
+	}()
+	cache.Cache.Delete(tagsCacheKey)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := GetClusterNameWithContext(ctx)
+	assert.Error(t, err)
+	assert.True(t, time.Since(start) < 2*time.Second, "cancellation wasn't honored")
+}
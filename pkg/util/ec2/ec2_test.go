@@ -6,11 +6,23 @@
 package ec2
 
 import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -24,13 +36,25 @@ var (
 	initialTimeout     = time.Duration(config.Datadog.GetInt("ec2_metadata_timeout")) * time.Millisecond
 	initialMetadataURL = metadataURL
 	initialTokenURL    = tokenURL
+	initialIdentityURL = instanceIdentityURL
+	initialPKCS7URL    = instanceIdentityPKCS7URL
 )
 
+func init() {
+	// don't slow down the tests of failing endpoints with the retries
+	config.Datadog.Set("ec2_metadata_retry_backoff_ms", 1)
+}
+
 func resetPackageVars() {
 	config.Datadog.Set("ec2_metadata_timeout", initialTimeout)
 	metadataURL = initialMetadataURL
 	tokenURL = initialTokenURL
+	instanceIdentityURL = initialIdentityURL
+	instanceIdentityPKCS7URL = initialPKCS7URL
 	token = ec2Token{}
+	imdsVerified.done = false
+	notOnEC2 = notOnEC2Marker{}
+	lastLatencies = requestLatencies{}
 }
 
 func TestIsDefaultHostname(t *testing.T) {
@@ -159,6 +183,307 @@ func TestGetHostname(t *testing.T) {
 	assert.Equal(t, lastRequest.URL.Path, "/hostname")
 }
 
+func TestHostnameProvider(t *testing.T) {
+	hostname := "ip-10-10-10-10.ec2.internal"
+	instanceID := "i-aaaaaaaaaaaaaaaaa"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		switch r.URL.Path {
+		case "/hostname":
+			io.WriteString(w, hostname)
+		case "/instance-id":
+			io.WriteString(w, instanceID)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	metadataURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	defer resetPackageVars()
+	defer config.Datadog.Set("ec2_hostname_source", hostnameSourceInstanceID)
+	defer cache.Cache.Delete(hostnameCacheKey)
+
+	for _, tc := range []struct {
+		source   string
+		hostname string
+		expected string
+	}{
+		{source: hostnameSourceInstanceID, hostname: hostname, expected: instanceID},
+		{source: hostnameSourcePrivateDNS, hostname: hostname, expected: hostname},
+		{source: hostnameSourcePrivateDNS, hostname: "", expected: instanceID},
+		{source: "public-dns", hostname: hostname, expected: instanceID},
+	} {
+		t.Run(tc.source, func(t *testing.T) {
+			cache.Cache.Delete(hostnameCacheKey)
+			config.Datadog.Set("ec2_hostname_source", tc.source)
+			hostname = tc.hostname
+
+			val, err := HostnameProvider()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, val)
+		})
+	}
+}
+
+func TestIsOutpost(t *testing.T) {
+	var zoneID string
+	var responseCode int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		switch r.RequestURI {
+		case "/placement/availability-zone-id":
+			w.WriteHeader(responseCode)
+			io.WriteString(w, zoneID)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	metadataURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	defer resetPackageVars()
+
+	// regional availability zone
+	responseCode = http.StatusOK
+	zoneID = "use1-az1"
+	outpost, err := IsOutpost()
+	require.NoError(t, err)
+	assert.False(t, outpost)
+
+	// outpost availability zone
+	zoneID = "op-0123456789abcdef0"
+	outpost, err = IsOutpost()
+	require.NoError(t, err)
+	assert.True(t, outpost)
+
+	// missing field means not an outpost
+	responseCode = http.StatusNotFound
+	outpost, err = IsOutpost()
+	require.NoError(t, err)
+	assert.False(t, outpost)
+
+	// API errors out, should return error
+	responseCode = http.StatusInternalServerError
+	_, err = IsOutpost()
+	assert.Error(t, err)
+}
+
+func TestGetRegion(t *testing.T) {
+	var region, zone string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		switch {
+		case r.RequestURI == "/placement/region" && region != "":
+			io.WriteString(w, region)
+		case r.RequestURI == "/placement/availability-zone" && zone != "":
+			io.WriteString(w, zone)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	metadataURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	defer resetPackageVars()
+	cache.Cache.Delete(regionCacheKey)
+	defer cache.Cache.Delete(regionCacheKey)
+
+	// region exposed by the metadata API
+	region, zone = "eu-west-3", "eu-west-3a"
+	val, err := GetRegion()
+	require.NoError(t, err)
+	assert.Equal(t, "eu-west-3", val)
+
+	// region derived from a Local Zone
+	region, zone = "", "us-east-1-bos-1a"
+	val, err = GetRegion()
+	require.NoError(t, err)
+	assert.Equal(t, "us-east-1", val)
+
+	// neither region nor availability zone, the last region fetched is returned
+	region, zone = "", ""
+	val, err = GetRegion()
+	require.NoError(t, err)
+	assert.Equal(t, "us-east-1", val)
+
+	// nothing cached
+	cache.Cache.Delete(regionCacheKey)
+	_, err = GetRegion()
+	assert.Error(t, err)
+}
+
+func TestGetAvailabilityZone(t *testing.T) {
+	zone := "us-east-1a\n"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		if r.RequestURI == "/placement/availability-zone" && zone != "" {
+			io.WriteString(w, zone)
+		} else {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	metadataURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	defer resetPackageVars()
+	cache.Cache.Delete(zoneCacheKey)
+	defer cache.Cache.Delete(zoneCacheKey)
+
+	val, err := GetAvailabilityZone()
+	require.NoError(t, err)
+	assert.Equal(t, "us-east-1a", val)
+
+	// the last availability zone fetched is returned
+	zone = ""
+	val, err = GetAvailabilityZone()
+	require.NoError(t, err)
+	assert.Equal(t, "us-east-1a", val)
+
+	// nothing cached
+	cache.Cache.Delete(zoneCacheKey)
+	_, err = GetAvailabilityZone()
+	assert.Error(t, err)
+
+	// cloud provider disabled
+	holdValue := config.Datadog.Get("cloud_provider_metadata")
+	defer config.Datadog.Set("cloud_provider_metadata", holdValue)
+	config.Datadog.Set("cloud_provider_metadata", []string{})
+	zone = "us-east-1a"
+	_, err = GetAvailabilityZone()
+	assert.Error(t, err)
+}
+
+func TestGetInstanceType(t *testing.T) {
+	instanceType := "m5.large"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		if r.RequestURI == "/instance-type" {
+			io.WriteString(w, instanceType)
+		} else {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	metadataURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	defer resetPackageVars()
+	cache.Cache.Delete(instanceTypeCacheKey)
+	defer cache.Cache.Delete(instanceTypeCacheKey)
+
+	val, err := GetInstanceType()
+	require.NoError(t, err)
+	assert.Equal(t, "m5.large", val)
+
+	// an empty value isn't cached, the last instance type fetched is returned
+	instanceType = ""
+	val, err = GetInstanceType()
+	require.NoError(t, err)
+	assert.Equal(t, "m5.large", val)
+
+	// nothing cached
+	cache.Cache.Delete(instanceTypeCacheKey)
+	_, err = GetInstanceType()
+	assert.Error(t, err)
+	_, found := cache.Cache.Get(instanceTypeCacheKey)
+	assert.False(t, found)
+}
+
+func TestGetInstanceIdentityDocument(t *testing.T) {
+	const testToken = "AQAAAFKw7LyqwVmmBMkqXHpDBuDWw2GnfGswTHi2yiIOGvzD7OMaWw=="
+	available, withToken := true, false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		switch {
+		case r.Method == http.MethodPut:
+			io.WriteString(w, testToken)
+		case available:
+			withToken = r.Header.Get("X-aws-ec2-metadata-token") == testToken
+			content, err := ioutil.ReadFile("payloads/instance_indentity.json")
+			require.NoError(t, err)
+			w.Write(content)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer ts.Close()
+	instanceIdentityURL = ts.URL
+	tokenURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	defer resetPackageVars()
+	defer config.Datadog.Set("ec2_prefer_imdsv2", false)
+	cache.Cache.Delete(identityCacheKey)
+	defer cache.Cache.Delete(identityCacheKey)
+
+	expected := &InstanceIdentity{
+		AccountID:        "REMOVED",
+		Region:           "us-east-1",
+		InstanceID:       "i-aaaaaaaaaaaaaaaaa",
+		ImageID:          "ami-aaaaaaaa",
+		InstanceType:     "m4.2xlarge",
+		AvailabilityZone: "us-east-1a",
+		PrivateIP:        "1.2.3.4",
+	}
+
+	identity, err := GetInstanceIdentityDocument()
+	require.NoError(t, err)
+	assert.Equal(t, expected, identity)
+	assert.False(t, withToken)
+
+	config.Datadog.Set("ec2_prefer_imdsv2", true)
+	identity, err = GetInstanceIdentityDocument()
+	require.NoError(t, err)
+	assert.Equal(t, expected, identity)
+	assert.True(t, withToken)
+
+	// the last document fetched is returned
+	available = false
+	identity, err = GetInstanceIdentityDocument()
+	require.NoError(t, err)
+	assert.Equal(t, expected, identity)
+
+	// nothing cached
+	cache.Cache.Delete(identityCacheKey)
+	_, err = GetInstanceIdentityDocument()
+	assert.Error(t, err)
+}
+
+func TestRegionFromAvailabilityZone(t *testing.T) {
+	for zone, expected := range map[string]string{
+		"us-east-1a":              "us-east-1",
+		"ap-southeast-2c":         "ap-southeast-2",
+		"us-gov-west-1b":          "us-gov-west-1",
+		"us-east-1-bos-1a":        "us-east-1",
+		"us-west-2-lax-1b":        "us-west-2",
+		"us-east-1-wl1-bos-wlz-1": "us-east-1",
+	} {
+		region, err := regionFromAvailabilityZone(zone)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, region, zone)
+	}
+
+	_, err := regionFromAvailabilityZone("garbage")
+	assert.Error(t, err)
+}
+
+func TestNormalizeArchitecture(t *testing.T) {
+	for name, expected := range map[string]string{
+		"386":     "i386",
+		"i686":    "i386",
+		"amd64":   "x86_64",
+		"x86_64":  "x86_64",
+		"arm64":   "arm64",
+		"aarch64": "arm64",
+	} {
+		arch, err := normalizeArchitecture(name)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, arch, name)
+	}
+
+	_, err := normalizeArchitecture("s390x")
+	assert.Error(t, err)
+}
+
 func TestExtractClusterName(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -204,6 +529,26 @@ func TestExtractClusterName(t *testing.T) {
 	}
 }
 
+func TestExtractTagsWithPrefix(t *testing.T) {
+	tags := []string{
+		"Name:myhost",
+		"costcenter:team:containers",
+		"costcenter:project:agent",
+		"costcenter:untagged",
+		"kubernetes.io/cluster/myclustername:owned",
+	}
+
+	assert.Equal(t, map[string]string{
+		"team":     "containers",
+		"project":  "agent",
+		"untagged": "",
+	}, extractTagsWithPrefix(tags, "costcenter:"))
+	assert.Equal(t, map[string]string{
+		"myclustername": "owned",
+	}, extractTagsWithPrefix(tags, "kubernetes.io/cluster/"))
+	assert.Empty(t, extractTagsWithPrefix(tags, "nomatch"))
+}
+
 func TestGetNetworkID(t *testing.T) {
 	mac := "00:00:00:00:00"
 	vpc := "vpc-12345"
@@ -229,6 +574,111 @@ func TestGetNetworkID(t *testing.T) {
 	assert.Equal(t, vpc, val)
 }
 
+func TestGetNetworkIDs(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		switch r.RequestURI {
+		case "/network/interfaces/macs":
+			io.WriteString(w, "06:aa:bb:cc:dd:01/\n06:aa:bb:cc:dd:02/\n06:aa:bb:cc:dd:03/")
+		case "/network/interfaces/macs/06:aa:bb:cc:dd:01/vpc-id":
+			io.WriteString(w, "vpc-67890")
+		case "/network/interfaces/macs/06:aa:bb:cc:dd:02/vpc-id":
+			io.WriteString(w, "vpc-12345")
+		case "/network/interfaces/macs/06:aa:bb:cc:dd:03/vpc-id":
+			io.WriteString(w, "vpc-67890")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	defer ts.Close()
+	metadataURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	defer resetPackageVars()
+
+	vals, err := GetNetworkIDs()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"vpc-12345", "vpc-67890"}, vals)
+
+	_, err = GetNetworkID()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "too many mac addresses returned")
+}
+
+func TestGetSubnetIDAndSecurityGroups(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		switch r.RequestURI {
+		case "/network/interfaces/macs":
+			io.WriteString(w, "06:aa:bb:cc:dd:01/\n06:aa:bb:cc:dd:02/")
+		case "/network/interfaces/macs/06:aa:bb:cc:dd:01/subnet-id":
+			io.WriteString(w, "subnet-67890")
+		case "/network/interfaces/macs/06:aa:bb:cc:dd:02/subnet-id":
+			io.WriteString(w, "subnet-12345")
+		case "/network/interfaces/macs/06:aa:bb:cc:dd:01/security-group-ids":
+			io.WriteString(w, "sg-0bbbbbbbbbbbbbbbb\nsg-0aaaaaaaaaaaaaaaa")
+		case "/network/interfaces/macs/06:aa:bb:cc:dd:02/security-group-ids":
+			io.WriteString(w, "sg-0aaaaaaaaaaaaaaaa\n")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	defer ts.Close()
+	metadataURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	defer resetPackageVars()
+
+	groups, err := GetSecurityGroups()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sg-0aaaaaaaaaaaaaaaa", "sg-0bbbbbbbbbbbbbbbb"}, groups)
+
+	_, err = GetSubnetID()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "several subnets")
+
+	defer config.Datadog.Set("ec2_allow_multiple_subnets", false)
+	config.Datadog.Set("ec2_allow_multiple_subnets", true)
+	subnet, err := GetSubnetID()
+	require.NoError(t, err)
+	assert.Equal(t, "subnet-12345", subnet)
+}
+
+func TestGetWithContextCancelled(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// hang until the agent gives up on the request
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+
+	defer ts.Close()
+	metadataURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 5000)
+	defer resetPackageVars()
+	cache.Cache.Delete(instanceIDCacheKey)
+	cache.Cache.Delete(hostnameCacheKey)
+
+	for name, get := range map[string]func(context.Context) (string, error){
+		"instance ID": GetInstanceIDWithContext,
+		"hostname":    GetHostnameWithContext,
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			time.AfterFunc(50*time.Millisecond, cancel)
+
+			start := time.Now()
+			_, err := get(ctx)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, context.Canceled))
+			assert.True(t, time.Since(start) < time.Second)
+			// a cancelled request doesn't tell anything about the metadata API
+			assert.False(t, isNotOnEC2())
+		})
+	}
+}
+
 func TestGetInstanceIDNoMac(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		io.WriteString(w, "")
@@ -274,6 +724,50 @@ func TestGetInstanceIDMultipleVPC(t *testing.T) {
 	assert.Contains(t, err.Error(), "too many mac addresses returned")
 }
 
+func TestGetBlockDeviceMappings(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		switch r.RequestURI {
+		case "/block-device-mapping":
+			io.WriteString(w, "ami\nebs1\nroot")
+		case "/block-device-mapping/ami":
+			io.WriteString(w, "/dev/xvda")
+		case "/block-device-mapping/ebs1":
+			io.WriteString(w, "sdb")
+		case "/block-device-mapping/root":
+			io.WriteString(w, "/dev/xvda")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	metadataURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	defer resetPackageVars()
+
+	mappings, err := GetBlockDeviceMappings()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"ami":  "/dev/xvda",
+		"ebs1": "sdb",
+		"root": "/dev/xvda",
+	}, mappings)
+}
+
+func TestGetBlockDeviceMappingsNone(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+	metadataURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	defer resetPackageVars()
+
+	mappings, err := GetBlockDeviceMappings()
+	require.NoError(t, err)
+	assert.Empty(t, mappings)
+}
+
 func TestGetLocalIPv4(t *testing.T) {
 	ip := "10.0.0.2"
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -296,6 +790,79 @@ func TestGetLocalIPv4(t *testing.T) {
 	assert.Equal(t, []string{ip}, ips)
 }
 
+func TestGetLocalIPv4MultipleInterfaces(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		switch r.RequestURI {
+		case "/local-ipv4":
+			io.WriteString(w, "10.0.0.2")
+		case "/network/interfaces/macs":
+			io.WriteString(w, "06:aa:bb:cc:dd:01/\n06:aa:bb:cc:dd:02/\n06:aa:bb:cc:dd:03/")
+		case "/network/interfaces/macs/06:aa:bb:cc:dd:01/local-ipv4s":
+			io.WriteString(w, "10.0.0.2\n10.0.0.3")
+		case "/network/interfaces/macs/06:aa:bb:cc:dd:02/local-ipv4s":
+			io.WriteString(w, "10.0.1.5\n10.0.0.3\n")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	defer ts.Close()
+	metadataURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	defer resetPackageVars()
+
+	ips, err := GetLocalIPv4()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.2", "10.0.0.3", "10.0.1.5"}, ips)
+}
+
+func TestGetLocalIPv6(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		switch r.RequestURI {
+		case "/network/interfaces/macs":
+			io.WriteString(w, "06:aa:bb:cc:dd:01/\n06:aa:bb:cc:dd:02/\n06:aa:bb:cc:dd:03/")
+		case "/network/interfaces/macs/06:aa:bb:cc:dd:01/ipv6s":
+			io.WriteString(w, "2600:1f18:aaaa:bb00::1\n2600:1f18:aaaa:bb00::2")
+		case "/network/interfaces/macs/06:aa:bb:cc:dd:03/ipv6s":
+			io.WriteString(w, "2600:1f18:aaaa:bb01::5\n2600:1f18:aaaa:bb00::2\n")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	defer ts.Close()
+	metadataURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	defer resetPackageVars()
+
+	ips, err := GetLocalIPv6()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2600:1f18:aaaa:bb00::1", "2600:1f18:aaaa:bb00::2", "2600:1f18:aaaa:bb01::5"}, ips)
+}
+
+func TestGetLocalIPv6NoAddress(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		if r.RequestURI == "/network/interfaces/macs" {
+			io.WriteString(w, "06:aa:bb:cc:dd:01/")
+		} else {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	defer ts.Close()
+	metadataURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	defer resetPackageVars()
+
+	ips, err := GetLocalIPv6()
+	require.NoError(t, err)
+	assert.NotNil(t, ips)
+	assert.Empty(t, ips)
+}
+
 func TestGetToken(t *testing.T) {
 	originalToken := "AQAAAFKw7LyqwVmmBMkqXHpDBuDWw2GnfGswTHi2yiIOGvzD7OMaWw=="
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -318,6 +885,233 @@ func TestGetToken(t *testing.T) {
 	assert.Equal(t, originalToken, token)
 }
 
+func TestTokenRefresher(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		if r.Method == http.MethodPut {
+			atomic.AddInt32(&requests, 1)
+			io.WriteString(w, "AQAAAFKw7LyqwVmmBMkqXHpDBuDWw2GnfGswTHi2yiIOGvzD7OMaWw==")
+		} else {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	defer ts.Close()
+	tokenURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	defer resetPackageVars()
+	// the token is renewed a second after being fetched
+	defer func(lifetime time.Duration) { tokenLifetime = lifetime }(tokenLifetime)
+	tokenLifetime = tokenRefreshMargin + time.Second
+
+	isRunning := func() bool {
+		tokenRefresher.Lock()
+		defer tokenRefresher.Unlock()
+		return tokenRefresher.running
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// disabled by default
+	StartTokenRefresher(ctx)
+	assert.False(t, isRunning())
+
+	defer config.Datadog.Set("ec2_imdsv2_background_token_refresh", false)
+	config.Datadog.Set("ec2_imdsv2_background_token_refresh", true)
+	StartTokenRefresher(ctx)
+	StartTokenRefresher(ctx)
+	assert.True(t, isRunning())
+
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&requests) == 1 }, time.Second, 10*time.Millisecond)
+	valid, _ := TokenStatus()
+	assert.True(t, valid)
+
+	// the token is renewed before it expires
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&requests) == 2 }, 3*time.Second, 10*time.Millisecond)
+
+	cancel()
+	assert.Eventually(t, func() bool { return !isRunning() }, time.Second, 10*time.Millisecond)
+}
+
+func TestLastRequestLatencies(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		switch {
+		case r.Method == http.MethodPut:
+			io.WriteString(w, "AQAAAFKw7LyqwVmmBMkqXHpDBuDWw2GnfGswTHi2yiIOGvzD7OMaWw==")
+		case r.URL.Path == "/instance-id":
+			time.Sleep(10 * time.Millisecond)
+			io.WriteString(w, "i-0123456789abcdef0")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	defer ts.Close()
+	metadataURL = ts.URL
+	tokenURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	defer resetPackageVars()
+
+	assert.Empty(t, LastRequestLatencies())
+
+	_, err := getMetadataItem("/instance-id")
+	require.NoError(t, err)
+	_, err = getMetadataItem("/placement/region")
+	require.Error(t, err)
+	_, err = getToken()
+	require.NoError(t, err)
+
+	latencies := LastRequestLatencies()
+	assert.Len(t, latencies, 3)
+	assert.True(t, latencies["/instance-id"] >= 10*time.Millisecond)
+	assert.Contains(t, latencies, "/placement/region")
+	assert.Contains(t, latencies, tokenEndpoint)
+}
+
+func TestTokenStatus(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		if r.Method == http.MethodPut {
+			io.WriteString(w, "AQAAAFKw7LyqwVmmBMkqXHpDBuDWw2GnfGswTHi2yiIOGvzD7OMaWw==")
+		} else {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	defer ts.Close()
+	tokenURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	defer resetPackageVars()
+
+	valid, expiresIn := TokenStatus()
+	assert.False(t, valid)
+	assert.Zero(t, expiresIn)
+
+	_, err := getToken()
+	require.NoError(t, err)
+
+	valid, expiresIn = TokenStatus()
+	assert.True(t, valid)
+	assert.True(t, expiresIn > 0 && expiresIn <= tokenLifetime)
+
+	token.expirationDate = time.Now()
+	valid, expiresIn = TokenStatus()
+	assert.False(t, valid)
+	assert.Zero(t, expiresIn)
+}
+
+func TestGetMetadataItemRetries(t *testing.T) {
+	attempts := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		switch {
+		case r.RequestURI != "/instance-id":
+			w.WriteHeader(http.StatusNotFound)
+		case attempts <= 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			io.WriteString(w, "i-aaaaaaaaaaaaaaaaa")
+		}
+	}))
+	defer ts.Close()
+	metadataURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	defer resetPackageVars()
+
+	// transient errors are retried
+	val, err := getMetadataItem("/instance-id")
+	require.NoError(t, err)
+	assert.Equal(t, "i-aaaaaaaaaaaaaaaaa", val)
+	assert.Equal(t, 3, attempts)
+
+	// a missing endpoint isn't
+	attempts = 0
+	_, err = getMetadataItem("/placement/region")
+	require.Error(t, err)
+	assert.True(t, isNotFound(err))
+	assert.Equal(t, 1, attempts)
+
+	// retries are bounded
+	maxRetries := config.Datadog.GetInt("ec2_metadata_max_retries")
+	defer config.Datadog.Set("ec2_metadata_max_retries", maxRetries)
+	config.Datadog.Set("ec2_metadata_max_retries", 1)
+	attempts = 0
+	_, err = getMetadataItem("/instance-id")
+	require.Error(t, err)
+	assert.Equal(t, 2, attempts)
+	assert.False(t, isNotOnEC2())
+}
+
+func TestNotOnEC2(t *testing.T) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		io.WriteString(w, "i-aaaaaaaaaaaaaaaaa")
+	}))
+	defer ts.Close()
+
+	// grab the address of a server nobody listens on anymore
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+
+	metadataURL = unreachable.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	config.Datadog.SetDefault("ec2_prefer_imdsv2", false)
+	defer resetPackageVars()
+
+	_, err := getMetadataItem("/instance-id")
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, ErrNotOnEC2))
+
+	// the following requests fail fast, even once the endpoint is reachable
+	metadataURL = ts.URL
+	_, err = getMetadataItem("/instance-id")
+	assert.True(t, errors.Is(err, ErrNotOnEC2))
+	assert.Equal(t, 0, requests)
+
+	// the marker expires, and is cleared by the next request reaching the metadata API
+	notOnEC2.expirationDate = time.Now()
+	val, err := getMetadataItem("/instance-id")
+	require.NoError(t, err)
+	assert.Equal(t, "i-aaaaaaaaaaaaaaaaa", val)
+	assert.Equal(t, 1, requests)
+	assert.True(t, notOnEC2.expirationDate.IsZero())
+
+	// the marker is cleared on configuration reload
+	metadataURL = unreachable.URL
+	_, err = getMetadataItem("/instance-id")
+	require.Error(t, err)
+	metadataURL = ts.URL
+	ResetNotOnEC2()
+	_, err = getMetadataItem("/instance-id")
+	require.NoError(t, err)
+	assert.Equal(t, 2, requests)
+}
+
+func TestNotOnEC2Timeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer ts.Close()
+
+	metadataURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 50)
+	config.Datadog.SetDefault("ec2_prefer_imdsv2", false)
+	defer resetPackageVars()
+
+	// a slow metadata API doesn't tell that the host isn't on EC2
+	_, err := getMetadataItem("/instance-id")
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, ErrNotOnEC2))
+	assert.False(t, isNotOnEC2())
+}
+
 func TestMetedataRequestWithToken(t *testing.T) {
 	var requestWithoutToken *http.Request
 	var requestForToken *http.Request
@@ -444,3 +1238,195 @@ func TestMetedataRequestWithoutToken(t *testing.T) {
 	assert.Equal(t, "/local-ipv4", requestWithoutToken.RequestURI)
 	assert.Equal(t, http.MethodGet, requestWithoutToken.Method)
 }
+
+func newIMDSServer(instanceID string, tokenWithoutTTL bool) *httptest.Server {
+	const tok = "AQAAAFKw7LyqwVmmBMkqXHpDBuDWw2GnfGswTHi2yiIOGvzD7OMaWw=="
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		switch r.Method {
+		case http.MethodPut:
+			if r.Header.Get("X-aws-ec2-metadata-token-ttl-seconds") == "" && !tokenWithoutTTL {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			io.WriteString(w, tok)
+		case http.MethodGet:
+			if token := r.Header.Get("X-aws-ec2-metadata-token"); token != "" && token != tok {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			switch r.RequestURI {
+			case "/instance-id":
+				io.WriteString(w, instanceID)
+			case "/hostname":
+				io.WriteString(w, "ip-10-0-0-2.ec2.internal")
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}
+	}))
+}
+
+func TestVerifyIMDS(t *testing.T) {
+	tests := []struct {
+		name            string
+		instanceID      string
+		tokenWithoutTTL bool
+		expectError     bool
+	}{
+		{
+			name:       "genuine",
+			instanceID: "i-0123456789abcdef0",
+		},
+		{
+			name:       "genuine (short instance ID)",
+			instanceID: "i-0123abcd",
+		},
+		{
+			name:        "unexpected instance ID",
+			instanceID:  "my-server",
+			expectError: true,
+		},
+		{
+			name:            "token issued without TTL",
+			instanceID:      "i-0123456789abcdef0",
+			tokenWithoutTTL: true,
+			expectError:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts := newIMDSServer(test.instanceID, test.tokenWithoutTTL)
+			defer ts.Close()
+			metadataURL = ts.URL
+			tokenURL = ts.URL
+			config.Datadog.Set("ec2_metadata_timeout", 1000)
+			defer resetPackageVars()
+
+			err := VerifyIMDS()
+			if test.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetInstanceIDVerifyIMDS(t *testing.T) {
+	ts := newIMDSServer("my-server", false)
+	defer ts.Close()
+	metadataURL = ts.URL
+	tokenURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	config.Datadog.Set("ec2_verify_imds", true)
+	defer config.Datadog.Set("ec2_verify_imds", false)
+	defer resetPackageVars()
+	defer cache.Cache.Delete(instanceIDCacheKey)
+	cache.Cache.Delete(instanceIDCacheKey)
+
+	_, err := GetInstanceID()
+	assert.Error(t, err)
+	_, found := cache.Cache.Get(instanceIDCacheKey)
+	assert.False(t, found)
+}
+
+func TestGetInstanceIDVerifyInstanceIdentity(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	certDER, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{SerialNumber: big.NewInt(1)}, &x509.Certificate{SerialNumber: big.NewInt(1)}, &key.PublicKey, key)
+	require.NoError(t, err)
+	dir, err := ioutil.TempDir("", "ec2-identity")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	certPath := filepath.Join(dir, "ec2.pem")
+	require.NoError(t, ioutil.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}), 0600))
+
+	sign := func(signingKey *rsa.PrivateKey) func(digest []byte) []byte {
+		return func(digest []byte) []byte {
+			sig, err := rsa.SignPKCS1v15(rand.Reader, signingKey, crypto.SHA256, digest)
+			require.NoError(t, err)
+			return sig
+		}
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		document      string
+		signingKey    *rsa.PrivateKey
+		noCertificate bool
+		expectedError error
+	}{
+		{
+			name:       "matching identity document",
+			document:   `{"instanceId": "i-0123456789abcdef0", "region": "us-east-1"}`,
+			signingKey: key,
+		},
+		{
+			name:          "mismatching identity document",
+			document:      `{"instanceId": "i-0fedcba9876543210", "region": "us-east-1"}`,
+			signingKey:    key,
+			expectedError: ErrInstanceIDMismatch,
+		},
+		{
+			name:          "identity document signed by another key",
+			document:      `{"instanceId": "i-0123456789abcdef0", "region": "us-east-1"}`,
+			signingKey:    otherKey,
+			expectedError: ErrInstanceIdentityUnverified,
+		},
+		{
+			name:          "identity document not available",
+			expectedError: ErrInstanceIdentityUnverified,
+		},
+		{
+			name:          "certificate not set",
+			document:      `{"instanceId": "i-0123456789abcdef0", "region": "us-east-1"}`,
+			signingKey:    key,
+			noCertificate: true,
+			expectedError: ErrInstanceIdentityUnverified,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := newIMDSServer("i-0123456789abcdef0", false)
+			defer ts.Close()
+			identity := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.document == "" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				der := signTestPKCS7(t, []byte(tt.document), crypto.SHA256, sign(tt.signingKey), false, false)
+				io.WriteString(w, base64.StdEncoding.EncodeToString(der))
+			}))
+			defer identity.Close()
+			metadataURL = ts.URL
+			tokenURL = ts.URL
+			instanceIdentityPKCS7URL = identity.URL
+			config.Datadog.Set("ec2_metadata_timeout", 1000)
+			config.Datadog.Set("ec2_verify_instance_identity", true)
+			defer config.Datadog.Set("ec2_verify_instance_identity", false)
+			if !tt.noCertificate {
+				config.Datadog.Set("ec2_instance_identity_certificate", certPath)
+				defer config.Datadog.Set("ec2_instance_identity_certificate", "")
+			}
+			defer resetPackageVars()
+			defer cache.Cache.Delete(instanceIDCacheKey)
+			cache.Cache.Delete(instanceIDCacheKey)
+
+			val, err := GetInstanceID()
+			_, found := cache.Cache.Get(instanceIDCacheKey)
+			if tt.expectedError != nil {
+				assert.True(t, errors.Is(err, tt.expectedError))
+				assert.False(t, found)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "i-0123456789abcdef0", val)
+				assert.True(t, found)
+			}
+		})
+	}
+}
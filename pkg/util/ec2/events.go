@@ -0,0 +1,120 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package ec2
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/config"
+)
+
+// scheduledEventTimeLayout is the layout of the times of the scheduled events
+// exposed by the metadata API, ie: "21 Jan 2019 09:00:43 GMT"
+const scheduledEventTimeLayout = "2 Jan 2006 15:04:05 MST"
+
+// ScheduledEvent is a maintenance event (reboot, retirement...) scheduled for the current host
+type ScheduledEvent struct {
+	EventID     string
+	Code        string
+	Description string
+	State       string
+	NotBefore   time.Time
+	NotAfter    time.Time
+}
+
+// SpotAction is the action scheduled for the current spot instance when it's interrupted
+type SpotAction struct {
+	// Action is either stop, hibernate or terminate
+	Action string    `json:"action"`
+	Time   time.Time `json:"time"`
+}
+
+type rawScheduledEvent struct {
+	EventID     string `json:"EventId"`
+	Code        string `json:"Code"`
+	Description string `json:"Description"`
+	State       string `json:"State"`
+	NotBefore   string `json:"NotBefore"`
+	NotAfter    string `json:"NotAfter"`
+}
+
+// GetScheduledEvents returns the maintenance events scheduled for the current
+// host. An empty slice is returned when no event is scheduled.
+func GetScheduledEvents() ([]ScheduledEvent, error) {
+	if !config.IsCloudProviderEnabled(CloudProviderName) {
+		return nil, fmt.Errorf("cloud provider is disabled by configuration")
+	}
+
+	res, err := getMetadataItem("/events/maintenance/scheduled")
+	if err != nil {
+		if isNotFound(err) {
+			return []ScheduledEvent{}, nil
+		}
+		return nil, err
+	}
+
+	return parseScheduledEvents([]byte(res))
+}
+
+// GetSpotInstanceAction returns the interruption scheduled for the current spot
+// instance, nil when none is scheduled. As it's meant to be polled, the result is
+// never cached.
+func GetSpotInstanceAction() (*SpotAction, error) {
+	if !config.IsCloudProviderEnabled(CloudProviderName) {
+		return nil, fmt.Errorf("cloud provider is disabled by configuration")
+	}
+
+	res, err := getMetadataItem("/spot/instance-action")
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	action := &SpotAction{}
+	if err := json.Unmarshal([]byte(res), action); err != nil {
+		return nil, fmt.Errorf("unable to unmarshall json, %s", err)
+	}
+	return action, nil
+}
+
+func parseScheduledEvents(data []byte) ([]ScheduledEvent, error) {
+	var rawEvents []rawScheduledEvent
+	if err := json.Unmarshal(data, &rawEvents); err != nil {
+		return nil, fmt.Errorf("unable to unmarshall json, %s", err)
+	}
+
+	events := make([]ScheduledEvent, 0, len(rawEvents))
+	for _, raw := range rawEvents {
+		event := ScheduledEvent{
+			EventID:     raw.EventID,
+			Code:        raw.Code,
+			Description: raw.Description,
+			State:       raw.State,
+		}
+
+		var err error
+		if event.NotBefore, err = parseScheduledEventTime(raw.NotBefore); err != nil {
+			return nil, fmt.Errorf("unable to parse NotBefore of event %s: %s", raw.EventID, err)
+		}
+		if event.NotAfter, err = parseScheduledEventTime(raw.NotAfter); err != nil {
+			return nil, fmt.Errorf("unable to parse NotAfter of event %s: %s", raw.EventID, err)
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func parseScheduledEventTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(scheduledEventTimeLayout, value)
+}
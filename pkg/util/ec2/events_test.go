@@ -0,0 +1,104 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package ec2
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetScheduledEvents(t *testing.T) {
+	payload := `[{"NotBefore": "21 Jan 2019 09:00:43 GMT", "Code": "system-reboot", "Description": "scheduled reboot", "EventId": "instance-event-0d59937288b749b32", "NotAfter": "21 Jan 2019 09:17:23 GMT", "State": "active"}]`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		switch r.RequestURI {
+		case "/events/maintenance/scheduled":
+			io.WriteString(w, payload)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	metadataURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	defer resetPackageVars()
+
+	events, err := GetScheduledEvents()
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "instance-event-0d59937288b749b32", events[0].EventID)
+	assert.Equal(t, "system-reboot", events[0].Code)
+	assert.Equal(t, "scheduled reboot", events[0].Description)
+	assert.Equal(t, "active", events[0].State)
+	assert.True(t, time.Date(2019, time.January, 21, 9, 0, 43, 0, time.UTC).Equal(events[0].NotBefore))
+	assert.True(t, time.Date(2019, time.January, 21, 9, 17, 23, 0, time.UTC).Equal(events[0].NotAfter))
+}
+
+func TestGetScheduledEventsNone(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+	metadataURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	defer resetPackageVars()
+
+	events, err := GetScheduledEvents()
+	require.NoError(t, err)
+	assert.NotNil(t, events)
+	assert.Empty(t, events)
+}
+
+func TestGetSpotInstanceAction(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		switch r.RequestURI {
+		case "/spot/instance-action":
+			io.WriteString(w, `{"action": "terminate", "time": "2017-09-18T08:22:00Z"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	metadataURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	defer resetPackageVars()
+
+	action, err := GetSpotInstanceAction()
+	require.NoError(t, err)
+	require.NotNil(t, action)
+	assert.Equal(t, "terminate", action.Action)
+	assert.True(t, time.Date(2017, time.September, 18, 8, 22, 0, 0, time.UTC).Equal(action.Time))
+}
+
+func TestGetSpotInstanceActionNone(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+	metadataURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	defer resetPackageVars()
+
+	action, err := GetSpotInstanceAction()
+	require.NoError(t, err)
+	assert.Nil(t, action)
+}
+
+func TestParseScheduledEventsInvalid(t *testing.T) {
+	_, err := parseScheduledEvents([]byte(`{"not": "an array"}`))
+	assert.Error(t, err)
+
+	_, err = parseScheduledEvents([]byte(`[{"Code": "system-reboot", "NotBefore": "tomorrow"}]`))
+	assert.Error(t, err)
+}
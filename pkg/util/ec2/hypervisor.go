@@ -0,0 +1,60 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package ec2
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/config"
+)
+
+const (
+	// HypervisorNitro is the hypervisor of the Nitro based instances, including the bare metal ones
+	HypervisorNitro = "nitro"
+	// HypervisorXen is the hypervisor of the previous generation instances
+	HypervisorXen = "xen"
+)
+
+// declare these as vars not const to ease testing
+var (
+	dmiPath            = "/sys/devices/virtual/dmi/id"
+	hypervisorTypePath = "/sys/hypervisor/type"
+)
+
+// GetHypervisor returns the hypervisor generation of the current instance, either nitro or xen.
+// The metadata API doesn't expose it, so it's detected from sysfs: Nitro instances have Amazon
+// EC2 as board vendor while Xen instances expose the Xen hypervisor and system vendor.
+func GetHypervisor() (string, error) {
+	if !config.IsCloudProviderEnabled(CloudProviderName) {
+		return "", fmt.Errorf("cloud provider is disabled by configuration")
+	}
+	return detectHypervisor()
+}
+
+func detectHypervisor() (string, error) {
+	if readSysfsValue(filepath.Join(dmiPath, "board_vendor")) == "Amazon EC2" {
+		return HypervisorNitro, nil
+	}
+
+	if readSysfsValue(hypervisorTypePath) == "xen" || readSysfsValue(filepath.Join(dmiPath, "sys_vendor")) == "Xen" {
+		return HypervisorXen, nil
+	}
+
+	return "", errors.New("unable to detect the EC2 hypervisor")
+}
+
+// readSysfsValue returns the trimmed content of a sysfs file, or an empty string when it can't be read
+func readSysfsValue(path string) string {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(content))
+}
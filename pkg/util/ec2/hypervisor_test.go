@@ -0,0 +1,80 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package ec2
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectHypervisor(t *testing.T) {
+	initialDMIPath, initialHypervisorTypePath := dmiPath, hypervisorTypePath
+	defer func() {
+		dmiPath, hypervisorTypePath = initialDMIPath, initialHypervisorTypePath
+	}()
+
+	for _, tc := range []struct {
+		name     string
+		files    map[string]string
+		expected string
+	}{
+		{
+			name: "nitro",
+			files: map[string]string{
+				"dmi/board_vendor": "Amazon EC2\n",
+				"dmi/sys_vendor":   "Amazon EC2\n",
+			},
+			expected: HypervisorNitro,
+		},
+		{
+			name: "xen",
+			files: map[string]string{
+				"dmi/sys_vendor":  "Xen\n",
+				"hypervisor_type": "xen\n",
+			},
+			expected: HypervisorXen,
+		},
+		{
+			name: "xen without dmi",
+			files: map[string]string{
+				"hypervisor_type": "xen\n",
+			},
+			expected: HypervisorXen,
+		},
+		{
+			name: "unknown",
+			files: map[string]string{
+				"dmi/sys_vendor": "QEMU\n",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "ec2-hypervisor")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+
+			require.NoError(t, os.Mkdir(filepath.Join(dir, "dmi"), 0755))
+			for name, content := range tc.files {
+				require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+			}
+			dmiPath = filepath.Join(dir, "dmi")
+			hypervisorTypePath = filepath.Join(dir, "hypervisor_type")
+
+			hypervisor, err := detectHypervisor()
+			if tc.expected == "" {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, hypervisor)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package ec2
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/DataDog/datadog-agent/pkg/config"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+// Metadata holds the main EC2 metadata of the current host
+type Metadata struct {
+	InstanceID       string
+	Hostname         string
+	Region           string
+	AvailabilityZone string
+	InstanceType     string
+	PrivateIP        string
+	PublicIP         string
+
+	// Errors contains the error encountered for each field that could not be
+	// fetched, keyed by field name
+	Errors map[string]error
+}
+
+// GetMetadata fetches the main EC2 metadata of the current host at once. The
+// fields are fetched concurrently, a field that cannot be fetched is left empty
+// and its error is reported in Metadata.Errors. An error is only returned when
+// no field could be fetched at all.
+func GetMetadata() (Metadata, error) {
+	metadata := Metadata{Errors: make(map[string]error)}
+	if !config.IsCloudProviderEnabled(CloudProviderName) {
+		return metadata, fmt.Errorf("cloud provider is disabled by configuration")
+	}
+
+	fields := []struct {
+		name     string
+		endpoint string
+		value    *string
+	}{
+		{"InstanceID", "/instance-id", &metadata.InstanceID},
+		{"Hostname", "/hostname", &metadata.Hostname},
+		{"Region", "/placement/region", &metadata.Region},
+		{"AvailabilityZone", "/placement/availability-zone", &metadata.AvailabilityZone},
+		{"InstanceType", "/instance-type", &metadata.InstanceType},
+		{"PrivateIP", "/local-ipv4", &metadata.PrivateIP},
+		{"PublicIP", "/public-ipv4", &metadata.PublicIP},
+	}
+
+	endpoints := make(map[string]string, len(fields))
+	for _, field := range fields {
+		endpoints[field.name] = field.endpoint
+	}
+
+	values, errs := getMetadataItems(endpoints)
+	for _, field := range fields {
+		*field.value = values[field.name]
+	}
+	metadata.Errors = errs
+
+	if len(metadata.Errors) == len(fields) {
+		return metadata, fmt.Errorf("unable to fetch any EC2 metadata: %s", metadata.Errors["InstanceID"])
+	}
+
+	return metadata, nil
+}
+
+// Detect returns the cloud provider of the current host along with its
+// instance-id, region, availability-zone and instance-type, fetched
+// concurrently. The provider is AWS on EC2, an empty provider and no error
+// are returned when the host doesn't run on EC2. An error is only returned
+// when the host runs on EC2 but none of the metadata could be fetched.
+func Detect() (string, map[string]string, error) {
+	if !config.IsCloudProviderEnabled(CloudProviderName) || !IsRunningOn() {
+		return "", nil, nil
+	}
+
+	values, errs := getMetadataItems(map[string]string{
+		"instance-id":       "/instance-id",
+		"region":            "/placement/region",
+		"availability-zone": "/placement/availability-zone",
+		"instance-type":     "/instance-type",
+	})
+	if len(values) == 0 {
+		return "", nil, fmt.Errorf("unable to fetch any EC2 metadata: %s", errs["instance-id"])
+	}
+	for name, err := range errs {
+		log.Debugf("unable to fetch the EC2 %s: %s", name, err)
+	}
+
+	// older metadata API versions don't expose the region
+	if zone, found := values["availability-zone"]; found && values["region"] == "" {
+		if region, err := regionFromAvailabilityZone(zone); err == nil {
+			values["region"] = region
+		}
+	}
+
+	return CloudProviderName, values, nil
+}
+
+// getMetadataItems fetches metadata endpoints concurrently, keyed by name. The
+// values are trimmed, the endpoints that couldn't be fetched are missing from
+// them and have their error returned instead.
+func getMetadataItems(endpoints map[string]string) (map[string]string, map[string]error) {
+	// Fetch the IMDSv2 token upfront so that all the concurrent requests share it
+	if config.Datadog.GetBool("ec2_prefer_imdsv2") {
+		if _, err := getToken(); err != nil {
+			log.Debugf("unable to get an IMDSv2 token before fetching EC2 metadata: %s", err)
+		}
+	}
+
+	var (
+		values = make(map[string]string, len(endpoints))
+		errs   = make(map[string]error)
+		wg     sync.WaitGroup
+		m      sync.Mutex
+	)
+	for name, endpoint := range endpoints {
+		wg.Add(1)
+		go func(name, endpoint string) {
+			defer wg.Done()
+			res, err := getMetadataItem(endpoint)
+
+			m.Lock()
+			defer m.Unlock()
+			if err != nil {
+				errs[name] = err
+				return
+			}
+			values[name] = strings.TrimSpace(res)
+		}(name, endpoint)
+	}
+	wg.Wait()
+
+	return values, errs
+}
@@ -0,0 +1,120 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package ec2
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/config"
+	"github.com/DataDog/datadog-agent/pkg/util/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMetadata(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		switch r.RequestURI {
+		case "/instance-id":
+			io.WriteString(w, "i-0123456789abcdef0")
+		case "/hostname":
+			io.WriteString(w, "ip-10-0-0-2.ec2.internal")
+		case "/placement/region":
+			io.WriteString(w, "us-east-1")
+		case "/placement/availability-zone":
+			io.WriteString(w, "us-east-1a\n")
+		case "/instance-type":
+			io.WriteString(w, "m5.large")
+		case "/local-ipv4":
+			io.WriteString(w, "10.0.0.2")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	metadataURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	defer resetPackageVars()
+
+	metadata, err := GetMetadata()
+	require.NoError(t, err)
+	assert.Equal(t, "i-0123456789abcdef0", metadata.InstanceID)
+	assert.Equal(t, "ip-10-0-0-2.ec2.internal", metadata.Hostname)
+	assert.Equal(t, "us-east-1", metadata.Region)
+	assert.Equal(t, "us-east-1a", metadata.AvailabilityZone)
+	assert.Equal(t, "m5.large", metadata.InstanceType)
+	assert.Equal(t, "10.0.0.2", metadata.PrivateIP)
+	assert.Equal(t, "", metadata.PublicIP)
+
+	// the missing public IP must not fail the whole fetch
+	assert.Len(t, metadata.Errors, 1)
+	assert.Error(t, metadata.Errors["PublicIP"])
+}
+
+func TestGetMetadataUnreachable(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+	metadataURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	defer resetPackageVars()
+
+	metadata, err := GetMetadata()
+	require.Error(t, err)
+	assert.Len(t, metadata.Errors, 7)
+}
+
+func TestDetect(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		switch r.RequestURI {
+		case "/hostname":
+			io.WriteString(w, "ip-10-0-0-2.ec2.internal")
+		case "/instance-id":
+			io.WriteString(w, "i-0123456789abcdef0")
+		case "/placement/availability-zone":
+			io.WriteString(w, "us-east-1a\n")
+		case "/instance-type":
+			io.WriteString(w, "m5.large")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	metadataURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	defer resetPackageVars()
+
+	provider, meta, err := Detect()
+	require.NoError(t, err)
+	assert.Equal(t, "AWS", provider)
+	assert.Equal(t, map[string]string{
+		"instance-id":       "i-0123456789abcdef0",
+		"region":            "us-east-1", // derived from the availability zone
+		"availability-zone": "us-east-1a",
+		"instance-type":     "m5.large",
+	}, meta)
+}
+
+func TestDetectNotOnEC2(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+	metadataURL = ts.URL
+	config.Datadog.Set("ec2_metadata_timeout", 1000)
+	defer resetPackageVars()
+	cache.Cache.Delete(hostnameCacheKey)
+
+	provider, meta, err := Detect()
+	require.NoError(t, err)
+	assert.Equal(t, "", provider)
+	assert.Nil(t, meta)
+}
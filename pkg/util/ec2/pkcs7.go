@@ -0,0 +1,355 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package ec2
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/dsa" //nolint:staticcheck // the PKCS7 signature of the instance identity document is a DSA one
+	"crypto/ecdsa"
+	"crypto/rsa"
+	_ "crypto/sha1" // #nosec register the hash of the PKCS7 signature of the instance identity document
+	_ "crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+var (
+	oidData          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidSignedData    = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+	oidMessageDigest = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 4}
+	oidSHA1          = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+	oidSHA256        = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+)
+
+// pkcs7ContentInfo is a PKCS7 content, the raw value of an explicitly tagged field holds the tagged element
+type pkcs7ContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,optional,tag:0"`
+}
+
+type pkcs7SignedData struct {
+	Version          int
+	DigestAlgorithms []pkix.AlgorithmIdentifier `asn1:"set"`
+	ContentInfo      pkcs7ContentInfo
+	Certificates     asn1.RawValue     `asn1:"optional,tag:0"`
+	CRLs             asn1.RawValue     `asn1:"optional,tag:1"`
+	SignerInfos      []pkcs7SignerInfo `asn1:"set"`
+}
+
+type pkcs7SignerInfo struct {
+	Version                   int
+	IssuerAndSerialNumber     asn1.RawValue
+	DigestAlgorithm           pkix.AlgorithmIdentifier
+	AuthenticatedAttributes   asn1.RawValue `asn1:"optional,tag:0"`
+	DigestEncryptionAlgorithm pkix.AlgorithmIdentifier
+	EncryptedDigest           []byte
+	UnauthenticatedAttributes asn1.RawValue `asn1:"optional,tag:1"`
+}
+
+type pkcs7Attribute struct {
+	Type   asn1.ObjectIdentifier
+	Values asn1.RawValue `asn1:"set"`
+}
+
+// dsaSignature is the encoding of the DSA and ECDSA signatures
+type dsaSignature struct {
+	R, S *big.Int
+}
+
+// verifyPKCS7 checks that a PKCS7 signed message, either PEM or base64 encoded as served by the metadata
+// API, is signed by cert and returns its content
+func verifyPKCS7(encoded []byte, cert *x509.Certificate) ([]byte, error) {
+	der, err := decodePKCS7(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	// the signature of the instance identity document is BER encoded
+	der, err = berToDER(der)
+	if err != nil {
+		return nil, fmt.Errorf("invalid PKCS7 encoding: %s", err)
+	}
+
+	var contentInfo pkcs7ContentInfo
+	if _, err := asn1.Unmarshal(der, &contentInfo); err != nil {
+		return nil, fmt.Errorf("invalid PKCS7 content info: %s", err)
+	}
+	if !contentInfo.ContentType.Equal(oidSignedData) {
+		return nil, fmt.Errorf("unexpected PKCS7 content type %s", contentInfo.ContentType)
+	}
+
+	var signedData pkcs7SignedData
+	if _, err := asn1.Unmarshal(contentInfo.Content.Bytes, &signedData); err != nil {
+		return nil, fmt.Errorf("invalid PKCS7 signed data: %s", err)
+	}
+	if !signedData.ContentInfo.ContentType.Equal(oidData) {
+		return nil, fmt.Errorf("unexpected PKCS7 signed content type %s", signedData.ContentInfo.ContentType)
+	}
+
+	var content []byte
+	if _, err := asn1.Unmarshal(signedData.ContentInfo.Content.Bytes, &content); err != nil {
+		return nil, fmt.Errorf("invalid PKCS7 signed content: %s", err)
+	}
+	if len(signedData.SignerInfos) == 0 {
+		return nil, errors.New("no PKCS7 signer")
+	}
+
+	var errs []string
+	for _, signer := range signedData.SignerInfos {
+		err := verifyPKCS7Signer(content, signer, cert)
+		if err == nil {
+			return content, nil
+		}
+		errs = append(errs, err.Error())
+	}
+	return nil, fmt.Errorf("PKCS7 signature verification failed: %s", strings.Join(errs, ", "))
+}
+
+// decodePKCS7 returns the DER or BER bytes of a PEM or base64 encoded PKCS7 message
+func decodePKCS7(encoded []byte) ([]byte, error) {
+	if block, _ := pem.Decode(encoded); block != nil {
+		return block.Bytes, nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(string(encoded)), ""))
+	if err != nil {
+		return nil, fmt.Errorf("invalid PKCS7 base64 encoding: %s", err)
+	}
+	return data, nil
+}
+
+func verifyPKCS7Signer(content []byte, signer pkcs7SignerInfo, cert *x509.Certificate) error {
+	hash, err := pkcs7Hash(signer.DigestAlgorithm.Algorithm)
+	if err != nil {
+		return err
+	}
+
+	h := hash.New()
+	h.Write(content)
+	digest := h.Sum(nil)
+
+	// with authenticated attributes, the signature covers their DER encoding as a SET OF and the
+	// content is covered by the message digest attribute
+	signed := content
+	if len(signer.AuthenticatedAttributes.Bytes) > 0 {
+		var attributes []pkcs7Attribute
+		if _, err := asn1.UnmarshalWithParams(signer.AuthenticatedAttributes.FullBytes, &attributes, "set,tag:0"); err != nil {
+			return fmt.Errorf("invalid authenticated attributes: %s", err)
+		}
+
+		var messageDigest []byte
+		for _, attribute := range attributes {
+			if attribute.Type.Equal(oidMessageDigest) {
+				if _, err := asn1.Unmarshal(attribute.Values.Bytes, &messageDigest); err != nil {
+					return fmt.Errorf("invalid message digest attribute: %s", err)
+				}
+			}
+		}
+		if !bytes.Equal(messageDigest, digest) {
+			return errors.New("message digest mismatch")
+		}
+
+		signed = append([]byte{0x31}, signer.AuthenticatedAttributes.FullBytes[1:]...)
+		h = hash.New()
+		h.Write(signed)
+		digest = h.Sum(nil)
+	}
+
+	switch pub := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(pub, hash, digest, signer.EncryptedDigest)
+	case *ecdsa.PublicKey:
+		var sig dsaSignature
+		if _, err := asn1.Unmarshal(signer.EncryptedDigest, &sig); err != nil {
+			return fmt.Errorf("invalid ECDSA signature: %s", err)
+		}
+		if !ecdsa.Verify(pub, digest, sig.R, sig.S) {
+			return errors.New("invalid ECDSA signature")
+		}
+		return nil
+	case *dsa.PublicKey:
+		var sig dsaSignature
+		if _, err := asn1.Unmarshal(signer.EncryptedDigest, &sig); err != nil {
+			return fmt.Errorf("invalid DSA signature: %s", err)
+		}
+		if n := (pub.Q.BitLen() + 7) / 8; len(digest) > n {
+			digest = digest[:n]
+		}
+		if !dsa.Verify(pub, digest, sig.R, sig.S) { //nolint:staticcheck
+			return errors.New("invalid DSA signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported public key type %T", cert.PublicKey)
+	}
+}
+
+func pkcs7Hash(oid asn1.ObjectIdentifier) (crypto.Hash, error) {
+	switch {
+	case oid.Equal(oidSHA1):
+		return crypto.SHA1, nil
+	case oid.Equal(oidSHA256):
+		return crypto.SHA256, nil
+	default:
+		return 0, fmt.Errorf("unsupported digest algorithm %s", oid)
+	}
+}
+
+// berToDER converts a BER encoding to DER: indefinite lengths are replaced by definite ones and
+// constructed strings are flattened
+func berToDER(ber []byte) ([]byte, error) {
+	der, rest, err := berElementToDER(ber)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("trailing data")
+	}
+	return der, nil
+}
+
+// berElementToDER converts the first element of ber to DER and returns the bytes following it
+func berElementToDER(ber []byte) ([]byte, []byte, error) {
+	if len(ber) < 2 {
+		return nil, nil, errors.New("truncated element")
+	}
+
+	tagLength := 1
+	if ber[0]&0x1f == 0x1f {
+		for ber[tagLength]&0x80 != 0 {
+			tagLength++
+			if tagLength >= len(ber) {
+				return nil, nil, errors.New("truncated tag")
+			}
+		}
+		tagLength++
+	}
+	tag := ber[:tagLength]
+	constructed := ber[0]&0x20 != 0
+	rest := ber[tagLength:]
+	if len(rest) == 0 {
+		return nil, nil, errors.New("truncated length")
+	}
+
+	indefinite := false
+	var length int
+	switch {
+	case rest[0] == 0x80:
+		indefinite = true
+		rest = rest[1:]
+	case rest[0]&0x80 == 0:
+		length = int(rest[0])
+		rest = rest[1:]
+	default:
+		n := int(rest[0] & 0x7f)
+		if n > 4 || len(rest) < 1+n {
+			return nil, nil, errors.New("invalid length")
+		}
+		for _, b := range rest[1 : 1+n] {
+			length = length<<8 | int(b)
+		}
+		rest = rest[1+n:]
+	}
+
+	if !constructed {
+		if indefinite || length > len(rest) {
+			return nil, nil, errors.New("invalid primitive length")
+		}
+		return encodeDERElement(tag, rest[:length]), rest[length:], nil
+	}
+
+	var body []byte
+	if !indefinite {
+		if length > len(rest) {
+			return nil, nil, errors.New("truncated element")
+		}
+		body, rest = rest[:length], rest[length:]
+	}
+
+	var children [][]byte
+	for {
+		if indefinite {
+			if len(rest) < 2 {
+				return nil, nil, errors.New("missing end of contents")
+			}
+			if rest[0] == 0 && rest[1] == 0 {
+				rest = rest[2:]
+				break
+			}
+		} else if len(body) == 0 {
+			break
+		}
+
+		var child []byte
+		var err error
+		if indefinite {
+			child, rest, err = berElementToDER(rest)
+		} else {
+			child, body, err = berElementToDER(body)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		children = append(children, child)
+	}
+
+	// constructed strings, such as the octet strings of BER encoded content, are flattened
+	if tagLength == 1 && ber[0]&0xc0 == 0 && isStringTag(ber[0]&0x1f) {
+		var value []byte
+		for _, child := range children {
+			_, childValue, err := splitDERElement(child)
+			if err != nil {
+				return nil, nil, err
+			}
+			value = append(value, childValue...)
+		}
+		return encodeDERElement([]byte{ber[0] &^ 0x20}, value), rest, nil
+	}
+
+	return encodeDERElement(tag, bytes.Join(children, nil)), rest, nil
+}
+
+func isStringTag(tag byte) bool {
+	switch tag {
+	case asn1.TagOctetString, asn1.TagUTF8String, asn1.TagPrintableString, asn1.TagIA5String:
+		return true
+	}
+	return false
+}
+
+// splitDERElement returns the header and the value of a DER element
+func splitDERElement(der []byte) ([]byte, []byte, error) {
+	var raw asn1.RawValue
+	if _, err := asn1.Unmarshal(der, &raw); err != nil {
+		return nil, nil, err
+	}
+	return der[:len(der)-len(raw.Bytes)], raw.Bytes, nil
+}
+
+// encodeDERElement encodes an element with a definite length
+func encodeDERElement(tag []byte, value []byte) []byte {
+	der := append([]byte{}, tag...)
+	switch length := len(value); {
+	case length < 0x80:
+		der = append(der, byte(length))
+	default:
+		var lengthBytes []byte
+		for ; length > 0; length >>= 8 {
+			lengthBytes = append([]byte{byte(length)}, lengthBytes...)
+		}
+		der = append(der, 0x80|byte(len(lengthBytes)))
+		der = append(der, lengthBytes...)
+	}
+	return append(der, value...)
+}
@@ -0,0 +1,184 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package ec2
+
+import (
+	"crypto"
+	"crypto/dsa" //nolint:staticcheck
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testIdentityDocument = `{"instanceId": "i-aaaaaaaaaaaaaaaaa", "region": "us-east-1"}`
+
+// signTestPKCS7 returns a DER PKCS7 signed message of content, with authenticated attributes if withAttributes is
+// set. The message is BER encoded with indefinite lengths and a constructed octet string if ber is set.
+func signTestPKCS7(t *testing.T, content []byte, hash crypto.Hash, sign func(digest []byte) []byte, withAttributes, ber bool) []byte {
+	hashOID := oidSHA256
+	if hash == crypto.SHA1 {
+		hashOID = oidSHA1
+	}
+
+	h := hash.New()
+	h.Write(content)
+	digest := h.Sum(nil)
+
+	signer := pkcs7SignerInfo{
+		Version:                   1,
+		IssuerAndSerialNumber:     asn1.RawValue{FullBytes: []byte{0x30, 0x00}},
+		DigestAlgorithm:           pkix.AlgorithmIdentifier{Algorithm: hashOID},
+		DigestEncryptionAlgorithm: pkix.AlgorithmIdentifier{Algorithm: asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 1}},
+	}
+
+	if withAttributes {
+		contentType, err := asn1.Marshal(oidData)
+		require.NoError(t, err)
+		messageDigest, err := asn1.Marshal(digest)
+		require.NoError(t, err)
+
+		var attributes []byte
+		for _, attribute := range []pkcs7Attribute{
+			{Type: asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 3}, Values: asn1.RawValue{FullBytes: encodeDERElement([]byte{0x31}, contentType)}},
+			{Type: oidMessageDigest, Values: asn1.RawValue{FullBytes: encodeDERElement([]byte{0x31}, messageDigest)}},
+		} {
+			der, err := asn1.Marshal(attribute)
+			require.NoError(t, err)
+			attributes = append(attributes, der...)
+		}
+		signer.AuthenticatedAttributes = asn1.RawValue{FullBytes: encodeDERElement([]byte{0xa0}, attributes)}
+
+		h = hash.New()
+		h.Write(encodeDERElement([]byte{0x31}, attributes))
+		digest = h.Sum(nil)
+	}
+	signer.EncryptedDigest = sign(digest)
+
+	octets, err := asn1.Marshal(content)
+	require.NoError(t, err)
+	if ber {
+		// constructed octet string of two chunks with an indefinite length
+		half := len(content) / 2
+		octets = append([]byte{0x24, 0x80}, encodeDERElement([]byte{0x04}, content[:half])...)
+		octets = append(octets, encodeDERElement([]byte{0x04}, content[half:])...)
+		octets = append(octets, 0x00, 0x00)
+	}
+
+	signedData, err := asn1.Marshal(pkcs7SignedData{
+		Version:          1,
+		DigestAlgorithms: []pkix.AlgorithmIdentifier{{Algorithm: hashOID}},
+		ContentInfo: pkcs7ContentInfo{
+			ContentType: oidData,
+			Content:     asn1.RawValue{FullBytes: encodeDERElement([]byte{0xa0}, octets)},
+		},
+		SignerInfos: []pkcs7SignerInfo{signer},
+	})
+	require.NoError(t, err)
+
+	contentInfo, err := asn1.Marshal(pkcs7ContentInfo{
+		ContentType: oidSignedData,
+		Content:     asn1.RawValue{FullBytes: encodeDERElement([]byte{0xa0}, signedData)},
+	})
+	require.NoError(t, err)
+	if ber {
+		_, value, err := splitDERElement(contentInfo)
+		require.NoError(t, err)
+		contentInfo = append(append([]byte{0x30, 0x80}, value...), 0x00, 0x00)
+	}
+	return contentInfo
+}
+
+func TestVerifyPKCS7RSA(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	cert := &x509.Certificate{PublicKey: &key.PublicKey}
+
+	sign := func(digest []byte) []byte {
+		sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest)
+		require.NoError(t, err)
+		return sig
+	}
+
+	for _, tc := range []struct {
+		name           string
+		withAttributes bool
+		ber            bool
+	}{
+		{name: "content signature"},
+		{name: "authenticated attributes", withAttributes: true},
+		{name: "ber", withAttributes: true, ber: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			der := signTestPKCS7(t, []byte(testIdentityDocument), crypto.SHA256, sign, tc.withAttributes, tc.ber)
+
+			content, err := verifyPKCS7([]byte(base64.StdEncoding.EncodeToString(der)), cert)
+			require.NoError(t, err)
+			assert.Equal(t, testIdentityDocument, string(content))
+
+			content, err = verifyPKCS7(pem.EncodeToMemory(&pem.Block{Type: "PKCS7", Bytes: der}), cert)
+			require.NoError(t, err)
+			assert.Equal(t, testIdentityDocument, string(content))
+		})
+	}
+}
+
+func TestVerifyPKCS7DSA(t *testing.T) {
+	key := &dsa.PrivateKey{}
+	require.NoError(t, dsa.GenerateParameters(&key.Parameters, rand.Reader, dsa.L1024N160))
+	require.NoError(t, dsa.GenerateKey(key, rand.Reader))
+	cert := &x509.Certificate{PublicKey: &key.PublicKey}
+
+	der := signTestPKCS7(t, []byte(testIdentityDocument), crypto.SHA1, func(digest []byte) []byte {
+		r, s, err := dsa.Sign(rand.Reader, key, digest)
+		require.NoError(t, err)
+		sig, err := asn1.Marshal(dsaSignature{R: r, S: s})
+		require.NoError(t, err)
+		return sig
+	}, true, true)
+
+	content, err := verifyPKCS7([]byte(base64.StdEncoding.EncodeToString(der)), cert)
+	require.NoError(t, err)
+	assert.Equal(t, testIdentityDocument, string(content))
+}
+
+func TestVerifyPKCS7Invalid(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	der := signTestPKCS7(t, []byte(testIdentityDocument), crypto.SHA256, func(digest []byte) []byte {
+		sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest)
+		require.NoError(t, err)
+		return sig
+	}, true, false)
+	encoded := []byte(base64.StdEncoding.EncodeToString(der))
+
+	// signed by another key
+	_, err = verifyPKCS7(encoded, &x509.Certificate{PublicKey: &otherKey.PublicKey})
+	assert.Error(t, err)
+
+	// tampered content
+	tampered := signTestPKCS7(t, []byte(testIdentityDocument), crypto.SHA256, func(digest []byte) []byte {
+		sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, make([]byte, len(digest)))
+		require.NoError(t, err)
+		return sig
+	}, false, false)
+	_, err = verifyPKCS7([]byte(base64.StdEncoding.EncodeToString(tampered)), &x509.Certificate{PublicKey: &key.PublicKey})
+	assert.Error(t, err)
+
+	// garbage
+	_, err = verifyPKCS7([]byte("not a signature"), &x509.Certificate{PublicKey: &key.PublicKey})
+	assert.Error(t, err)
+}
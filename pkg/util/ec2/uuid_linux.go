@@ -0,0 +1,48 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux
+
+package ec2
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/config"
+)
+
+// declare it as var not const to ease testing
+var hypervisorUUIDPath = "/sys/hypervisor/uuid"
+
+// IsRunningOnViaUUID returns true if the agent is running on AWS according to the hypervisor and DMI
+// UUIDs, which start with ec2 on EC2 instances. Unlike IsRunningOn, it doesn't query the metadata API.
+// The DMI UUID is only readable by root.
+func IsRunningOnViaUUID() bool {
+	if !config.IsCloudProviderEnabled(CloudProviderName) {
+		return false
+	}
+
+	for _, path := range []string{hypervisorUUIDPath, filepath.Join(dmiPath, "product_uuid")} {
+		if isEC2UUID(readSysfsValue(path)) {
+			return true
+		}
+	}
+	return false
+}
+
+// isEC2UUID returns whether uuid is the UUID of an EC2 instance. Some instances expose the DMI UUID
+// with its first field in little-endian byte order, 45e12aec-... standing for ec2ae145-...
+func isEC2UUID(uuid string) bool {
+	uuid = strings.ToLower(uuid)
+	if strings.HasPrefix(uuid, "ec2") {
+		return true
+	}
+	if len(uuid) < 8 {
+		return false
+	}
+	swapped := uuid[6:8] + uuid[4:6] + uuid[2:4] + uuid[0:2]
+	return strings.HasPrefix(swapped, "ec2")
+}
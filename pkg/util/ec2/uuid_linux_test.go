@@ -0,0 +1,89 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build linux
+
+package ec2
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsEC2UUID(t *testing.T) {
+	for uuid, expected := range map[string]bool{
+		"ec2a1b2c-3d4e-5f60-7182-93a4b5c6d7e8": true,
+		"EC2A1B2C-3D4E-5F60-7182-93A4B5C6D7E8": true,
+		"45E12AEC-DCD1-B213-94ED-012345ABCDEF": true,
+		"4C4C4544-0042-3510-8052-B4C04F4E3732": false,
+		"":                                     false,
+	} {
+		assert.Equal(t, expected, isEC2UUID(uuid), uuid)
+	}
+}
+
+func TestIsRunningOnViaUUID(t *testing.T) {
+	initialDMIPath, initialHypervisorUUIDPath := dmiPath, hypervisorUUIDPath
+	defer func() {
+		dmiPath, hypervisorUUIDPath = initialDMIPath, initialHypervisorUUIDPath
+	}()
+
+	for _, tc := range []struct {
+		name     string
+		files    map[string]string
+		expected bool
+	}{
+		{
+			name: "xen",
+			files: map[string]string{
+				"hypervisor_uuid": "ec2a1b2c-3d4e-5f60-7182-93a4b5c6d7e8\n",
+			},
+			expected: true,
+		},
+		{
+			name: "nitro",
+			files: map[string]string{
+				"dmi/product_uuid": "EC2A1B2C-3D4E-5F60-7182-93A4B5C6D7E8\n",
+			},
+			expected: true,
+		},
+		{
+			name: "little-endian",
+			files: map[string]string{
+				"dmi/product_uuid": "45E12AEC-DCD1-B213-94ED-012345ABCDEF\n",
+			},
+			expected: true,
+		},
+		{
+			name: "other",
+			files: map[string]string{
+				"dmi/product_uuid": "4C4C4544-0042-3510-8052-B4C04F4E3732\n",
+			},
+		},
+		{
+			name: "unreadable",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "ec2-uuid")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+
+			require.NoError(t, os.Mkdir(filepath.Join(dir, "dmi"), 0755))
+			for name, content := range tc.files {
+				require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+			}
+			dmiPath = filepath.Join(dir, "dmi")
+			hypervisorUUIDPath = filepath.Join(dir, "hypervisor_uuid")
+
+			assert.Equal(t, tc.expected, IsRunningOnViaUUID())
+		})
+	}
+}
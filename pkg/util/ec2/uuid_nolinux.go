@@ -0,0 +1,14 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+// +build !linux
+
+package ec2
+
+// IsRunningOnViaUUID returns true if the agent is running on AWS according to the hypervisor and DMI
+// UUIDs, which are only read on Linux
+func IsRunningOnViaUUID() bool {
+	return false
+}
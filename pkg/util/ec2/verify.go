@@ -0,0 +1,208 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-2020 Datadog, Inc.
+
+package ec2
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/config"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+var (
+	// instanceIDRegexp matches EC2 instance IDs, made of 8 or 17 hexadecimal characters
+	instanceIDRegexp = regexp.MustCompile(`^i-([0-9a-f]{8}|[0-9a-f]{17})$`)
+
+	// ErrInstanceIDMismatch is returned when the instance ID served by the metadata endpoint differs from
+	// the one of the instance identity document
+	ErrInstanceIDMismatch = errors.New("instance ID doesn't match the instance identity document")
+
+	// ErrInstanceIdentityUnverified is returned when the signature of the instance identity document can't be
+	// verified, the instance ID served by the metadata endpoint is then not trusted
+	ErrInstanceIdentityUnverified = errors.New("unable to verify the instance identity document")
+
+	// imdsVerified remembers a successful verification of the metadata endpoint
+	imdsVerified = struct {
+		sync.Mutex
+		done bool
+	}{}
+)
+
+// VerifyIMDS checks that the metadata endpoint behaves like the genuine EC2 instance metadata service:
+// its token endpoint follows the IMDSv2 specification and the instance ID it serves looks like an
+// EC2 instance ID. This guards against trusting a spoofed endpoint, reached through a proxy for instance.
+func VerifyIMDS() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Duration(config.Datadog.GetInt("ec2_metadata_timeout"))*time.Millisecond)
+	defer cancel()
+
+	// a token request without TTL must be rejected
+	if _, err := imdsRequest(ctx, http.MethodPut, tokenURL, nil); !hasStatusCode(err, http.StatusBadRequest) {
+		return fmt.Errorf("token endpoint accepted a request without TTL: %v", err)
+	}
+
+	tok, err := imdsRequest(ctx, http.MethodPut, tokenURL, map[string]string{
+		"X-aws-ec2-metadata-token-ttl-seconds": fmt.Sprintf("%d", int(tokenLifetime.Seconds())),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to get a token: %w", err)
+	}
+	if tok == "" {
+		return errors.New("token endpoint returned an empty token")
+	}
+
+	// an invalid token must be rejected
+	if _, err := imdsRequest(ctx, http.MethodGet, metadataURL+"/instance-id", map[string]string{
+		"X-aws-ec2-metadata-token": "invalid" + tok,
+	}); !hasStatusCode(err, http.StatusUnauthorized) {
+		return fmt.Errorf("metadata endpoint accepted an invalid token: %v", err)
+	}
+
+	instanceID, err := imdsRequest(ctx, http.MethodGet, metadataURL+"/instance-id", map[string]string{
+		"X-aws-ec2-metadata-token": tok,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to get the instance ID: %w", err)
+	}
+	if !instanceIDRegexp.MatchString(instanceID) {
+		return fmt.Errorf("unexpected instance ID %q", instanceID)
+	}
+
+	return nil
+}
+
+// verifyIMDSIfEnabled runs VerifyIMDS when ec2_verify_imds is set, a successful verification is remembered
+func verifyIMDSIfEnabled() error {
+	if !config.Datadog.GetBool("ec2_verify_imds") {
+		return nil
+	}
+
+	imdsVerified.Lock()
+	defer imdsVerified.Unlock()
+
+	if imdsVerified.done {
+		return nil
+	}
+
+	if err := VerifyIMDS(); err != nil {
+		log.Errorf("The EC2 metadata endpoint doesn't behave like the genuine EC2 instance metadata service, its responses are not trusted: %s", err)
+		return fmt.Errorf("EC2 metadata endpoint verification failed: %w", err)
+	}
+
+	imdsVerified.done = true
+	return nil
+}
+
+// verifyInstanceIdentityIfEnabled checks, when ec2_verify_instance_identity is set, that the instance ID served
+// by the metadata endpoint matches the one of the instance identity document signed by AWS. The signature is
+// checked against the certificate configured with ec2_instance_identity_certificate, the unsigned document is
+// not trusted as it comes from the endpoint being verified. An error is returned when the signed document is
+// not available or can't be verified.
+func verifyInstanceIdentityIfEnabled(ctx context.Context, instanceID string) error {
+	if !config.Datadog.GetBool("ec2_verify_instance_identity") {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(config.Datadog.GetInt("ec2_metadata_timeout"))*time.Millisecond)
+	defer cancel()
+
+	identity, err := getVerifiedInstanceIdentity(ctx)
+	if err != nil {
+		log.Errorf("Unable to verify the instance identity document, the instance ID %q is not trusted: %s", instanceID, err)
+		return fmt.Errorf("%w: %s", ErrInstanceIdentityUnverified, err)
+	}
+
+	if identity.InstanceID != instanceID {
+		log.Errorf("The EC2 metadata endpoint returned the instance ID %q while the instance identity document has %q, it may be spoofed", instanceID, identity.InstanceID)
+		return fmt.Errorf("%w: %q != %q", ErrInstanceIDMismatch, instanceID, identity.InstanceID)
+	}
+
+	return nil
+}
+
+// getVerifiedInstanceIdentity returns the instance identity document once its PKCS7 signature is verified
+func getVerifiedInstanceIdentity(ctx context.Context) (*InstanceIdentity, error) {
+	cert, err := loadInstanceIdentityCertificate()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := doHTTPRequestWithContext(ctx, instanceIdentityPKCS7URL, http.MethodGet, map[string]string{}, true)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch the signed instance identity document, %s", err)
+	}
+	defer res.Body.Close()
+
+	signature, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read response body, %s", err)
+	}
+
+	document, err := verifyPKCS7(signature, cert)
+	if err != nil {
+		return nil, err
+	}
+
+	identity := &InstanceIdentity{}
+	if err := json.Unmarshal(document, identity); err != nil {
+		return nil, fmt.Errorf("unable to unmarshall the signed instance identity document, %s", err)
+	}
+	return identity, nil
+}
+
+// loadInstanceIdentityCertificate reads the PEM encoded AWS certificate of the instance identity document
+// signature. AWS publishes one per region and partition, so it is not bundled with the agent.
+func loadInstanceIdentityCertificate() (*x509.Certificate, error) {
+	path := config.Datadog.GetString("ec2_instance_identity_certificate")
+	if path == "" {
+		return nil, errors.New("ec2_instance_identity_certificate is not set")
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the instance identity certificate: %s", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM certificate in %s", path)
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// imdsRequest sends a request to the metadata endpoint without any cached token and returns the response body
+func imdsRequest(ctx context.Context, method, url string, headers map[string]string) (string, error) {
+	if headers == nil {
+		headers = map[string]string{}
+	}
+
+	res, err := doHTTPRequestWithContext(ctx, url, method, headers, false)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return "", fmt.Errorf("unable to read response body, %s", err)
+	}
+	return string(body), nil
+}
+
+// hasStatusCode returns whether err was caused by the metadata endpoint answering with the given status code
+func hasStatusCode(err error, code int) bool {
+	var statusErr *statusCodeError
+	return errors.As(err, &statusErr) && statusErr.code == code
+}
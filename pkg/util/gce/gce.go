@@ -125,10 +125,7 @@ func getResponseWithMaxLength(endpoint string, maxLength int) (string, error) {
 	if err != nil {
 		return result, err
 	}
-	if len(result) > maxLength {
-		return "", fmt.Errorf("%v gave a response with length > to %v", endpoint, maxLength)
-	}
-	return result, err
+	return config.EnforceMaxHostnameSize(endpoint, result, maxLength)
 }
 
 func getResponse(url string) (string, error) {
@@ -63,10 +63,7 @@ func getMetadataItemWithMaxLength(endpoint string, maxLength int) (string, error
 	if err != nil {
 		return result, err
 	}
-	if len(result) > maxLength {
-		return "", fmt.Errorf("%v gave a response with length > to %v", endpoint, maxLength)
-	}
-	return result, err
+	return config.EnforceMaxHostnameSize(endpoint, result, maxLength)
 }
 
 func getMetadataItem(endpoint string) (string, error) {
@@ -15,6 +15,7 @@ var (
 	procNtQueryInformationProcess = modntdll.NewProc("NtQueryInformationProcess")
 	procReadProcessMemory         = modkernel.NewProc("ReadProcessMemory")
 	procIsWow64Process            = modkernel.NewProc("IsWow64Process")
+	procQueryFullProcessImageName = modkernel.NewProc("QueryFullProcessImageNameW")
 )
 
 // C definition from winternl.h
@@ -276,3 +277,22 @@ func GetCommandLineForPid(pid uint32) (cmdline string, err error) {
 	}
 	return GetCommandLineForProcess(h)
 }
+
+// GetImagePathForProcess returns the full path of the executable of the given process, the handle
+// needs the PROCESS_QUERY_LIMITED_INFORMATION access right
+// https://docs.microsoft.com/en-us/windows/win32/api/winbase/nf-winbase-queryfullprocessimagenamew
+func GetImagePathForProcess(h windows.Handle) (path string, err error) {
+	// the longest path supported by the Windows API
+	buf := make([]uint16, 32768)
+	size := uint32(len(buf))
+
+	r, _, _ := procQueryFullProcessImageName.Call(uintptr(h),
+		uintptr(0), // win32 path format
+		uintptr(unsafe.Pointer(&buf[0])),
+		uintptr(unsafe.Pointer(&size)))
+	if r == 0 {
+		err = windows.GetLastError()
+		return
+	}
+	return windows.UTF16ToString(buf[:size]), nil
+}